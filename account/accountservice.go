@@ -1,10 +1,13 @@
 package account
 
 import (
+	"bytes"
 	"sync"
+	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/account/extsigner"
 	"github.com/aergoio/aergo/account/key"
 	cfg "github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/contract/name"
@@ -19,6 +22,7 @@ type AccountService struct {
 	cfg         *cfg.Config
 	sdb         *state.ChainStateDB
 	ks          *key.Store
+	signer      *extsigner.Client
 	accountLock sync.RWMutex
 	accounts    []*types.Account
 	testConfig  bool
@@ -36,7 +40,10 @@ func NewAccountService(cfg *cfg.Config, sdb *state.ChainStateDB) *AccountService
 }
 
 func (as *AccountService) BeforeStart() {
-	as.ks = key.NewStore(as.cfg.DataDir, as.cfg.Account.UnlockTimeout)
+	as.ks = key.NewStore(as.cfg.DataDir, as.cfg.AuthDir, as.cfg.Account.UnlockTimeout)
+	if err := as.ks.SetKDF(as.cfg.Account.KDF); err != nil {
+		as.Logger.Warn().Str("kdf", as.cfg.Account.KDF).Msg("unsupported kdf of keystore, use default")
+	}
 
 	as.accounts = []*types.Account{}
 	addresses, err := as.ks.GetAddresses()
@@ -46,24 +53,68 @@ func (as *AccountService) BeforeStart() {
 	for _, v := range addresses {
 		as.accounts = append(as.accounts, &types.Account{Address: v})
 	}
+
+	if as.cfg.Account.Signer != "" {
+		as.initSigner(as.cfg.Account.Signer, as.cfg.Account.SignerAccounts)
+	}
+}
+
+// initSigner connects external signer, which signs txs of the accounts not unlocked in the keystore. Only the
+// accounts configured in signeraccounts are signed without unlock.
+func (as *AccountService) initSigner(endpoint string, accounts []string) {
+	allowed := make([]key.Address, 0, len(accounts))
+	for _, encoded := range accounts {
+		addr, err := types.DecodeAddress(encoded)
+		if err != nil {
+			as.Logger.Error().Err(err).Str("address", encoded).Msg("invalid address of external signer account")
+			continue
+		}
+		allowed = append(allowed, addr)
+	}
+	signer, err := extsigner.NewClient(endpoint)
+	if err != nil {
+		as.Logger.Error().Err(err).Str("signer", endpoint).Msg("invalid endpoint of external signer")
+		return
+	}
+	as.signer = signer
+	as.ks.SetSigner(signer, allowed)
+
+	addresses, err := signer.Addresses()
+	if err != nil {
+		// signer can be started later, so the signer is still used
+		as.Logger.Warn().Err(err).Str("signer", endpoint).Msg("could not get addresses of external signer")
+		return
+	}
+	as.appendAccounts(addresses)
+	as.Logger.Info().Str("signer", endpoint).Int("keys", len(addresses)).Msg("external signer connected")
 }
 
 func (as *AccountService) AfterStart() {}
 
 func (as *AccountService) BeforeStop() {
 	as.ks.CloseStore()
+	if as.signer != nil {
+		as.signer.Close()
+	}
 	as.accounts = nil
 }
 
 func (as *AccountService) Statistics() *map[string]interface{} {
 	return nil
 }
+
+// resolveName resolves the name at the next block, where the tx signed by the account is executed
 func (as *AccountService) resolveName(namedAddress []byte) ([]byte, error) {
+	result, err := as.RequestToFutureResult(message.ChainSvc, &message.GetBestBlockNo{}, time.Second,
+		"account.(*AccountService).resolveName")
+	if err != nil {
+		return nil, err
+	}
 	scs, err := as.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 	if err != nil {
 		return nil, err
 	}
-	return name.GetAddress(scs, namedAddress), nil
+	return name.GetAddress(scs, namedAddress, result.(message.GetBestBlockNoRsp).BlockNo+1), nil
 }
 
 func (as *AccountService) Receive(context actor.Context) {
@@ -78,7 +129,7 @@ func (as *AccountService) Receive(context actor.Context) {
 	case *message.LockAccount:
 		actualAddress := msg.Account.Address
 		var err error
-		if len(actualAddress) == types.NameLength {
+		if types.IsNameAddress(actualAddress) {
 			actualAddress, err = as.resolveName(actualAddress)
 			if err != nil {
 				context.Respond(&message.AccountRsp{
@@ -92,7 +143,7 @@ func (as *AccountService) Receive(context actor.Context) {
 	case *message.UnlockAccount:
 		actualAddress := msg.Account.Address
 		var err error
-		if len(actualAddress) == types.NameLength {
+		if types.IsNameAddress(actualAddress) {
 			actualAddress, err = as.resolveName(actualAddress)
 			if err != nil {
 				context.Respond(&message.AccountRsp{
@@ -107,12 +158,18 @@ func (as *AccountService) Receive(context actor.Context) {
 		account, err := as.importAccount(msg.Wif, msg.OldPass, msg.NewPass)
 		context.Respond(&message.ImportAccountRsp{Account: account, Err: err})
 	case *message.ExportAccount:
-		wif, err := as.exportAccount(msg.Account.Address, msg.Pass)
+		wif, err := as.exportAccount(msg.Account.Address, msg.Pass, msg.AsKeystore)
 		context.Respond(&message.ExportAccountRsp{Wif: wif, Err: err})
+	case *message.CreateHDAccounts:
+		mnemonic, accounts, err := as.createHDAccounts(msg.Passphrase, msg.Count)
+		context.Respond(&message.HDAccountsRsp{Mnemonic: mnemonic, Accounts: accounts, Err: err})
+	case *message.RecoverHDAccounts:
+		accounts, err := as.recoverHDAccounts(msg.Mnemonic, msg.Passphrase, msg.Count)
+		context.Respond(&message.HDAccountsRsp{Accounts: accounts, Err: err})
 	case *message.SignTx:
 		var err error
 		actualAddress := msg.Tx.GetBody().GetAccount()
-		if len(actualAddress) == types.NameLength {
+		if types.IsNameAddress(actualAddress) {
 			actualAddress, err = as.resolveName(msg.Tx.GetBody().GetAccount())
 			if err != nil {
 				context.Respond(&message.SignTxRsp{Tx: nil, Err: err})
@@ -148,8 +205,6 @@ func (as *AccountService) createAccount(passphrase string) (*types.Account, erro
 
 	//append list
 	as.accountLock.Lock()
-	//TODO: performance turning here
-	as.ks.SaveAddress(address)
 	as.accounts = append(as.accounts, account)
 	as.accountLock.Unlock()
 	return account, nil
@@ -163,14 +218,59 @@ func (as *AccountService) importAccount(wif []byte, old string, new string) (*ty
 	//append list
 	account := &types.Account{Address: address}
 	as.accountLock.Lock()
-	//TODO: performance turning here
-	as.ks.SaveAddress(address)
 	as.accounts = append(as.accounts, account)
 	as.accountLock.Unlock()
 	return account, nil
 }
 
-func (as *AccountService) exportAccount(address []byte, pass string) ([]byte, error) {
+// createHDAccounts derives next accounts from hd wallet. If there is no hd wallet, it is created with new
+// mnemonic and the mnemonic is returned.
+func (as *AccountService) createHDAccounts(passphrase string, count int) (string, []*types.Account, error) {
+	var mnemonic string
+	addresses, err := as.ks.DeriveHDAccounts(passphrase, count)
+	if err == key.ErrHDWalletNotExist {
+		mnemonic, addresses, err = as.ks.CreateHDWallet(passphrase, count)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return mnemonic, as.appendAccounts(addresses), nil
+}
+
+func (as *AccountService) recoverHDAccounts(mnemonic string, passphrase string, count int) ([]*types.Account, error) {
+	addresses, err := as.ks.RecoverHDWallet(mnemonic, passphrase, count)
+	if err != nil {
+		return nil, err
+	}
+	return as.appendAccounts(addresses), nil
+}
+
+// appendAccounts adds addresses to the account list, skipping ones already in it.
+func (as *AccountService) appendAccounts(addresses []key.Address) []*types.Account {
+	as.accountLock.Lock()
+	defer as.accountLock.Unlock()
+	accounts := make([]*types.Account, 0, len(addresses))
+	for _, address := range addresses {
+		account := types.NewAccount(address)
+		accounts = append(accounts, account)
+		exist := false
+		for _, a := range as.accounts {
+			if bytes.Equal(a.Address, address) {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			as.accounts = append(as.accounts, account)
+		}
+	}
+	return accounts
+}
+
+func (as *AccountService) exportAccount(address []byte, pass string, asKeystore bool) ([]byte, error) {
+	if asKeystore {
+		return as.ks.ExportKeystore(address, pass)
+	}
 	wif, err := as.ks.ExportKey(address, pass)
 	if err != nil {
 		return nil, err
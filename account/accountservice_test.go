@@ -29,6 +29,7 @@ func initTest() {
 	serverCtx := config.NewServerContext("", "")
 	conf := serverCtx.GetDefaultConfig().(*config.Config)
 	conf.DataDir, _ = ioutil.TempDir("", "test")
+	conf.AuthDir = conf.DataDir
 
 	sdb = state.NewChainStateDB()
 	testmode := true
@@ -0,0 +1,202 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package extsigner
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+)
+
+// DefaultTimeout is the time limit of a request to signer
+const DefaultTimeout = 3 * time.Second
+
+// Client is the connection to an external signer. It is safe for concurrent use, and reconnects when the
+// connection is broken.
+type Client struct {
+	network string
+	address string
+	timeout time.Duration
+
+	mutex  sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	nextID uint64
+}
+
+var _ key.Signer = (*Client)(nil)
+
+// NewClient returns a client of the signer at endpoint. It does not connect until the first request.
+func NewClient(endpoint string) (*Client, error) {
+	network, address, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{network: network, address: address, timeout: DefaultTimeout}, nil
+}
+
+// Addresses returns the addresses of keys held by signer.
+func (c *Client) Addresses() ([]key.Address, error) {
+	rsp, err := c.request(&Request{Method: MethodAddresses})
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]key.Address, 0, len(rsp.Addresses))
+	for _, encoded := range rsp.Addresses {
+		addr, err := types.DecodeAddress(encoded)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// Sign requests signer to sign hash with the key of address.
+func (c *Client) Sign(address key.Address, hash []byte) ([]byte, error) {
+	rsp, err := c.request(&Request{Method: MethodSign, Address: types.EncodeAddress(address),
+		Hash: hex.EncodeToString(hash)})
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(rsp.Signature)
+}
+
+// Close closes the connection to signer.
+func (c *Client) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closeConn()
+}
+
+func (c *Client) request(req *Request) (*Response, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	rsp, err := c.roundTrip(req)
+	if err != nil {
+		// connection can be broken by restart of signer. retry once with new connection
+		c.closeConn()
+		if rsp, err = c.roundTrip(req); err != nil {
+			c.closeConn()
+			return nil, err
+		}
+	}
+	if rsp.Error != "" {
+		return nil, fmt.Errorf("signer: %s", rsp.Error)
+	}
+	return rsp, nil
+}
+
+func (c *Client) roundTrip(req *Request) (*Response, error) {
+	if c.conn == nil {
+		conn, err := net.DialTimeout(c.network, c.address, c.timeout)
+		if err != nil {
+			return nil, err
+		}
+		c.conn, c.reader = conn, bufio.NewReader(conn)
+	}
+	c.nextID++
+	req.ID = c.nextID
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	c.conn.SetDeadline(time.Now().Add(c.timeout))
+	if _, err = c.conn.Write(append(data, '\n')); err != nil {
+		return nil, err
+	}
+	line, err := c.reader.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	rsp := &Response{}
+	if err = json.Unmarshal(line, rsp); err != nil {
+		return nil, err
+	}
+	if rsp.ID != req.ID {
+		return nil, fmt.Errorf("signer responded to request %d, expected %d", rsp.ID, req.ID)
+	}
+	return rsp, nil
+}
+
+func (c *Client) closeConn() {
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn, c.reader = nil, nil
+	}
+}
+
+// BlockSigner signs blocks with the key held by external signer. It is used by block factory in place of
+// the node key.
+type BlockSigner struct {
+	client  *Client
+	address key.Address
+	pubKey  crypto.PubKey
+}
+
+var _ types.BlockSigner = (*BlockSigner)(nil)
+
+// NewBlockSigner returns the block signer with the key of address. If address is empty, the signer must hold
+// only one key and it is used.
+func NewBlockSigner(client *Client, address key.Address) (*BlockSigner, error) {
+	addrs, err := client.Addresses()
+	if err != nil {
+		return nil, err
+	}
+	if len(address) == 0 {
+		if len(addrs) != 1 {
+			return nil, fmt.Errorf("signer holds %d keys, the address of bp key must be given", len(addrs))
+		}
+		address = addrs[0]
+	} else if !containsAddress(addrs, address) {
+		return nil, ErrUnknownAddress
+	}
+	// aergo address is the compressed public key of secp256k1
+	pubKey, err := crypto.UnmarshalSecp256k1PublicKey(address)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockSigner{client: client, address: address, pubKey: pubKey}, nil
+}
+
+// GetPublic returns the public key of block signer
+func (bs *BlockSigner) GetPublic() crypto.PubKey {
+	return bs.pubKey
+}
+
+// Sign signs msg in the same way as secp256k1 key of libp2p, which digests msg by sha256.
+func (bs *BlockSigner) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+	sig, err := bs.client.Sign(bs.address, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	if valid, err := bs.pubKey.Verify(msg, sig); err != nil || !valid {
+		return nil, errors.New("signer returned invalid block signature")
+	}
+	return sig, nil
+}
+
+func containsAddress(addrs []key.Address, address key.Address) bool {
+	for _, a := range addrs {
+		if bytes.Equal(a, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package extsigner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func startTestSigner(t *testing.T, keys ...*btcec.PrivateKey) (*Server, string, func()) {
+	dir, err := ioutil.TempDir("", "extsigner")
+	assert.NoError(t, err)
+	endpoint := "unix://" + filepath.Join(dir, "signer.sock")
+	server := NewServer(keys...)
+	assert.NoError(t, server.Listen(endpoint))
+	return server, endpoint, func() {
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestParseEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		network  string
+		address  string
+		wantErr  bool
+	}{
+		{"/var/run/signer.sock", "unix", "/var/run/signer.sock", false},
+		{"unix:///var/run/signer.sock", "unix", "/var/run/signer.sock", false},
+		{"tcp://127.0.0.1:7846", "tcp", "127.0.0.1:7846", false},
+		{"tcp://[::1]:7846", "tcp", "[::1]:7846", false},
+		{"tcp://localhost:7846", "tcp", "localhost:7846", false},
+		{"tcp://192.168.0.10:7846", "", "", true},
+		{"tcp://signer.example.com:7846", "", "", true},
+		{"tcp://127.0.0.1", "", "", true},
+		{"http://127.0.0.1:7846", "", "", true},
+		{"tcp://", "", "", true},
+	}
+	for _, tt := range tests {
+		network, address, err := ParseEndpoint(tt.endpoint)
+		assert.Equal(t, tt.wantErr, err != nil, tt.endpoint)
+		assert.Equal(t, tt.network, network, tt.endpoint)
+		assert.Equal(t, tt.address, address, tt.endpoint)
+	}
+}
+
+func TestSignTx(t *testing.T) {
+	privkey, _ := btcec.NewPrivateKey(btcec.S256())
+	address := key.GenerateAddress(&privkey.PublicKey)
+	_, endpoint, stop := startTestSigner(t, privkey)
+	defer stop()
+
+	client, err := NewClient(endpoint)
+	assert.NoError(t, err)
+	defer client.Close()
+	addrs, err := client.Addresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []key.Address{address}, addrs)
+
+	dir, _ := ioutil.TempDir("", "extsigner")
+	defer os.RemoveAll(dir)
+	ks := key.NewStore(dir, dir, 0)
+	defer ks.CloseStore()
+
+	tx := &types.Tx{Body: &types.TxBody{Account: address, Amount: []byte{1}}}
+	assert.Equal(t, types.ErrShouldUnlockAccount, ks.SignTx(tx, nil))
+
+	// accounts not allowed to signer must be unlocked
+	ks.SetSigner(client, nil)
+	assert.Equal(t, types.ErrShouldUnlockAccount, ks.SignTx(tx, nil))
+
+	ks.SetSigner(client, []key.Address{address})
+	assert.NoError(t, ks.SignTx(tx, nil))
+	assert.NoError(t, key.VerifyTx(tx))
+	assert.Equal(t, tx.CalculateTxHash(), tx.Hash)
+
+	// signer does not hold the key of other account
+	other, _ := btcec.NewPrivateKey(btcec.S256())
+	otherAddress := key.GenerateAddress(&other.PublicKey)
+	ks.SetSigner(client, []key.Address{address, otherAddress})
+	tx = &types.Tx{Body: &types.TxBody{Account: otherAddress}}
+	assert.Error(t, ks.SignTx(tx, nil))
+}
+
+func TestBlockSigner(t *testing.T) {
+	key1, _ := btcec.NewPrivateKey(btcec.S256())
+	key2, _ := btcec.NewPrivateKey(btcec.S256())
+	server, endpoint, stop := startTestSigner(t, key1, key2)
+	defer stop()
+
+	client, _ := NewClient(endpoint)
+	defer client.Close()
+	_, err := NewBlockSigner(client, nil)
+	assert.Error(t, err, "address of bp key is required for signer holding several keys")
+	signer, err := NewBlockSigner(client, key.GenerateAddress(&key2.PublicKey))
+	assert.NoError(t, err)
+
+	block := types.NewBlock(nil, nil, nil, nil, nil, 0)
+	assert.NoError(t, block.Sign(signer))
+	valid, err := block.VerifySign()
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// client reconnects to restarted signer
+	server.Close()
+	assert.NoError(t, server.Listen(endpoint))
+	assert.NoError(t, block.Sign(signer))
+}
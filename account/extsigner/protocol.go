@@ -0,0 +1,81 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package extsigner implements the protocol to sign with private keys held outside of the node, such as
+// an hsm bridge or a remote signer process.
+//
+// The node connects to the signer through a local unix socket (or tcp for a bridge on loopback), and
+// sends newline delimited json requests. The protocol has no authentication nor encryption, so tcp endpoints
+// other than loopback are refused. Each request is answered by one response with the same id.
+//
+//	{"id":1,"method":"addresses"}
+//	{"id":1,"addresses":["AmN...","AmP..."]}
+//	{"id":2,"method":"sign","address":"AmN...","hash":"<hex of 32 bytes>"}
+//	{"id":2,"signature":"<hex of DER signature>"}
+//
+// The hash is already digested (sha256) by the node, and the signer must sign it as is with secp256k1.
+package extsigner
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	MethodAddresses = "addresses"
+	MethodSign      = "sign"
+)
+
+var (
+	ErrUnknownAddress = errors.New("signer does not hold the key of address")
+	ErrUnknownMethod  = errors.New("unknown method")
+	ErrNotLoopback    = errors.New("tcp endpoint of signer must be a loopback address")
+)
+
+// Request is the message from node to signer
+type Request struct {
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Address string `json:"address,omitempty"`
+	Hash    string `json:"hash,omitempty"`
+}
+
+// Response is the message from signer to node. Error is not empty if the request failed.
+type Response struct {
+	ID        uint64   `json:"id"`
+	Addresses []string `json:"addresses,omitempty"`
+	Signature string   `json:"signature,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// ParseEndpoint splits endpoint like unix:///var/run/signer.sock or tcp://127.0.0.1:7846 to network and address.
+// Endpoint without scheme is regarded as path of unix socket. The host of tcp endpoint must be a loopback address.
+func ParseEndpoint(endpoint string) (network string, address string, err error) {
+	idx := strings.Index(endpoint, "://")
+	if idx < 0 {
+		return "unix", endpoint, nil
+	}
+	network, address = endpoint[:idx], endpoint[idx+3:]
+	switch network {
+	case "unix", "tcp":
+	default:
+		return "", "", fmt.Errorf("unsupported signer network %s", network)
+	}
+	if address == "" {
+		return "", "", fmt.Errorf("invalid signer endpoint %s", endpoint)
+	}
+	if network == "tcp" {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid signer endpoint %s", endpoint)
+		}
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return "", "", ErrNotLoopback
+		}
+	}
+	return network, address, nil
+}
@@ -0,0 +1,142 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package extsigner
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"sync"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// Server is the reference signer holding keys in memory. It is a stand-in of hsm bridge for tests and
+// development, and must not be used for keys of value.
+type Server struct {
+	keys  map[string]*btcec.PrivateKey
+	order []string
+
+	mutex    sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	wg       sync.WaitGroup
+}
+
+// NewServer returns the reference signer with keys.
+func NewServer(keys ...*btcec.PrivateKey) *Server {
+	s := &Server{keys: make(map[string]*btcec.PrivateKey), conns: make(map[net.Conn]struct{})}
+	for _, k := range keys {
+		address := types.EncodeAddress(key.GenerateAddress(&k.PublicKey))
+		s.keys[address] = k
+		s.order = append(s.order, address)
+	}
+	return s
+}
+
+// Listen starts serving at endpoint, and returns immediately.
+func (s *Server) Listen(endpoint string) error {
+	network, address, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	s.listener = l
+	s.mutex.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.mutex.Lock()
+			s.conns[conn] = struct{}{}
+			s.mutex.Unlock()
+			s.wg.Add(1)
+			go s.serve(conn)
+		}
+	}()
+	return nil
+}
+
+// Close stops listening and closes all connections.
+func (s *Server) Close() {
+	s.mutex.Lock()
+	if s.listener != nil {
+		s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mutex.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, conn)
+		s.mutex.Unlock()
+		conn.Close()
+		s.wg.Done()
+	}()
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+		req := &Request{}
+		rsp := &Response{}
+		if err = json.Unmarshal(line, req); err != nil {
+			rsp.Error = err.Error()
+		} else {
+			rsp = s.handle(req)
+		}
+		data, _ := json.Marshal(rsp)
+		if _, err = conn.Write(append(data, '\n')); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) handle(req *Request) *Response {
+	rsp := &Response{ID: req.ID}
+	switch req.Method {
+	case MethodAddresses:
+		rsp.Addresses = s.order
+	case MethodSign:
+		k, exist := s.keys[req.Address]
+		if !exist {
+			rsp.Error = ErrUnknownAddress.Error()
+			break
+		}
+		hash, err := hex.DecodeString(req.Hash)
+		if err != nil {
+			rsp.Error = err.Error()
+			break
+		}
+		sig, err := k.Sign(hash)
+		if err != nil {
+			rsp.Error = err.Error()
+			break
+		}
+		rsp.Signature = hex.EncodeToString(sig.Serialize())
+	default:
+		rsp.Error = ErrUnknownMethod.Error()
+	}
+	return rsp
+}
@@ -30,6 +30,8 @@ func GenerateAddress(pubkey *ecdsa.PublicKey) []byte {
 	return addr.Bytes() // 33 bytes
 }
 
+// SaveAddress adds address to the address list of legacy database. Keys saved in keystore files are listed
+// without it.
 func (ks *Store) SaveAddress(addr Address) error {
 	if len(addr) != types.AddressLength {
 		return errors.New("invalid address length")
@@ -39,11 +41,29 @@ func (ks *Store) SaveAddress(addr Address) error {
 	return nil
 }
 
+func (ks *Store) removeAddress(addr Address) {
+	b := ks.storage.Get(addresses)
+	remains := make([]byte, 0, len(b))
+	for i := 0; i+types.AddressLength <= len(b); i += types.AddressLength {
+		if !bytes.Equal(b[i:i+types.AddressLength], addr) {
+			remains = append(remains, b[i:i+types.AddressLength]...)
+		}
+	}
+	ks.storage.Set(addresses, remains)
+}
+
+// GetAddresses returns addresses of keystore files and of keys not migrated yet in legacy database.
 func (ks *Store) GetAddresses() ([]Address, error) {
+	ret, err := ks.keystore.addresses()
+	if err != nil {
+		return nil, err
+	}
 	b := ks.storage.Get(addresses)
-	var ret []Address
-	for i := 0; i < len(b); i += types.AddressLength {
-		ret = append(ret, b[i:i+types.AddressLength])
+	for i := 0; i+types.AddressLength <= len(b); i += types.AddressLength {
+		addr := b[i : i+types.AddressLength]
+		if !ks.keystore.exist(addr) {
+			ret = append(ret, addr)
+		}
 	}
 	return ret, nil
 }
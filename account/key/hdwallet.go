@@ -0,0 +1,265 @@
+package key
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	bip39 "github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// HDPathPrefix is the derivation path of aergo accounts. 441 is the coin type of aergo registered in SLIP-0044.
+	// The index of account is appended to it.
+	HDPathPrefix = "m/44'/441'/0'/0"
+	// MaxHDAccounts is the max count of accounts derived at once
+	MaxHDAccounts = 20
+
+	hdWalletFile   = "hdwallet.json"
+	mnemonicBits   = 256
+	hardenedOffset = uint32(0x80000000)
+)
+
+var (
+	ErrInvalidMnemonic  = errors.New("invalid mnemonic")
+	ErrHDWalletExist    = errors.New("hd wallet already exists")
+	ErrHDWalletNotExist = errors.New("hd wallet not exists, create or recover it first")
+	ErrInvalidHDPath    = errors.New("invalid derivation path")
+	ErrInvalidHDCount   = fmt.Errorf("count of accounts must be 1 to %d", MaxHDAccounts)
+)
+
+// NewMnemonic generates BIP-39 mnemonic of 24 words
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// MnemonicToSeed validates mnemonic and returns BIP-39 seed of it. The passphrase of seed is not used.
+func MnemonicToSeed(mnemonic string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	return bip39.NewSeed(mnemonic, ""), nil
+}
+
+// extendedKey is a private key of BIP-32 with its chain code
+type extendedKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+func newMasterKey(seed []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	if !validPrivKey(sum[:32]) {
+		return nil, errors.New("unusable seed")
+	}
+	return &extendedKey{key: sum[:32], chainCode: sum[32:]}, nil
+}
+
+// child derives private child key of BIP-32. index equal or over hardenedOffset derives hardened key.
+func (ek *extendedKey) child(index uint32) (*extendedKey, error) {
+	data := make([]byte, 0, 37)
+	if index >= hardenedOffset {
+		data = append(data, 0x0)
+		data = append(data, ek.key...)
+	} else {
+		_, pub := btcec.PrivKeyFromBytes(btcec.S256(), ek.key)
+		data = append(data, pub.SerializeCompressed()...)
+	}
+	data = append(data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[len(data)-4:], index)
+
+	mac := hmac.New(sha512.New, ek.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	if !validPrivKey(sum[:32]) {
+		return nil, fmt.Errorf("invalid child key at %d", index)
+	}
+	n := btcec.S256().N
+	childKey := new(big.Int).SetBytes(sum[:32])
+	childKey.Add(childKey, new(big.Int).SetBytes(ek.key))
+	childKey.Mod(childKey, n)
+	if childKey.Sign() == 0 {
+		return nil, fmt.Errorf("invalid child key at %d", index)
+	}
+	keyBytes := make([]byte, 32)
+	b := childKey.Bytes()
+	copy(keyBytes[32-len(b):], b)
+	return &extendedKey{key: keyBytes, chainCode: sum[32:]}, nil
+}
+
+func validPrivKey(key []byte) bool {
+	k := new(big.Int).SetBytes(key)
+	return k.Sign() > 0 && k.Cmp(btcec.S256().N) < 0
+}
+
+// parseHDPath parses derivation path like m/44'/441'/0'/0 to child indexes.
+func parseHDPath(path string) ([]uint32, error) {
+	elems := strings.Split(path, "/")
+	if len(elems) == 0 || elems[0] != "m" {
+		return nil, ErrInvalidHDPath
+	}
+	indexes := make([]uint32, 0, len(elems)-1)
+	for _, elem := range elems[1:] {
+		offset := uint32(0)
+		if strings.HasSuffix(elem, "'") {
+			offset = hardenedOffset
+			elem = strings.TrimSuffix(elem, "'")
+		}
+		idx, err := strconv.ParseUint(elem, 10, 31)
+		if err != nil {
+			return nil, ErrInvalidHDPath
+		}
+		indexes = append(indexes, uint32(idx)+offset)
+	}
+	return indexes, nil
+}
+
+// DeriveHDKey derives private key at path from BIP-39 seed.
+func DeriveHDKey(seed []byte, path string) (*btcec.PrivateKey, error) {
+	indexes, err := parseHDPath(path)
+	if err != nil {
+		return nil, err
+	}
+	ek, err := newMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range indexes {
+		if ek, err = ek.child(idx); err != nil {
+			return nil, err
+		}
+	}
+	privkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), ek.key)
+	return privkey, nil
+}
+
+// HDAccountPath returns the derivation path of account at index
+func HDAccountPath(index uint32) string {
+	return fmt.Sprintf("%s/%d", HDPathPrefix, index)
+}
+
+// hdWallet is saved in the keystore directory. The seed is encrypted in the keystore format.
+type hdWallet struct {
+	Version int             `json:"version"`
+	Path    string          `json:"path"`
+	Next    uint32          `json:"next"`
+	Seed    json.RawMessage `json:"seed"`
+}
+
+func (kd *keystoreDir) readHDWallet() (*hdWallet, error) {
+	data, err := ioutil.ReadFile(filepath.Join(kd.path, hdWalletFile))
+	if os.IsNotExist(err) {
+		return nil, ErrHDWalletNotExist
+	} else if err != nil {
+		return nil, err
+	}
+	w := &hdWallet{}
+	if err = json.Unmarshal(data, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (kd *keystoreDir) writeHDWallet(w *hdWallet) error {
+	data, err := json.MarshalIndent(w, "", "  ")
+	if err != nil {
+		return err
+	}
+	return kd.writeFile(hdWalletFile, data)
+}
+
+// CreateHDWallet generates new mnemonic, saves its seed encrypted with pass, and derives first count accounts.
+// The mnemonic must be kept by user, since it is not saved.
+func (ks *Store) CreateHDWallet(pass string, count int) (string, []Address, error) {
+	mnemonic, err := NewMnemonic()
+	if err != nil {
+		return "", nil, err
+	}
+	addrs, err := ks.RecoverHDWallet(mnemonic, pass, count)
+	if err != nil {
+		return "", nil, err
+	}
+	return mnemonic, addrs, nil
+}
+
+// RecoverHDWallet saves the seed of mnemonic encrypted with pass, and derives first count accounts.
+func (ks *Store) RecoverHDWallet(mnemonic string, pass string, count int) ([]Address, error) {
+	if count < 1 || count > MaxHDAccounts {
+		return nil, ErrInvalidHDCount
+	}
+	if _, err := ks.keystore.readHDWallet(); err != ErrHDWalletNotExist {
+		if err == nil {
+			err = ErrHDWalletExist
+		}
+		return nil, err
+	}
+	seed, err := MnemonicToSeed(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	encSeed, err := EncryptKeystore(seed, nil, pass, ks.kdf)
+	if err != nil {
+		return nil, err
+	}
+	w := &hdWallet{Version: KeystoreVersion, Path: HDPathPrefix, Seed: encSeed}
+	return ks.deriveHDAccounts(w, seed, pass, count)
+}
+
+// DeriveHDAccounts derives next count accounts from the seed of hd wallet.
+func (ks *Store) DeriveHDAccounts(pass string, count int) ([]Address, error) {
+	if count < 1 || count > MaxHDAccounts {
+		return nil, ErrInvalidHDCount
+	}
+	w, err := ks.keystore.readHDWallet()
+	if err != nil {
+		return nil, err
+	}
+	_, seed, err := DecryptKeystore(w.Seed, pass)
+	if err == ErrKeystoreChecksum {
+		return nil, types.ErrWrongAddressOrPassWord
+	} else if err != nil {
+		return nil, err
+	}
+	return ks.deriveHDAccounts(w, seed, pass, count)
+}
+
+func (ks *Store) deriveHDAccounts(w *hdWallet, seed []byte, pass string, count int) ([]Address, error) {
+	addrs := make([]Address, 0, count)
+	for i := 0; i < count; i++ {
+		privkey, err := DeriveHDKey(seed, fmt.Sprintf("%s/%d", w.Path, w.Next))
+		if err != nil {
+			return nil, err
+		}
+		w.Next++
+		address := GenerateAddress(&privkey.PublicKey)
+		// account can be already in store, if it was imported or wallet is recovered again
+		if !ks.keystore.exist(address) {
+			if err = ks.saveKeystore(address, privkey.Serialize(), pass); err != nil {
+				return nil, err
+			}
+		}
+		addrs = append(addrs, address)
+	}
+	if err := ks.keystore.writeHDWallet(w); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
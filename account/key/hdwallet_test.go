@@ -0,0 +1,70 @@
+package key
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// test vector 1 of BIP-32
+func TestDeriveHDKey(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path string
+		key  string
+	}{
+		{"m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0'/1/2'", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+	}
+	for _, tt := range tests {
+		privkey, err := DeriveHDKey(seed, tt.path)
+		assert.NoError(t, err, tt.path)
+		assert.Equal(t, tt.key, hex.EncodeToString(privkey.Serialize()), tt.path)
+	}
+
+	for _, path := range []string{"", "0'/1", "m/a", "m/2147483648"} {
+		_, err := DeriveHDKey(seed, path)
+		assert.Equal(t, ErrInvalidHDPath, err, path)
+	}
+}
+
+func TestHDWalletCreateRecover(t *testing.T) {
+	initTest()
+	defer deinitTest()
+
+	_, err := ks.DeriveHDAccounts("pass", 1)
+	assert.Equal(t, ErrHDWalletNotExist, err)
+
+	words, addrs, err := ks.CreateHDWallet("pass", 2)
+	assert.NoError(t, err)
+	assert.Len(t, addrs, 2)
+	_, _, err = ks.CreateHDWallet("pass", 1)
+	assert.Equal(t, ErrHDWalletExist, err)
+
+	next, err := ks.DeriveHDAccounts("pass", 1)
+	assert.NoError(t, err)
+	addrs = append(addrs, next...)
+	_, err = ks.DeriveHDAccounts("wrong", 1)
+	assert.Error(t, err)
+
+	// derived accounts are usable as normal accounts
+	_, err = ks.Unlock(addrs[2], "pass")
+	assert.NoError(t, err)
+
+	// other store recovers the same accounts from mnemonic
+	otherDir, _ := ioutil.TempDir("", "test")
+	other := NewStore(otherDir, otherDir, 0)
+	defer other.CloseStore()
+	_, err = other.RecoverHDWallet("invalid mnemonic words", "pass2", 3)
+	assert.Equal(t, ErrInvalidMnemonic, err)
+	recovered, err := other.RecoverHDWallet(words, "pass2", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, addrs, recovered)
+
+	_, err = other.RecoverHDWallet(words, "pass2", MaxHDAccounts+1)
+	assert.Equal(t, ErrInvalidHDCount, err)
+}
@@ -0,0 +1,299 @@
+package key
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aergoio/aergo/types"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KeystoreVersion is the version of keystore file format
+	KeystoreVersion = 1
+
+	KDFScrypt   = "scrypt"
+	KDFArgon2id = "argon2id"
+
+	cipherAlgorithm = "aes-256-gcm"
+	keystoreSuffix  = "__keystore.json"
+
+	derivedKeyLen = 64
+	saltLen       = 32
+
+	// upper bounds of kdf cost in keystore files, so that a crafted file can't exhaust memory or cpu of node.
+	// they allow 4 times of the standard cost.
+	maxScryptN      = 1 << 20
+	maxScryptR      = 8
+	maxScryptP      = 4
+	maxArgon2Time   = 12
+	maxArgon2Memory = 256 * 1024
+	maxArgon2Thread = 16
+)
+
+var (
+	ErrKeystoreVersion  = errors.New("unsupported keystore version")
+	ErrKeystoreChecksum = errors.New("keystore checksum mismatch: wrong password or broken file")
+	ErrUnsupportedKDF   = errors.New("unsupported kdf")
+	ErrKDFParams        = errors.New("kdf parameters out of bounds")
+)
+
+// kdfParams is the cost parameters of kdf. Unused fields of the other algorithm are omitted in file.
+type kdfParams struct {
+	// scrypt
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+	// argon2id
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+var (
+	standardScrypt = kdfParams{N: 1 << 18, R: 8, P: 1, DKLen: derivedKeyLen}
+	standardArgon2 = kdfParams{Time: 3, Memory: 64 * 1024, Threads: 4, DKLen: derivedKeyLen}
+
+	// cost of kdf for new keystore files. tests lower them.
+	scryptCost = standardScrypt
+	argon2Cost = standardArgon2
+)
+
+type keystoreKDF struct {
+	Algorithm string    `json:"algorithm"`
+	Params    kdfParams `json:"params"`
+}
+
+type keystoreCipher struct {
+	Algorithm  string `json:"algorithm"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// KeystoreFile is the json format of an encrypted private key. The first half of the key derived from
+// passphrase encrypts the private key, and the other half makes checksum of the cipher text, so that
+// wrong passphrase is detected before decryption.
+type KeystoreFile struct {
+	Version  int            `json:"version"`
+	Address  string         `json:"address"`
+	KDF      keystoreKDF    `json:"kdf"`
+	Cipher   keystoreCipher `json:"cipher"`
+	Checksum string         `json:"checksum"`
+}
+
+// EncryptKeystore encrypts the private key with passphrase and returns the keystore file contents.
+func EncryptKeystore(privkey []byte, address Address, pass string, kdf string) ([]byte, error) {
+	var params kdfParams
+	switch kdf {
+	case KDFScrypt, "":
+		kdf, params = KDFScrypt, scryptCost
+	case KDFArgon2id:
+		params = argon2Cost
+	default:
+		return nil, ErrUnsupportedKDF
+	}
+	salt := make([]byte, saltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	params.Salt = hex.EncodeToString(salt)
+	derived, err := deriveKey(kdf, params, pass)
+	if err != nil {
+		return nil, err
+	}
+
+	aesgcm, err := newGCM(derived[:32])
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aesgcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	ciphertext := aesgcm.Seal(nil, nonce, privkey, nil)
+
+	ksFile := &KeystoreFile{
+		Version: KeystoreVersion,
+		Address: types.EncodeAddress(address),
+		KDF:     keystoreKDF{Algorithm: kdf, Params: params},
+		Cipher: keystoreCipher{Algorithm: cipherAlgorithm, Nonce: hex.EncodeToString(nonce),
+			Ciphertext: hex.EncodeToString(ciphertext)},
+		Checksum: hex.EncodeToString(checksum(derived, ciphertext)),
+	}
+	return json.MarshalIndent(ksFile, "", "  ")
+}
+
+// DecryptKeystore decrypts the keystore file contents with passphrase and returns the address and the private key.
+func DecryptKeystore(data []byte, pass string) (Address, []byte, error) {
+	ksFile := &KeystoreFile{}
+	if err := json.Unmarshal(data, ksFile); err != nil {
+		return nil, nil, err
+	}
+	if ksFile.Version != KeystoreVersion {
+		return nil, nil, ErrKeystoreVersion
+	}
+	if ksFile.Cipher.Algorithm != cipherAlgorithm {
+		return nil, nil, fmt.Errorf("unsupported cipher %s", ksFile.Cipher.Algorithm)
+	}
+	// keystore of hd wallet seed has no address
+	var address Address
+	if ksFile.Address != "" {
+		var err error
+		if address, err = types.DecodeAddress(ksFile.Address); err != nil {
+			return nil, nil, err
+		}
+	}
+	nonce, err := hex.DecodeString(ksFile.Cipher.Nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext, err := hex.DecodeString(ksFile.Cipher.Ciphertext)
+	if err != nil {
+		return nil, nil, err
+	}
+	expected, err := hex.DecodeString(ksFile.Checksum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	derived, err := deriveKey(ksFile.KDF.Algorithm, ksFile.KDF.Params, pass)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(checksum(derived, ciphertext), expected) {
+		return nil, nil, ErrKeystoreChecksum
+	}
+	aesgcm, err := newGCM(derived[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(nonce) != aesgcm.NonceSize() {
+		return nil, nil, errors.New("invalid nonce length")
+	}
+	privkey, err := aesgcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return address, privkey, nil
+}
+
+// IsKeystore reports whether data looks like a json keystore file rather than the legacy export format.
+func IsKeystore(data []byte) bool {
+	trimmed := bytes.TrimSpace(data)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+func deriveKey(kdf string, params kdfParams, pass string) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if params.DKLen != derivedKeyLen {
+		return nil, fmt.Errorf("invalid derived key length %d", params.DKLen)
+	}
+	switch kdf {
+	case KDFScrypt:
+		if params.N > maxScryptN || params.R > maxScryptR || params.P > maxScryptP {
+			return nil, ErrKDFParams
+		}
+		return scrypt.Key([]byte(pass), salt, params.N, params.R, params.P, params.DKLen)
+	case KDFArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, errors.New("invalid argon2id parameters")
+		}
+		if params.Time > maxArgon2Time || params.Memory > maxArgon2Memory || params.Threads > maxArgon2Thread {
+			return nil, ErrKDFParams
+		}
+		return argon2.IDKey([]byte(pass), salt, params.Time, params.Memory, params.Threads, uint32(params.DKLen)), nil
+	default:
+		return nil, ErrUnsupportedKDF
+	}
+}
+
+func checksum(derived []byte, ciphertext []byte) []byte {
+	return hashBytes(derived[32:], ciphertext)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// keystoreDir keeps one keystore file for each address.
+type keystoreDir struct {
+	path string
+}
+
+func (kd *keystoreDir) filePath(address Address) string {
+	return filepath.Join(kd.path, types.EncodeAddress(address)+keystoreSuffix)
+}
+
+func (kd *keystoreDir) exist(address Address) bool {
+	_, err := os.Stat(kd.filePath(address))
+	return err == nil
+}
+
+func (kd *keystoreDir) read(address Address) ([]byte, error) {
+	data, err := ioutil.ReadFile(kd.filePath(address))
+	if os.IsNotExist(err) {
+		return nil, types.ErrWrongAddressOrPassWord
+	}
+	return data, err
+}
+
+func (kd *keystoreDir) write(address Address, data []byte) error {
+	return kd.writeFile(filepath.Base(kd.filePath(address)), data)
+}
+
+// writeFile saves the file in keystore directory. It writes to temporary file first, not to leave broken key file.
+func (kd *keystoreDir) writeFile(name string, data []byte) error {
+	if err := os.MkdirAll(kd.path, 0700); err != nil {
+		return err
+	}
+	path := filepath.Join(kd.path, name)
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// addresses returns the addresses of keystore files in directory.
+func (kd *keystoreDir) addresses() ([]Address, error) {
+	files, err := ioutil.ReadDir(kd.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var ret []Address
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), keystoreSuffix) {
+			continue
+		}
+		addr, err := types.DecodeAddress(strings.TrimSuffix(f.Name(), keystoreSuffix))
+		if err != nil {
+			continue
+		}
+		ret = append(ret, addr)
+	}
+	return ret, nil
+}
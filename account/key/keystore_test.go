@@ -0,0 +1,118 @@
+package key
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeystoreEncryptDecrypt(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	for _, kdf := range []string{KDFScrypt, KDFArgon2id} {
+		privkey, _ := btcec.NewPrivateKey(btcec.S256())
+		address := GenerateAddress(&privkey.PublicKey)
+
+		data, err := EncryptKeystore(privkey.Serialize(), address, "pass", kdf)
+		assert.NoError(t, err, kdf)
+		assert.True(t, IsKeystore(data))
+
+		ksFile := &KeystoreFile{}
+		assert.NoError(t, json.Unmarshal(data, ksFile))
+		assert.Equal(t, KeystoreVersion, ksFile.Version)
+		assert.Equal(t, kdf, ksFile.KDF.Algorithm)
+		assert.Equal(t, types.EncodeAddress(address), ksFile.Address)
+
+		decAddr, decKey, err := DecryptKeystore(data, "pass")
+		assert.NoError(t, err, kdf)
+		assert.Equal(t, address, decAddr)
+		assert.Equal(t, privkey.Serialize(), decKey)
+
+		_, _, err = DecryptKeystore(data, "wrong")
+		assert.Equal(t, ErrKeystoreChecksum, err, kdf)
+	}
+	_, err := EncryptKeystore([]byte("key"), nil, "pass", "pbkdf2")
+	assert.Equal(t, ErrUnsupportedKDF, err)
+}
+
+func TestKeystoreKDFBounds(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	for _, kdf := range []string{KDFScrypt, KDFArgon2id} {
+		privkey, _ := btcec.NewPrivateKey(btcec.S256())
+		data, err := EncryptKeystore(privkey.Serialize(), GenerateAddress(&privkey.PublicKey), "pass", kdf)
+		assert.NoError(t, err, kdf)
+
+		ksFile := &KeystoreFile{}
+		assert.NoError(t, json.Unmarshal(data, ksFile))
+		if kdf == KDFScrypt {
+			ksFile.KDF.Params.N = maxScryptN << 1
+		} else {
+			ksFile.KDF.Params.Memory = maxArgon2Memory << 1
+		}
+		crafted, _ := json.Marshal(ksFile)
+		_, _, err = DecryptKeystore(crafted, "pass")
+		assert.Equal(t, ErrKDFParams, err, kdf)
+	}
+}
+
+func TestKeystoreFileStore(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	addr, err := ks.CreateKey("pass")
+	assert.NoError(t, err)
+	assert.True(t, ks.keystore.exist(addr))
+
+	addrs, err := ks.GetAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{addr}, addrs)
+
+	_, err = ks.Unlock(addr, "wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+	_, err = ks.Unlock(addr, "pass")
+	assert.NoError(t, err)
+
+	exported, err := ks.ExportKeystore(addr, "pass")
+	assert.NoError(t, err)
+	_, err = ks.ImportKey(exported, "pass", "newpass")
+	assert.Error(t, err, "already exist")
+}
+
+func TestMigrateLegacyKey(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	// save key in the legacy way
+	privkey, _ := btcec.NewPrivateKey(btcec.S256())
+	address := GenerateAddress(&privkey.PublicKey)
+	encryptkey := hashBytes(address, []byte("pass"))
+	encrypted, err := encrypt(address, encryptkey, privkey.Serialize())
+	assert.NoError(t, err)
+	ks.storage.Set(hashBytes(address, encryptkey), encrypted)
+	assert.NoError(t, ks.SaveAddress(address))
+
+	addrs, err := ks.GetAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{address}, addrs)
+	assert.False(t, ks.keystore.exist(address))
+
+	// migrated when it is used with passphrase
+	_, err = ks.Unlock(address, "wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+	_, err = ks.Unlock(address, "pass")
+	assert.NoError(t, err)
+	assert.True(t, ks.keystore.exist(address))
+	assert.Empty(t, ks.storage.Get(hashBytes(address, encryptkey)))
+
+	addrs, err = ks.GetAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(addrs))
+	assert.True(t, bytes.Equal(address, addrs[0]))
+
+	key, err := ks.getKey(address, "pass")
+	assert.NoError(t, err)
+	assert.Equal(t, privkey.Serialize(), key)
+}
@@ -33,7 +33,23 @@ func SignTx(tx *types.Tx, key *aergokey) error {
 	return nil
 }
 
+// Signer signs hash with the private key of address, which is held outside of the node
+// (e.g. by hsm bridge or remote signer process). It returns DER serialized signature.
+type Signer interface {
+	Sign(address Address, hash []byte) ([]byte, error)
+}
+
+// SetSigner sets the external signer used for accounts, which are not required to be unlocked in the store.
+func (ks *Store) SetSigner(signer Signer, accounts []Address) {
+	ks.signer = signer
+	ks.signerAccounts = make(map[string]bool, len(accounts))
+	for _, addr := range accounts {
+		ks.signerAccounts[types.EncodeAddress(addr)] = true
+	}
+}
+
 //SignTx return transaction which signed with unlocked key. if requester is nil, requester is assumed to tx.Account
+//If the key is not unlocked and the account is allowed to external signer, the signer signs it instead.
 func (ks *Store) SignTx(tx *types.Tx, requester []byte) error {
 	addr := tx.Body.Account
 	if requester != nil {
@@ -41,11 +57,30 @@ func (ks *Store) SignTx(tx *types.Tx, requester []byte) error {
 	}
 	keyPair, exist := ks.unlocked[types.EncodeAddress(addr)]
 	if !exist {
-		return types.ErrShouldUnlockAccount
+		if ks.signer == nil || !ks.signerAccounts[types.EncodeAddress(addr)] {
+			return types.ErrShouldUnlockAccount
+		}
+		return signTxExternal(tx, addr, ks.signer)
 	}
 	return SignTx(tx, keyPair.key)
 }
 
+func signTxExternal(tx *types.Tx, addr Address, signer Signer) error {
+	hash := CalculateHashWithoutSign(tx.Body)
+	sign, err := signer.Sign(addr, hash)
+	if err != nil {
+		return err
+	}
+	tx.Body.Sign = sign
+	// signer is out of node, so check that it signed with the right key
+	if err = VerifyTxWithAddress(tx, addr); err != nil {
+		tx.Body.Sign = nil
+		return err
+	}
+	tx.Hash = tx.CalculateTxHash()
+	return nil
+}
+
 //VerifyTx return result to varify sign
 func VerifyTx(tx *types.Tx) error {
 	return VerifyTxWithAddress(tx, tx.Body.Account)
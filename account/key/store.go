@@ -25,19 +25,40 @@ type keyPair struct {
 type Store struct {
 	timeout  time.Duration
 	unlocked map[string]*keyPair
-	storage  db.DB
+	keystore *keystoreDir
+	kdf      string
+	// signer signs with the keys held outside of the node. it can be nil.
+	signer Signer
+	// signerAccounts is the addresses allowed to be signed by signer without unlock
+	signerAccounts map[string]bool
+	// storage is the legacy key database. keys in it are moved to keystore files when they are used.
+	storage db.DB
 }
 
-// NewStore make new instance of keystore
-func NewStore(storePath string, unlockTimeout uint) *Store {
+// NewStore make new instance of keystore. Keys are saved as files in keystorePath, and the keys in the
+// legacy database under storePath are migrated to files when they are used with the passphrase.
+func NewStore(storePath string, keystorePath string, unlockTimeout uint) *Store {
 	const dbName = "account"
 	dbPath := path.Join(storePath, dbName)
 	return &Store{
 		timeout:  time.Duration(unlockTimeout) * time.Second,
 		unlocked: map[string]*keyPair{},
+		keystore: &keystoreDir{path: keystorePath},
+		kdf:      KDFScrypt,
 		storage:  db.NewDB(db.LevelImpl, dbPath),
 	}
 }
+
+// SetKDF changes the key derivation function of keystore files to be written.
+func (ks *Store) SetKDF(kdf string) error {
+	switch kdf {
+	case KDFScrypt, KDFArgon2id:
+		ks.kdf = kdf
+		return nil
+	default:
+		return ErrUnsupportedKDF
+	}
+}
 func (ks *Store) CloseStore() {
 	ks.unlocked = nil
 	ks.storage.Close()
@@ -53,11 +74,17 @@ func (ks *Store) CreateKey(pass string) (Address, error) {
 	return ks.addKey(privkey, pass)
 }
 
-//ImportKey is to import encrypted key
+//ImportKey is to import encrypted key. imported is either a json keystore file or the legacy export format.
 func (ks *Store) ImportKey(imported []byte, oldpass string, newpass string) (Address, error) {
-	hash := hashBytes([]byte(oldpass), nil)
-	rehash := hashBytes([]byte(oldpass), hash)
-	key, err := decrypt(hash, rehash, imported)
+	var key []byte
+	var err error
+	if IsKeystore(imported) {
+		_, key, err = DecryptKeystore(imported, oldpass)
+	} else {
+		hash := hashBytes([]byte(oldpass), nil)
+		rehash := hashBytes([]byte(oldpass), hash)
+		key, err = decrypt(hash, rehash, imported)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -72,14 +99,10 @@ func (ks *Store) ImportKey(imported []byte, oldpass string, newpass string) (Add
 			return nil, errors.New("already exist")
 		}
 	}
-	err = ks.SaveAddress(address)
-	if err != nil {
-		return nil, err
-	}
 	return ks.addKey(privkey, newpass)
 }
 
-//ExportKey is to export encrypted key
+//ExportKey is to export encrypted key in the legacy format
 func (ks *Store) ExportKey(addr Address, pass string) ([]byte, error) {
 	key, err := ks.getKey(addr, pass)
 	if key == nil {
@@ -88,6 +111,15 @@ func (ks *Store) ExportKey(addr Address, pass string) ([]byte, error) {
 	return EncryptKey(key, pass)
 }
 
+//ExportKeystore is to export the json keystore file of key
+func (ks *Store) ExportKeystore(addr Address, pass string) ([]byte, error) {
+	key, err := ks.getKey(addr, pass)
+	if key == nil {
+		return nil, err
+	}
+	return ks.keystore.read(addr)
+}
+
 // EncryptKey encrypts a key with a given export for exporting
 func EncryptKey(key []byte, pass string) ([]byte, error) {
 	hash := hashBytes([]byte(pass), nil)
@@ -138,27 +170,63 @@ func (ks *Store) Lock(addr Address, pass string) (Address, error) {
 }
 
 func (ks *Store) getKey(address []byte, pass string) ([]byte, error) {
+	if !ks.keystore.exist(address) {
+		return ks.migrateKey(address, pass)
+	}
+	data, err := ks.keystore.read(address)
+	if err != nil {
+		return nil, err
+	}
+	owner, key, err := DecryptKeystore(data, pass)
+	if err == ErrKeystoreChecksum {
+		return nil, types.ErrWrongAddressOrPassWord
+	} else if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(owner, address) {
+		return nil, errors.New("keystore file is not of the address")
+	}
+	return key, nil
+}
+
+// migrateKey moves the key in the legacy database to keystore file. It is done lazily, since the legacy
+// database can be read only with the passphrase.
+func (ks *Store) migrateKey(address []byte, pass string) ([]byte, error) {
 	encryptkey := hashBytes(address, []byte(pass))
-	key := ks.storage.Get(hashBytes(address, encryptkey))
+	dbKey := hashBytes(address, encryptkey)
+	key := ks.storage.Get(dbKey)
 	if cap(key) == 0 {
 		return nil, types.ErrWrongAddressOrPassWord
 	}
-	return decrypt(address, encryptkey, key)
+	plain, err := decrypt(address, encryptkey, key)
+	if err != nil {
+		return nil, err
+	}
+	if err = ks.saveKeystore(address, plain, pass); err != nil {
+		return nil, err
+	}
+	ks.storage.Delete(dbKey)
+	ks.removeAddress(address)
+	return plain, nil
 }
 
 func (ks *Store) addKey(key *btcec.PrivateKey, pass string) (Address, error) {
 	//gen new address
 	address := GenerateAddress(&key.PublicKey)
-	//save pass/address/key
-	encryptkey := hashBytes(address, []byte(pass))
-	encrypted, err := encrypt(address, encryptkey, key.Serialize())
-	if err != nil {
+	if err := ks.saveKeystore(address, key.Serialize(), pass); err != nil {
 		return nil, err
 	}
-	ks.storage.Set(hashBytes(address, encryptkey), encrypted)
 	return address, nil
 }
 
+func (ks *Store) saveKeystore(address Address, key []byte, pass string) error {
+	data, err := EncryptKeystore(key, address, pass, ks.kdf)
+	if err != nil {
+		return err
+	}
+	return ks.keystore.write(address, data)
+}
+
 func hashBytes(b1 []byte, b2 []byte) []byte {
 	h := sha256.New()
 	h.Write(b1)
@@ -15,7 +15,10 @@ var (
 
 func initTest() {
 	testDir, _ = ioutil.TempDir("", "test")
-	ks = NewStore(testDir, 0)
+	ks = NewStore(testDir, testDir, 0)
+	// light kdf cost not to slow down tests
+	scryptCost = kdfParams{N: 1 << 10, R: 8, P: 1, DKLen: derivedKeyLen}
+	argon2Cost = kdfParams{Time: 1, Memory: 1024, Threads: 1, DKLen: derivedKeyLen}
 }
 
 func deinitTest() {
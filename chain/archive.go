@@ -0,0 +1,440 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+)
+
+// The bodies and receipts of old blocks are moved out of chain DB by the retention policy. In archive mode, they are
+// appended to segment files, each of which has segmentBlocks blocks. The data file of segment has the records of
+// [len(body) uint32][body][len(receipts) uint32][receipts] compressed by snappy, and the index file has the offset
+// of each record as uint64. In prune mode, they are deleted. The header of block is kept in chain DB in any mode.
+const (
+	ArchiveNone  = "none"
+	ArchiveMove  = "archive"
+	ArchivePrune = "prune"
+
+	archiveDirName  = "archive"
+	segmentBlocks   = 10000
+	archiveBatch    = 1000
+	archiveInterval = 10 * time.Second
+)
+
+var (
+	archivedNoKey  = []byte(chainDBName + ".archivedNo")
+	archiveModeKey = []byte(chainDBName + ".archiveMode")
+
+	ErrInvalidArchiveMode = errors.New("invalid archive mode (none, archive or prune)")
+	ErrArchiveModeChanged = errors.New("archive mode can't be changed after blocks are archived")
+	ErrCorruptedSegment   = errors.New("corrupted segment of archived blocks")
+)
+
+// ErrBlockPruned reports that the body and receipts of block are deleted by the retention policy of node.
+type ErrBlockPruned struct {
+	no types.BlockNo
+}
+
+func (e ErrBlockPruned) Error() string {
+	return fmt.Sprintf("body and receipts of block are pruned: blockNo=%d", e.no)
+}
+
+type blockArchive struct {
+	sync.Mutex
+	mode     string
+	enabled  bool // false if blocks are not archived any more
+	dir      string
+	archived uint64 // bodies and receipts of blocks in [1, archived] are not in chain DB
+	segments map[uint64]*segment
+}
+
+type segment struct {
+	data *os.File
+	idx  *os.File
+}
+
+// openArchive opens the archive of chain DB. The mode of archived blocks can't be changed, but archiving can be
+// stopped by mode none.
+func (cdb *ChainDB) openArchive(dataDir string, mode string) error {
+	if mode == "" {
+		mode = ArchiveNone
+	}
+	if mode != ArchiveNone && mode != ArchiveMove && mode != ArchivePrune {
+		return ErrInvalidArchiveMode
+	}
+	a := &blockArchive{
+		mode:     mode,
+		enabled:  mode != ArchiveNone,
+		dir:      filepath.Join(dataDir, archiveDirName),
+		segments: make(map[uint64]*segment),
+	}
+	if b := cdb.store.Get(archivedNoKey); len(b) != 0 {
+		a.archived = types.BlockNoFromBytes(b)
+	}
+	if a.archived > 0 {
+		stored := string(cdb.store.Get(archiveModeKey))
+		if mode != ArchiveNone && mode != stored {
+			return ErrArchiveModeChanged
+		}
+		a.mode = stored
+	}
+	if a.mode == ArchiveMove {
+		common.PathMkdirAll(dataDir, archiveDirName)
+		if err := a.repair(); err != nil {
+			return err
+		}
+	}
+	cdb.archive = a
+
+	logger.Info().Str("mode", a.mode).Uint64("archived", a.archived).Msg("archive of blocks opened")
+	return nil
+}
+
+func (a *blockArchive) archivedNo() types.BlockNo {
+	return atomic.LoadUint64(&a.archived)
+}
+
+// isArchived returns true if the body and receipts of block are not in chain DB
+func (cdb *ChainDB) isArchived(blockNo types.BlockNo) bool {
+	return cdb.archive != nil && blockNo > 0 && blockNo <= cdb.archive.archivedNo()
+}
+
+// loadArchivedBody fills the body of archived block. The body of pruned block is left nil, since the header is still
+// needed by the lookups of consensus and peers.
+func (cdb *ChainDB) loadArchivedBody(block *types.Block) error {
+	no := block.GetHeader().GetBlockNo()
+	if !cdb.isArchived(no) || cdb.archive.mode == ArchivePrune {
+		return nil
+	}
+	body, _, err := cdb.archive.read(no)
+	if err != nil {
+		return err
+	}
+	block.Body = &types.BlockBody{}
+	return proto.Unmarshal(body, block.Body)
+}
+
+// loadArchivedReceipts returns the stored receipts of archived block
+func (cdb *ChainDB) loadArchivedReceipts(blockNo types.BlockNo) ([]byte, error) {
+	if cdb.archive.mode == ArchivePrune {
+		return nil, &ErrBlockPruned{no: blockNo}
+	}
+	_, receipts, err := cdb.archive.read(blockNo)
+	return receipts, err
+}
+
+// checkPruned returns error if the receipts of block are pruned
+func (cdb *ChainDB) checkPruned(blockNo types.BlockNo) error {
+	if cdb.isArchived(blockNo) && cdb.archive.mode == ArchivePrune {
+		return &ErrBlockPruned{no: blockNo}
+	}
+	return nil
+}
+
+// archiveBlocks moves the bodies and receipts of blocks up to toNo out of chain DB, at most archiveBatch blocks at
+// once. It returns the last archived block number.
+func (cdb *ChainDB) archiveBlocks(toNo types.BlockNo) (types.BlockNo, error) {
+	a := cdb.archive
+	from := a.archivedNo() + 1
+	if toNo < from {
+		return a.archivedNo(), nil
+	}
+	if toNo-from >= archiveBatch {
+		toNo = from + archiveBatch - 1
+	}
+
+	type archived struct {
+		key   []byte
+		block *types.Block
+	}
+	var blocks []archived
+	for no := from; no <= toNo; no++ {
+		var (
+			block    types.Block
+			receipts []byte
+		)
+		// blocks before the pivot of snapshot sync don't exist
+		if hash, err := cdb.getHashByNo(no); err == nil {
+			if err = cdb.loadData(cdb.blockKey(hash), &block); err != nil {
+				return a.archivedNo(), err
+			}
+			receipts = cdb.store.Get(cdb.receiptsKey(hash, no))
+			blocks = append(blocks, archived{key: hash, block: &block})
+		}
+		if a.mode == ArchiveMove {
+			body, err := proto.Marshal(block.GetBody())
+			if err != nil {
+				return a.archivedNo(), err
+			}
+			if err = a.append(no, body, receipts); err != nil {
+				return a.archivedNo(), err
+			}
+		}
+	}
+	if a.mode == ArchiveMove {
+		if err := a.sync(toNo); err != nil {
+			return a.archivedNo(), err
+		}
+	}
+
+	// readers get the body from segment before it is removed from chain DB
+	atomic.StoreUint64(&a.archived, toNo)
+
+	dbTx := cdb.store.NewTx()
+	for _, b := range blocks {
+		no := b.block.GetHeader().GetBlockNo()
+		b.block.Body = nil
+		data, err := proto.Marshal(b.block)
+		if err != nil {
+			dbTx.Discard()
+			return a.archivedNo(), err
+		}
+		dbTx.Set(cdb.blockKey(b.key), data)
+		dbTx.Delete(cdb.receiptsKey(b.key, no))
+	}
+	dbTx.Set(archiveModeKey, []byte(a.mode))
+	dbTx.Set(archivedNoKey, types.BlockNoToBytes(toNo))
+	dbTx.Commit()
+
+	return toNo, nil
+}
+
+func segmentNo(blockNo types.BlockNo) uint64 {
+	return blockNo / segmentBlocks
+}
+
+// firstOfSegment returns the first block number of segment. Genesis block is not archived.
+func firstOfSegment(segNo uint64) types.BlockNo {
+	if segNo == 0 {
+		return 1
+	}
+	return segNo * segmentBlocks
+}
+
+func (a *blockArchive) segmentPath(segNo uint64) string {
+	return filepath.Join(a.dir, fmt.Sprintf("blocks-%010d", segNo))
+}
+
+func (a *blockArchive) openSegment(segNo uint64) (*segment, error) {
+	a.Lock()
+	defer a.Unlock()
+
+	if seg, exists := a.segments[segNo]; exists {
+		return seg, nil
+	}
+	data, err := os.OpenFile(a.segmentPath(segNo)+".dat", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := os.OpenFile(a.segmentPath(segNo)+".idx", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	seg := &segment{data: data, idx: idx}
+	a.segments[segNo] = seg
+	return seg, nil
+}
+
+// repair truncates the records which are written after the last commit of chain DB
+func (a *blockArchive) repair() error {
+	next := a.archivedNo() + 1
+	segNo := segmentNo(next)
+	seg, err := a.openSegment(segNo)
+	if err != nil {
+		return err
+	}
+	count := int64(next - firstOfSegment(segNo))
+	end := int64(0)
+	if count > 0 {
+		if end, err = seg.recordEnd(count - 1); err != nil {
+			return err
+		}
+	}
+	if err = seg.idx.Truncate(count * 8); err != nil {
+		return err
+	}
+	if err = seg.data.Truncate(end); err != nil {
+		return err
+	}
+	// the next segment can be written, if the segment was full
+	_ = os.Remove(a.segmentPath(segNo+1) + ".dat")
+	_ = os.Remove(a.segmentPath(segNo+1) + ".idx")
+	return nil
+}
+
+func (a *blockArchive) append(blockNo types.BlockNo, body, receipts []byte) error {
+	segNo := segmentNo(blockNo)
+	seg, err := a.openSegment(segNo)
+	if err != nil {
+		return err
+	}
+	info, err := seg.data.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size()
+
+	var buf []byte
+	for _, b := range [][]byte{body, receipts} {
+		compressed := snappy.Encode(nil, b)
+		l := make([]byte, 4)
+		binary.LittleEndian.PutUint32(l, uint32(len(compressed)))
+		buf = append(append(buf, l...), compressed...)
+	}
+	if _, err = seg.data.WriteAt(buf, offset); err != nil {
+		return err
+	}
+	off := make([]byte, 8)
+	binary.LittleEndian.PutUint64(off, uint64(offset))
+	_, err = seg.idx.WriteAt(off, int64(blockNo-firstOfSegment(segNo))*8)
+	return err
+}
+
+// sync flushes the segments written up to blockNo
+func (a *blockArchive) sync(blockNo types.BlockNo) error {
+	segNo := segmentNo(blockNo)
+	for _, no := range []uint64{segNo - 1, segNo} {
+		if no > segNo {
+			// underflow of the first segment
+			continue
+		}
+		a.Lock()
+		seg, exists := a.segments[no]
+		a.Unlock()
+		if !exists {
+			continue
+		}
+		if err := seg.data.Sync(); err != nil {
+			return err
+		}
+		if err := seg.idx.Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *blockArchive) read(blockNo types.BlockNo) ([]byte, []byte, error) {
+	segNo := segmentNo(blockNo)
+	seg, err := a.openSegment(segNo)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset, err := seg.offset(int64(blockNo - firstOfSegment(segNo)))
+	if err != nil {
+		return nil, nil, err
+	}
+	var parts [2][]byte
+	for i := range parts {
+		l := make([]byte, 4)
+		if _, err = seg.data.ReadAt(l, offset); err != nil {
+			return nil, nil, ErrCorruptedSegment
+		}
+		compressed := make([]byte, binary.LittleEndian.Uint32(l))
+		if _, err = seg.data.ReadAt(compressed, offset+4); err != nil && err != io.EOF {
+			return nil, nil, ErrCorruptedSegment
+		}
+		if parts[i], err = snappy.Decode(nil, compressed); err != nil {
+			return nil, nil, ErrCorruptedSegment
+		}
+		offset += 4 + int64(len(compressed))
+	}
+	return parts[0], parts[1], nil
+}
+
+func (a *blockArchive) close() {
+	a.Lock()
+	defer a.Unlock()
+
+	for no, seg := range a.segments {
+		seg.data.Close()
+		seg.idx.Close()
+		delete(a.segments, no)
+	}
+}
+
+func (seg *segment) offset(i int64) (int64, error) {
+	off := make([]byte, 8)
+	if _, err := seg.idx.ReadAt(off, i*8); err != nil {
+		return 0, ErrCorruptedSegment
+	}
+	return int64(binary.LittleEndian.Uint64(off)), nil
+}
+
+// recordEnd returns the end offset of i-th record
+func (seg *segment) recordEnd(i int64) (int64, error) {
+	offset, err := seg.offset(i)
+	if err != nil {
+		return 0, err
+	}
+	for part := 0; part < 2; part++ {
+		l := make([]byte, 4)
+		if _, err = seg.data.ReadAt(l, offset); err != nil {
+			return 0, ErrCorruptedSegment
+		}
+		offset += 4 + int64(binary.LittleEndian.Uint32(l))
+	}
+	return offset, nil
+}
+
+// libNo returns the block number of the last irreversible block
+func (cs *ChainService) libNo() types.BlockNo {
+	if f, ok := cs.ChainConsensus.(consensus.FinalityAccessor); ok {
+		return f.LibNo()
+	}
+	return cs.getBestBlockNo()
+}
+
+// runArchiver archives the blocks which are retention blocks behind LIB, until quit is closed.
+func (cs *ChainService) runArchiver(retention uint64, quit <-chan interface{}, done chan<- interface{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(archiveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-quit:
+			return
+		}
+		for {
+			lib := cs.libNo()
+			if lib <= retention {
+				break
+			}
+			target := lib - retention
+			archived, err := cs.cdb.archiveBlocks(target)
+			if err != nil {
+				logger.Error().Err(err).Uint64("archived", archived).Msg("failed to archive blocks")
+				break
+			}
+			logger.Debug().Str("mode", cs.cdb.archive.mode).Uint64("archived", archived).Msg("blocks archived")
+			if archived >= target {
+				break
+			}
+			select {
+			case <-quit:
+				return
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package chain
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockArchiveSegment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	a := &blockArchive{mode: ArchiveMove, dir: dir, segments: make(map[uint64]*segment)}
+	defer a.close()
+
+	for no := uint64(1); no <= 3; no++ {
+		assert.NoError(t, a.append(no, []byte{byte(no)}, []byte{byte(no), byte(no)}))
+	}
+	// the record of the last block of segment 0 and the first one of segment 1
+	assert.NoError(t, a.append(segmentBlocks-1, []byte("last"), nil))
+	assert.NoError(t, a.append(segmentBlocks, []byte("first"), nil))
+	assert.NoError(t, a.sync(segmentBlocks))
+
+	body, receipts, err := a.read(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2}, body)
+	assert.Equal(t, []byte{2, 2}, receipts)
+
+	body, _, err = a.read(segmentBlocks)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("first"), body)
+
+	// the records after the last committed block are dropped
+	a.archived = 2
+	assert.NoError(t, a.repair())
+	_, _, err = a.read(3)
+	assert.Equal(t, ErrCorruptedSegment, err)
+	body, _, err = a.read(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2}, body)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/storage"
 	"github.com/aergoio/aergo/types"
 	"github.com/gogo/protobuf/proto"
 )
@@ -70,7 +71,9 @@ type ChainDB struct {
 	latest    atomic.Value //types.BlockNo
 	bestBlock atomic.Value // *types.Block
 	//	blocks []*types.Block
-	store db.DB
+	store   db.DB
+	layout  *storage.Layout
+	archive *blockArchive
 }
 
 func NewChainDB() *ChainDB {
@@ -88,11 +91,18 @@ func (cdb *ChainDB) NewTx() db.Transaction {
 	return cdb.store.NewTx()
 }
 
-func (cdb *ChainDB) Init(dbType string, dataDir string) error {
+// Init opens the chain DB. If prefixed is true and the DB is created, blocks, receipts and tx index are stored under
+// separate key prefixes.
+func (cdb *ChainDB) Init(dbType string, dataDir string, prefixed bool) error {
 	if cdb.store == nil {
 		dbPath := common.PathMkdirAll(dataDir, chainDBName)
-		cdb.store = db.NewDB(db.ImplType(dbType), dbPath)
+		store, err := storage.NewDB(dbType, dbPath)
+		if err != nil {
+			return err
+		}
+		cdb.store = store
 	}
+	cdb.layout = storage.OpenLayout(cdb.store, prefixed)
 
 	// load data
 	if err := cdb.loadChainData(); err != nil {
@@ -203,6 +213,9 @@ func (cdb *ChainDB) checkBlockDropped(dropBlock *types.Block) error {
 }
 
 func (cdb *ChainDB) Close() {
+	if cdb.archive != nil {
+		cdb.archive.close()
+	}
 	if cdb.store != nil {
 		cdb.store.Close()
 	}
@@ -373,7 +386,7 @@ func (cdb *ChainDB) connectToChain(dbtx *db.Transaction, block *types.Block, ski
 
 	// Update best block hash
 	(*dbtx).Set(latestKey, blockIdx)
-	(*dbtx).Set(blockIdx, block.BlockHash())
+	(*dbtx).Set(cdb.blockIdxKey(blockNo), block.BlockHash())
 
 	// Save the last consensus status.
 	if cdb.cc != nil {
@@ -409,7 +422,7 @@ func (cdb *ChainDB) swapChainMapping(newBlocks []*types.Block) error {
 		block := newBlocks[i]
 		blockIdx = types.BlockNoToBytes(block.GetHeader().GetBlockNo())
 
-		bulk.Set(blockIdx, block.BlockHash())
+		bulk.Set(cdb.blockIdxKey(block.GetHeader().GetBlockNo()), block.BlockHash())
 	}
 
 	bulk.Set(latestKey, blockIdx)
@@ -478,12 +491,12 @@ func (cdb *ChainDB) addTx(dbtx *db.Transaction, tx *types.Tx, blockHash []byte,
 	if err != nil {
 		return err
 	}
-	(*dbtx).Set(tx.Hash, txidxbytes)
+	(*dbtx).Set(cdb.txKey(tx.Hash), txidxbytes)
 	return nil
 }
 
 func (cdb *ChainDB) deleteTx(dbtx *db.Transaction, tx *types.Tx) {
-	(*dbtx).Delete(tx.Hash)
+	(*dbtx).Delete(cdb.txKey(tx.Hash))
 }
 
 // store block info to DB
@@ -506,7 +519,7 @@ func (cdb *ChainDB) addBlock(dbtx *db.Transaction, block *types.Block) error {
 	}
 
 	//add block
-	(*dbtx).Set(block.BlockHash(), blockBytes)
+	(*dbtx).Set(cdb.blockKey(block.BlockHash()), blockBytes)
 
 	return nil
 }
@@ -536,12 +549,11 @@ func (cdb *ChainDB) dropBlock(dropNo types.BlockNo) error {
 	cdb.deleteReceipts(&dbTx, dropBlock.BlockHash(), dropBlock.BlockNo())
 
 	// remove (hash/block)
-	dbTx.Delete(dropBlock.BlockHash())
+	dbTx.Delete(cdb.blockKey(dropBlock.BlockHash()))
 
 	// remove (no/hash)
-	dropIdx := types.BlockNoToBytes(dropNo)
 	newLatestIdx := types.BlockNoToBytes(dropNo - 1)
-	dbTx.Delete(dropIdx)
+	dbTx.Delete(cdb.blockIdxKey(dropNo))
 
 	// update latest
 	dbTx.Set(latestKey, newLatestIdx)
@@ -591,21 +603,26 @@ func (cdb *ChainDB) getBlock(blockHash []byte) (*types.Block, error) {
 		return nil, fmt.Errorf("block hash invalid(nil)")
 	}
 	buf := types.Block{}
-	err := cdb.loadData(blockHash, &buf)
+	err := cdb.loadData(cdb.blockKey(blockHash), &buf)
 	if err != nil || !bytes.Equal(buf.Hash, blockHash) {
 		return nil, &ErrNoBlock{id: blockHash}
 	}
+	// the body of archived block is moved out of chain DB. the block of which body is pruned has only its header.
+	if buf.Body == nil {
+		if err = cdb.loadArchivedBody(&buf); err != nil {
+			return nil, err
+		}
+	}
 
 	//logger.Debugf("getblockbyHash Hash=%v", enc.ToString(blockHash))
 	return &buf, nil
 }
 
 func (cdb *ChainDB) getHashByNo(blockNo types.BlockNo) ([]byte, error) {
-	blockIdx := types.BlockNoToBytes(blockNo)
 	if cdb.store == nil {
 		return nil, ErrNoChainDB
 	}
-	blockHash := cdb.store.Get(blockIdx)
+	blockHash := cdb.store.Get(cdb.blockIdxKey(blockNo))
 	if len(blockHash) == 0 {
 		return nil, &ErrNoBlock{id: blockNo}
 	}
@@ -615,7 +632,7 @@ func (cdb *ChainDB) getHashByNo(blockNo types.BlockNo) ([]byte, error) {
 func (cdb *ChainDB) getTx(txHash []byte) (*types.Tx, *types.TxIdx, error) {
 	txIdx := &types.TxIdx{}
 
-	err := cdb.loadData(txHash, txIdx)
+	err := cdb.loadData(cdb.txKey(txHash), txIdx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("tx not found: txHash=%v", enc.ToString(txHash))
 	}
@@ -623,6 +640,9 @@ func (cdb *ChainDB) getTx(txHash []byte) (*types.Tx, *types.TxIdx, error) {
 	if err != nil {
 		return nil, nil, &ErrNoBlock{txIdx.BlockHash}
 	}
+	if err = cdb.checkPruned(block.GetHeader().GetBlockNo()); err != nil {
+		return nil, nil, err
+	}
 	txs := block.GetBody().GetTxs()
 	if txIdx.Idx >= int32(len(txs)) {
 		return nil, nil, fmt.Errorf("wrong tx idx: %d", txIdx.Idx)
@@ -649,7 +669,13 @@ func (cdb *ChainDB) getReceipt(blockHash []byte, blockNo types.BlockNo, idx int3
 }
 
 func (cdb *ChainDB) getReceipts(blockHash []byte, blockNo types.BlockNo) (*types.Receipts, error) {
-	data := cdb.store.Get(receiptsKey(blockHash, blockNo))
+	data := cdb.store.Get(cdb.receiptsKey(blockHash, blockNo))
+	if len(data) == 0 && cdb.isArchived(blockNo) {
+		var err error
+		if data, err = cdb.loadArchivedReceipts(blockNo); err != nil {
+			return nil, err
+		}
+	}
 	if len(data) == 0 {
 		return nil, errors.New("cannot find a receipt")
 	}
@@ -696,23 +722,35 @@ func (cdb *ChainDB) writeReceipts(blockHash []byte, blockNo types.BlockNo, recei
 	gob := gob.NewEncoder(&val)
 	gob.Encode(receipts)
 
-	dbTx.Set(receiptsKey(blockHash, blockNo), val.Bytes())
+	dbTx.Set(cdb.receiptsKey(blockHash, blockNo), val.Bytes())
 
 	dbTx.Commit()
 }
 
 func (cdb *ChainDB) deleteReceipts(dbTx *db.Transaction, blockHash []byte, blockNo types.BlockNo) {
-	(*dbTx).Delete(receiptsKey(blockHash, blockNo))
+	(*dbTx).Delete(cdb.receiptsKey(blockHash, blockNo))
 }
 
-func receiptsKey(blockHash []byte, blockNo types.BlockNo) []byte {
+func (cdb *ChainDB) receiptsKey(blockHash []byte, blockNo types.BlockNo) []byte {
 	var key bytes.Buffer
 	key.Write(receiptsPrefix)
 	key.Write(blockHash)
 	l := make([]byte, 8)
 	binary.LittleEndian.PutUint64(l[:], blockNo)
 	key.Write(l)
-	return key.Bytes()
+	return cdb.layout.Key(storage.FamilyReceipt, key.Bytes())
+}
+
+func (cdb *ChainDB) blockKey(blockHash []byte) []byte {
+	return cdb.layout.Key(storage.FamilyBlock, blockHash)
+}
+
+func (cdb *ChainDB) blockIdxKey(blockNo types.BlockNo) []byte {
+	return cdb.layout.Key(storage.FamilyBlock, types.BlockNoToBytes(blockNo))
+}
+
+func (cdb *ChainDB) txKey(txHash []byte) []byte {
+	return cdb.layout.Key(storage.FamilyTxIndex, txHash)
 }
 
 func (cdb *ChainDB) writeReorgMarker(marker *ReorgMarker) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/contract/name"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/message"
@@ -110,6 +111,23 @@ func (cs *ChainService) getTx(txHash []byte) (*types.Tx, *types.TxIdx, error) {
 	return tx, txidx, err
 }
 
+func (cs *ChainService) getTxProof(txHash []byte) (*types.TxProof, error) {
+	tx, txidx, err := cs.getTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := cs.cdb.getBlock(txidx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	path := types.CalculateTxsMerkleProof(block.GetBody().GetTxs(), int(txidx.Idx))
+	if path == nil {
+		return nil, fmt.Errorf("tx index %d is out of block body", txidx.Idx)
+	}
+	return &types.TxProof{TxIdx: txidx, Tx: tx, Header: block.Header, MerklePath: path,
+		LeafCount: uint32(len(block.GetBody().GetTxs()))}, nil
+}
+
 func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	tx, i, err := cs.cdb.getTx(txHash)
 	if err != nil {
@@ -132,6 +150,32 @@ func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	return r, nil
 }
 
+func (cs *ChainService) getReceiptProof(txHash []byte) (*types.ReceiptProof, error) {
+	_, txidx, err := cs.getTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := cs.cdb.getBlock(txidx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := cs.cdb.getReceipts(txidx.BlockHash, block.GetHeader().BlockNo)
+	if err != nil {
+		return nil, err
+	}
+	path := receipts.MerkleProof(int(txidx.Idx))
+	if path == nil {
+		return nil, fmt.Errorf("cannot find a receipt: invalid index (%d)", txidx.Idx)
+	}
+	// events are kept as stored, since merkle hash of receipt is calculated before tx hash is set to events
+	r := receipts.Get()[txidx.Idx]
+	r.BlockNo = block.GetHeader().BlockNo
+	r.BlockHash = txidx.BlockHash
+	r.TxIndex = txidx.Idx
+	return &types.ReceiptProof{TxIdx: txidx, Receipt: r, Header: block.Header, MerklePath: path,
+		LeafCount: uint32(receipts.MerkleLeafCount())}, nil
+}
+
 func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, filter *types.FilterInfo,
 	argFilter []types.ArgFilter) uint64 {
 	blkHash, err := cs.cdb.getHashByNo(blkNo)
@@ -187,6 +231,16 @@ func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, er
 	if err != nil {
 		return nil, err
 	}
+	// genesis block is never archived
+	first := from
+	if first == 0 {
+		first = 1
+	}
+	if first <= to {
+		if err := cs.cdb.checkPruned(first); err != nil {
+			return nil, err
+		}
+	}
 	events := []*types.Event{}
 	var totalSize uint64
 	if filter.Desc {
@@ -526,6 +580,7 @@ type blockExecutor struct {
 	txs              []*types.Tx
 	validatePost     ValidatePostFn
 	coinbaseAcccount []byte
+	blockNo          types.BlockNo
 	commitOnly       bool
 	validateSignWait ValidateSignWaitFn
 }
@@ -565,6 +620,7 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		execTx:           exec,
 		txs:              block.GetBody().GetTxs(),
 		coinbaseAcccount: block.GetHeader().GetCoinbaseAccount(),
+		blockNo:          block.BlockNo(),
 		validatePost: func() error {
 			return cs.validator.ValidatePost(bState.GetRoot(), bState.Receipts(), block)
 		},
@@ -620,11 +676,15 @@ func (e *blockExecutor) execute() error {
 		}
 
 		//TODO check result of verifing txs
-		if err := SendRewardCoinbase(e.BlockState, e.coinbaseAcccount); err != nil {
+		if err := SendRewardCoinbase(e.BlockState, e.coinbaseAcccount, e.blockNo); err != nil {
+			return err
+		}
+
+		if err := ApplyProposals(e.BlockState, e.blockNo); err != nil {
 			return err
 		}
 
-		if err := contract.SaveRecoveryPoint(e.BlockState); err != nil {
+		if err := contract.SaveRecoveryPoint(e.BlockState, e.blockNo); err != nil {
 			return err
 		}
 
@@ -657,7 +717,8 @@ func (e *blockExecutor) commit() error {
 		return err
 	}
 
-	return nil
+	// the parameters applied by proposals take effect from the next block
+	return refreshParams(e.sdb)
 }
 
 // TODO: Refactoring: batch
@@ -697,6 +758,7 @@ func (cs *ChainService) executeBlock(bstate *state.BlockState, block *types.Bloc
 	cs.notifyEvents(block, ex.BlockState)
 
 	cs.Update(block)
+	cs.notifyAllowedPeers()
 
 	logger.Debug().Uint64("no", block.GetHeader().BlockNo).Msg("end to execute")
 
@@ -735,6 +797,7 @@ func (cs *ChainService) executeBlockReco(_ *state.BlockState, block *types.Block
 	}
 
 	cs.Update(block)
+	cs.notifyAllowedPeers()
 
 	logger.Debug().Uint64("no", block.GetHeader().BlockNo).Msg("end to execute for reco")
 
@@ -760,6 +823,13 @@ func (cs *ChainService) notifyEvents(block *types.Block, bstate *state.BlockStat
 			events = append(events, e)
 		}
 	}
+	// the events emitted at the end of block don't belong to any tx
+	for _, e := range bstate.BlockEvents() {
+		e.BlockHash = blkHash
+		e.BlockNo = blkNo
+		e.TxIndex = -1
+		events = append(events, e)
+	}
 
 	if len(events) != 0 {
 		cs.TellTo(message.RPCSvc, events)
@@ -785,18 +855,22 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 	if tx.HasVerifedAccount() {
 		account = tx.GetVerifedAccount()
 		tx.RemoveVerifedAccount()
-		resolvedAccount := name.Resolve(bs, txBody.GetAccount())
+		resolvedAccount := name.Resolve(bs, txBody.GetAccount(), blockNo)
 		if !bytes.Equal(account, resolvedAccount) {
 			return types.ErrSignNotMatch
 		}
 	} else {
-		account = name.Resolve(bs, txBody.GetAccount())
+		account = name.Resolve(bs, txBody.GetAccount(), blockNo)
 	}
 
 	err := tx.Validate(chainIDHash)
 	if err != nil {
 		return err
 	}
+	err = types.ValidateForkTx(txBody, blockNo)
+	if err != nil {
+		return err
+	}
 
 	sender, err := bs.GetAccountStateV(account)
 	if err != nil {
@@ -808,7 +882,7 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 		return err
 	}
 
-	recipient := name.Resolve(bs, txBody.Recipient)
+	recipient := name.Resolve(bs, txBody.Recipient, blockNo)
 	var receiver *state.V
 	var status string
 	if len(recipient) > 0 {
@@ -874,13 +948,20 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 	return bs.AddReceipt(receipt)
 }
 
-func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte) error {
+func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte, blockNo types.BlockNo) error {
 	bpReward := new(big.Int).SetBytes(bState.BpReward)
 	if bpReward.Cmp(new(big.Int).SetUint64(0)) <= 0 || coinbaseAccount == nil {
 		logger.Debug().Str("reward", new(big.Int).SetBytes(bState.BpReward).String()).Msg("coinbase is skipped")
 		return nil
 	}
 
+	if types.IsForkActive(types.ForkDelegation, blockNo) {
+		var err error
+		if bpReward, err = distributeReward(bState, coinbaseAccount, bpReward); err != nil {
+			return err
+		}
+	}
+
 	receiverID := types.ToAccountID(coinbaseAccount)
 	receiverState, err := bState.GetAccountState(receiverID)
 	if err != nil {
@@ -901,6 +982,37 @@ func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte) error
 	return nil
 }
 
+// distributeReward gives the reward to the delegators of bp, and returns the commission of bp. The reward of
+// delegators is kept in the system account until they claim it.
+func distributeReward(bState *state.BlockState, coinbaseAccount []byte, bpReward *big.Int) (*big.Int, error) {
+	sysState, err := bState.GetAccountStateV([]byte(types.AergoSystem))
+	if err != nil {
+		return nil, err
+	}
+	scs, err := bState.StateDB.OpenContractState(sysState.AccountID(), sysState.State())
+	if err != nil {
+		return nil, err
+	}
+	commission, err := system.DistributeReward(scs, coinbaseAccount, bpReward)
+	if err != nil {
+		return nil, err
+	}
+	delegated := new(big.Int).Sub(bpReward, commission)
+	if delegated.Sign() == 0 {
+		return bpReward, nil
+	}
+	if err = bState.StateDB.StageContractState(scs); err != nil {
+		return nil, err
+	}
+	sysState.AddBalance(delegated)
+	if err = sysState.PutState(); err != nil {
+		return nil, err
+	}
+	logger.Debug().Str("commission", commission.String()).Str("delegated", delegated.String()).
+		Msg("distribute reward to delegators")
+	return commission, nil
+}
+
 // find an orphan block which is the child of the added block
 func (cs *ChainService) resolveOrphan(block *types.Block) (*types.Block, error) {
 	hash := block.BlockHash()
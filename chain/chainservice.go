@@ -51,13 +51,13 @@ type Core struct {
 }
 
 // NewCore returns an instance of Core.
-func NewCore(dbType string, dataDir string, testModeOn bool, forceResetHeight types.BlockNo) (*Core, error) {
+func NewCore(dbType string, dataDir string, prefixed bool, testModeOn bool, forceResetHeight types.BlockNo) (*Core, error) {
 	core := &Core{
 		cdb: NewChainDB(),
 		sdb: state.NewChainStateDB(),
 	}
 
-	err := core.init(dbType, dataDir, testModeOn, forceResetHeight)
+	err := core.init(dbType, dataDir, prefixed, testModeOn, forceResetHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +66,9 @@ func NewCore(dbType string, dataDir string, testModeOn bool, forceResetHeight ty
 }
 
 // Init prepares Core (chain & state DB).
-func (core *Core) init(dbType string, dataDir string, testModeOn bool, forceResetHeight types.BlockNo) error {
+func (core *Core) init(dbType string, dataDir string, prefixed bool, testModeOn bool, forceResetHeight types.BlockNo) error {
 	// init chaindb
-	if err := core.cdb.Init(dbType, dataDir); err != nil {
+	if err := core.cdb.Init(dbType, dataDir, prefixed); err != nil {
 		logger.Fatal().Err(err).Msg("failed to initialize chaindb")
 		return err
 	}
@@ -177,16 +177,27 @@ type IChainHandler interface {
 	getBlock(blockHash []byte) (*types.Block, error)
 	getBlockByNo(blockNo types.BlockNo) (*types.Block, error)
 	getTx(txHash []byte) (*types.Tx, *types.TxIdx, error)
+	getTxProof(txHash []byte) (*types.TxProof, error)
 	getReceipt(txHash []byte) (*types.Receipt, error)
+	getReceiptProof(txHash []byte) (*types.ReceiptProof, error)
 	getAccountVote(id []string, addr []byte) (*types.AccountVoteInfo, error)
 	getVotes(id string, n uint32) (*types.VoteList, error)
 	getStaking(addr []byte) (*types.Staking, error)
 	getNameInfo(name string) (*types.NameInfo, error)
+	getNameByAddress(addr []byte) (*types.NameInfo, error)
+	getDelegation(addr []byte) (*types.Delegation, error)
+	listProposals(size, offset uint32, asc bool) (*types.ProposalList, error)
+	getAllowedPeers() ([]peer.ID, error)
 	addBlock(newBlock *types.Block, usedBstate *state.BlockState, peerID peer.ID) error
 	getAnchorsNew() (ChainAnchor, types.BlockNo, error)
 	findAncestor(Hashes [][]byte) (*types.BlockInfo, error)
 	setSync(val bool)
 	listEvents(filter *types.FilterInfo) ([]*types.Event, error)
+	getSnapshotChunk(root, start, end []byte, accounts bool) ([]*types.StateChunkEntry, bool, error)
+	getSnapshotSQL(name string, version, offset uint64) ([]byte, uint64, uint64, error)
+	putSnapshotChunk(root []byte, entries []*types.StateChunkEntry) error
+	putSnapshotSQL(name string, offset uint64, data []byte) error
+	applySnapshot(block *types.Block, storageRoots [][]byte, sqls map[string]*message.SnapshotSQL) error
 }
 
 // ChainService manage connectivity of blocks
@@ -208,6 +219,16 @@ type ChainService struct {
 
 	recovered  atomic.Value
 	debuggable bool
+
+	// allowlist is the allowed peers last notified to p2p
+	allowlist string
+
+	// snapshot writes the state downloaded by snapshot sync
+	snapshot *state.SnapshotWriter
+
+	// archiverQuit stops the archiver of old blocks, and archiverDone is closed when it returns
+	archiverQuit chan interface{}
+	archiverDone chan interface{}
 }
 
 // NewChainService creates an instance of ChainService.
@@ -221,10 +242,14 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 	cs.setRecovered(false)
 
 	var err error
-	if cs.Core, err = NewCore(cfg.DbType, cfg.DataDir, cfg.EnableTestmode, types.BlockNo(cfg.Blockchain.ForceResetHeight)); err != nil {
+	if cs.Core, err = NewCore(cfg.DbType, cfg.DataDir, cfg.DbPrefixed, cfg.EnableTestmode, types.BlockNo(cfg.Blockchain.ForceResetHeight)); err != nil {
 		logger.Fatal().Err(err).Msg("failed to initialize DB")
 		panic(err)
 	}
+	if err = cs.cdb.openArchive(cfg.DataDir, cfg.Blockchain.ArchiveMode); err != nil {
+		logger.Fatal().Err(err).Msg("failed to open archive of blocks")
+		panic(err)
+	}
 
 	if err = Init(cfg.Blockchain.MaxBlockSize,
 		cfg.Blockchain.CoinbaseAccount,
@@ -251,6 +276,13 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 		logger.Fatal().Err(err).Msg("failed to create a genesis block")
 		panic("failed to init genesis block")
 	}
+	if err := initForks(cs.cdb.GetGenesisInfo(), cfg.Blockchain.Forks); err != nil {
+		logger.Fatal().Err(err).Msg("invalid forks of config")
+		panic("invalid config: forks")
+	}
+	if forks := types.GetForks(); len(forks) > 0 {
+		logger.Info().Interface("forks", forks).Msg("fork schedule")
+	}
 
 	if ConsensusName() == consensus.ConsensusName[consensus.ConsensusDPOS] {
 		top, err := cs.getVotes(types.VoteBP[2:], 1)
@@ -264,6 +296,15 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 		}
 	}
 
+	if err := refreshParams(cs.sdb); err != nil {
+		logger.Fatal().Err(err).Msg("failed to load chain parameters")
+		panic("failed to load chain parameters")
+	}
+	// p2p gets the initial allowlist by AllowedPeers
+	if allowlist, err := cs.getAllowedPeers(); err == nil {
+		cs.allowlist = allowlistDigest(allowlist)
+	}
+
 	// init related modules
 	if !pubNet && cfg.Blockchain.ZeroFee {
 		fee.EnableZeroFee()
@@ -325,10 +366,20 @@ func (cs *ChainService) BeforeStart() {
 func (cs *ChainService) AfterStart() {
 	cs.chainManager.Start()
 	cs.chainWorker.Start()
+
+	if cs.cdb.archive.enabled {
+		cs.archiverQuit = make(chan interface{})
+		cs.archiverDone = make(chan interface{})
+		go cs.runArchiver(cs.cfg.Blockchain.Retention, cs.archiverQuit, cs.archiverDone)
+	}
 }
 
 // BeforeStop close chain database and stop BlockValidator
 func (cs *ChainService) BeforeStop() {
+	if cs.archiverQuit != nil {
+		close(cs.archiverQuit)
+		<-cs.archiverDone
+	}
 	cs.Close()
 
 	cs.chainManager.Stop()
@@ -350,6 +401,36 @@ func (cs *ChainService) notifyBlock(block *types.Block, isByBP bool) {
 		})
 }
 
+// AllowedPeers returns the peers allowed to join the network by the latest state, which include the bps in charge of
+// the next block. It is empty if the network is permissionless.
+func (cs *ChainService) AllowedPeers() ([]peer.ID, error) {
+	return cs.getAllowedPeers()
+}
+
+// notifyAllowedPeers tells p2p the allowlist of peers if it is changed.
+func (cs *ChainService) notifyAllowedPeers() {
+	allowlist, err := cs.getAllowedPeers()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get allowlist of peers")
+		return
+	}
+	digest := allowlistDigest(allowlist)
+	if digest == cs.allowlist {
+		return
+	}
+	cs.allowlist = digest
+	logger.Info().Int("peers", len(allowlist)).Msg("allowlist of peers is changed")
+	cs.TellTo(message.P2PSvc, &message.NotifyAllowedPeers{Peers: allowlist})
+}
+
+func allowlistDigest(allowlist []peer.ID) string {
+	var digest string
+	for _, id := range allowlist {
+		digest += id.Pretty() + ","
+	}
+	return digest
+}
+
 func (cs *ChainService) setRecovered(val bool) {
 	cs.recovered.Store(val)
 	return
@@ -378,7 +459,11 @@ func (cs *ChainService) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
 	case *message.AddBlock,
 		*message.GetAnchors, //TODO move to ChainWorker (need chain lock)
-		*message.GetAncestor:
+		*message.GetAncestor,
+		*message.GetSnapshotSQL,
+		*message.PutSnapshotChunk,
+		*message.PutSnapshotSQL,
+		*message.ApplySnapshot:
 		cs.chainManager.Request(msg, context.Sender())
 
 		//pass to chainWorker
@@ -387,14 +472,21 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetState,
 		*message.GetStateAndProof,
 		*message.GetTx,
+		*message.GetTxProof,
 		*message.GetReceipt,
+		*message.GetReceiptProof,
 		*message.GetABI,
 		*message.GetQuery,
 		*message.GetStateQuery,
 		*message.GetElected,
 		*message.GetVote,
 		*message.GetStaking,
+		*message.GetDelegation,
 		*message.GetNameInfo,
+		*message.GetNameByAddress,
+		*message.ListProposals,
+		*message.GetAllowedPeers,
+		*message.GetSnapshotChunk,
 		*message.ListEvents:
 		cs.chainWorker.Request(msg, context.Sender())
 
@@ -412,6 +504,8 @@ func (cs *ChainService) Receive(context actor.Context) {
 			Block: block,
 			Err:   err,
 		})
+	case *message.GetDBStats:
+		context.Respond(&message.GetDBStatsRsp{Stats: cs.dbStats()})
 	case *message.MemPoolDelRsp:
 		err := msg.Err
 		if err != nil {
@@ -500,23 +594,78 @@ func (cs *ChainService) getStaking(addr []byte) (*types.Staking, error) {
 	if err != nil {
 		return nil, err
 	}
-	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr))
+	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr, cs.getBestBlockNo()))
 	if err != nil {
 		return nil, err
 	}
 	return staking, nil
 }
 
+func (cs *ChainService) getDelegation(addr []byte) (*types.Delegation, error) {
+	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
+	if err != nil {
+		return nil, err
+	}
+	namescs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
+	if err != nil {
+		return nil, err
+	}
+	return system.GetDelegation(scs, name.GetAddress(namescs, addr, cs.getBestBlockNo()))
+}
+
+func (cs *ChainService) listProposals(size, offset uint32, asc bool) (*types.ProposalList, error) {
+	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
+	if err != nil {
+		return nil, err
+	}
+	return system.ListProposals(scs, size, offset, asc)
+}
+
+func (cs *ChainService) getAllowedPeers() ([]peer.ID, error) {
+	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
+	if err != nil {
+		return nil, err
+	}
+	return system.GetPermittedPeers(scs, cs.getBestBlockNo()+1)
+}
+
 func (cs *ChainService) getNameInfo(qname string) (*types.NameInfo, error) {
+	// an address is queried for its primary name
+	if !types.IsNameAddress([]byte(qname)) {
+		if addr, err := types.DecodeAddress(qname); err == nil {
+			return cs.getNameByAddress(addr)
+		}
+	}
 	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 	if err != nil {
 		return nil, err
 	}
-	owner := name.GetOwner(scs, []byte(qname))
-	if owner == nil {
+	return nameInfo(scs, []byte(qname))
+}
+
+func (cs *ChainService) getNameByAddress(addr []byte) (*types.NameInfo, error) {
+	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
+	if err != nil {
+		return nil, err
+	}
+	qname := name.GetNameByAddress(scs, addr, cs.getBestBlockNo())
+	if qname == nil {
+		return &types.NameInfo{Name: &types.Name{}, Destination: addr}, types.ErrNameNotFound
+	}
+	return nameInfo(scs, qname)
+}
+
+func nameInfo(scs *state.ContractState, qname []byte) (*types.NameInfo, error) {
+	nameMap := name.GetNameMap(scs, qname)
+	if nameMap == nil {
 		return &types.NameInfo{Name: &types.Name{Name: string(qname)}, Owner: nil}, types.ErrNameNotFound
 	}
-	return &types.NameInfo{Name: &types.Name{Name: string(qname)}, Owner: owner, Destination: name.GetAddress(scs, []byte(qname))}, err
+	return &types.NameInfo{
+		Name:        &types.Name{Name: string(qname)},
+		Owner:       nameMap.Owner,
+		Destination: nameMap.Destination,
+		ExpireBlock: nameMap.ExpireBlock,
+	}, nil
 }
 
 type ChainManager struct {
@@ -597,6 +746,26 @@ func (cm *ChainManager) Receive(context actor.Context) {
 			Ancestor: ancestor,
 			Err:      err,
 		})
+	case *message.GetSnapshotSQL:
+		data, version, size, err := cm.getSnapshotSQL(msg.Name, msg.Version, msg.Offset)
+		context.Respond(&message.GetSnapshotSQLRsp{
+			Version: version,
+			Data:    data,
+			Size:    size,
+			Err:     err,
+		})
+	case *message.PutSnapshotChunk:
+		err := cm.putSnapshotChunk(msg.Root, msg.Entries)
+		context.Respond(&message.PutSnapshotRsp{Err: err})
+	case *message.PutSnapshotSQL:
+		err := cm.putSnapshotSQL(msg.Name, msg.Offset, msg.Data)
+		context.Respond(&message.PutSnapshotRsp{Err: err})
+	case *message.ApplySnapshot:
+		err := cm.applySnapshot(msg.Block, msg.StorageRoots, msg.SQLs)
+		if err != nil {
+			logger.Error().Err(err).Uint64("no", msg.Block.BlockNo()).Msg("failed to apply state snapshot")
+		}
+		context.Respond(&message.PutSnapshotRsp{Err: err})
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
 	default:
 		debug := fmt.Sprintf("[%s] Missed message. (%v) %s", cm.name, reflect.TypeOf(msg), msg)
@@ -604,14 +773,15 @@ func (cm *ChainManager) Receive(context actor.Context) {
 	}
 }
 
-func getAddressNameResolved(sdb *state.ChainStateDB, account []byte) ([]byte, error) {
-	if len(account) <= types.NameLength {
+func getAddressNameResolved(sdb *state.ChainStateDB, account []byte, blockNo types.BlockNo) ([]byte, error) {
+	if len(account) <= types.NameLength ||
+		(types.IsForkActive(types.ForkSubName, blockNo) && types.IsNameAddress(account)) {
 		scs, err := sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(account)).Err(err).Msg("failed to get state for account")
 			return nil, err
 		}
-		return name.GetAddress(scs, account), nil
+		return name.GetAddress(scs, account, blockNo), nil
 	}
 	return account, nil
 }
@@ -638,7 +808,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:   err,
 		})
 	case *message.GetState:
-		address, err := getAddressNameResolved(cw.sdb, msg.Account)
+		address, err := getAddressNameResolved(cw.sdb, msg.Account, cw.cdb.getBestBlockNo())
 		if err != nil {
 			context.Respond(message.GetStateRsp{
 				Account: msg.Account,
@@ -658,7 +828,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:     err,
 		})
 	case *message.GetStateAndProof:
-		address, err := getAddressNameResolved(cw.sdb, msg.Account)
+		address, err := getAddressNameResolved(cw.sdb, msg.Account, cw.cdb.getBestBlockNo())
 		if err != nil {
 			context.Respond(message.GetStateAndProofRsp{
 				StateProof: nil,
@@ -683,14 +853,26 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			TxIds: txIdx,
 			Err:   err,
 		})
+	case *message.GetTxProof:
+		proof, err := cw.getTxProof(msg.TxHash)
+		context.Respond(message.GetTxProofRsp{
+			Proof: proof,
+			Err:   err,
+		})
 	case *message.GetReceipt:
 		receipt, err := cw.getReceipt(msg.TxHash)
 		context.Respond(message.GetReceiptRsp{
 			Receipt: receipt,
 			Err:     err,
 		})
+	case *message.GetReceiptProof:
+		proof, err := cw.getReceiptProof(msg.TxHash)
+		context.Respond(message.GetReceiptProofRsp{
+			Proof: proof,
+			Err:   err,
+		})
 	case *message.GetABI:
-		address, err := getAddressNameResolved(cw.sdb, msg.Contract)
+		address, err := getAddressNameResolved(cw.sdb, msg.Contract, cw.cdb.getBestBlockNo())
 		if err != nil {
 			context.Respond(message.GetABIRsp{
 				ABI: nil,
@@ -714,7 +896,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 	case *message.GetQuery:
 		runtime.LockOSThread()
 		defer runtime.UnlockOSThread()
-		address, err := getAddressNameResolved(cw.sdb, msg.Contract)
+		address, err := getAddressNameResolved(cw.sdb, msg.Contract, cw.cdb.getBestBlockNo())
 		if err != nil {
 			context.Respond(message.GetQueryRsp{Result: nil, Err: err})
 			break
@@ -733,7 +915,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		var contractProof *types.AccountProof
 		var err error
 
-		address, err := getAddressNameResolved(cw.sdb, msg.ContractAddress)
+		address, err := getAddressNameResolved(cw.sdb, msg.ContractAddress, cw.cdb.getBestBlockNo())
 		if err != nil {
 			context.Respond(message.GetStateQueryRsp{
 				Result: nil,
@@ -784,18 +966,49 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Staking: staking,
 			Err:     err,
 		})
+	case *message.GetDelegation:
+		delegation, err := cw.getDelegation(msg.Addr)
+		context.Respond(&message.GetDelegationRsp{
+			Delegation: delegation,
+			Err:        err,
+		})
 	case *message.GetNameInfo:
 		owner, err := cw.getNameInfo(msg.Name)
 		context.Respond(&message.GetNameInfoRsp{
 			Owner: owner,
 			Err:   err,
 		})
+	case *message.GetNameByAddress:
+		owner, err := cw.getNameByAddress(msg.Addr)
+		context.Respond(&message.GetNameInfoRsp{
+			Owner: owner,
+			Err:   err,
+		})
+	case *message.ListProposals:
+		proposals, err := cw.listProposals(msg.Size, msg.Offset, msg.Asc)
+		context.Respond(&message.ListProposalsRsp{
+			Proposals: proposals,
+			Err:       err,
+		})
+	case *message.GetAllowedPeers:
+		peers, err := cw.getAllowedPeers()
+		context.Respond(&message.GetAllowedPeersRsp{
+			Peers: peers,
+			Err:   err,
+		})
 	case *message.ListEvents:
 		events, err := cw.listEvents(msg.Filter)
 		context.Respond(&message.ListEventsRsp{
 			Events: events,
 			Err:    err,
 		})
+	case *message.GetSnapshotChunk:
+		entries, hasNext, err := cw.getSnapshotChunk(msg.Root, msg.Start, msg.End, msg.Accounts)
+		context.Respond(&message.GetSnapshotChunkRsp{
+			Entries: entries,
+			HasNext: hasNext,
+			Err:     err,
+		})
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
 	default:
 		debug := fmt.Sprintf("[%s] Missed message. (%v) %s", cw.name, reflect.TypeOf(msg), msg)
@@ -7,6 +7,7 @@ package chain
 
 import (
 	"errors"
+	"sync/atomic"
 
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract/system"
@@ -21,12 +22,17 @@ var (
 	MaxAnchorCount  int
 	VerifierCount   int
 
-	// maxBlockBodySize is the upper limit of block size.
+	// maxBlockBodySize is the upper limit of block size. It is changed by
+	// governance, so it must be accessed atomically.
 	maxBlockBodySize uint32
 	maxBlockSize     uint32
 	pubNet           bool
 	consensusName    string
 
+	// defaultMaxBlockBodySize is the max block body size used until
+	// governance changes it.
+	defaultMaxBlockBodySize uint32
+
 	Genesis *types.Genesis
 )
 
@@ -35,13 +41,14 @@ var (
 	// address is invalid.
 	ErrInvalidCoinbaseAccount = errors.New("invalid coinbase account in config")
 	ErrInvalidConsensus       = errors.New("invalid consensus name from genesis")
+	ErrForkConfigPublic       = errors.New("forks of aergo public chains can't be changed by config")
 )
 
 // Init initializes the blockchain-related parameters.
 func Init(maxBlkBodySize uint32, coinbaseAccountStr string, isBp bool, maxAnchorCount int, verifierCount int) error {
 	var err error
 
-	setBlockSizeLimit(maxBlkBodySize)
+	setDefaultBlockSizeLimit(maxBlkBodySize)
 
 	if isBp {
 		if len(coinbaseAccountStr) != 0 {
@@ -71,37 +78,66 @@ func IsPublic() bool {
 func initChainParams(genesis *types.Genesis) {
 	pubNet = genesis.ID.PublicNet
 	if pubNet {
-		setBlockSizeLimit(pubNetMaxBlockBodySize)
+		setDefaultBlockSizeLimit(pubNetMaxBlockBodySize)
 	}
 	if err := setConsensusName(genesis.ConsensusType()); err != nil {
 		logger.Panic().Err(err).Msg("invalid consensus type in genesis block")
 	}
 	system.InitDefaultBpCount(len(genesis.BPs))
+	system.InitGenesisBPs(genesis.BPs)
+	system.InitChainID(genesis.Block().GetHeader().GetChainID())
 	if genesis.TotalBalance() != nil {
 		types.MaxAER = genesis.TotalBalance()
 		logger.Info().Str("TotalBalance", types.MaxAER.String()).Msg("set total from genesis")
 	}
+	types.InitForks(genesis.Forks)
 
 	Genesis = genesis
 }
 
+// initForks adds the forks of config to the schedule of genesis. They are for the private chains whose genesis is
+// created before the forks are scheduled.
+func initForks(genesis *types.Genesis, list []string) error {
+	if len(list) == 0 {
+		return nil
+	}
+	if genesis.IsAergoPublicChain() {
+		return ErrForkConfigPublic
+	}
+	schedule, err := types.ParseForks(list)
+	if err != nil {
+		return err
+	}
+	merged := genesis.Forks.Merge(schedule)
+	if err := merged.Validate(); err != nil {
+		return err
+	}
+	types.InitForks(merged)
+	return nil
+}
+
 // MaxBlockBodySize returns the max block body size.
 func MaxBlockBodySize() uint32 {
-	return maxBlockBodySize
+	return atomic.LoadUint32(&maxBlockBodySize)
 }
 
 // MaxBlockSize returns the max block size.
 func MaxBlockSize() uint32 {
-	return maxBlockSize
+	return atomic.LoadUint32(&maxBlockSize)
 }
 
 func setMaxBlockBodySize(size uint32) {
-	maxBlockBodySize = size
+	atomic.StoreUint32(&maxBlockBodySize, size)
 }
 
 func setBlockSizeLimit(maxBlockBodySize uint32) {
 	setMaxBlockBodySize(maxBlockBodySize)
-	maxBlockSize = MaxBlockBodySize() + types.DefaultMaxHdrSize
+	atomic.StoreUint32(&maxBlockSize, MaxBlockBodySize()+types.DefaultMaxHdrSize)
+}
+
+func setDefaultBlockSizeLimit(maxBlockBodySize uint32) {
+	defaultMaxBlockBodySize = maxBlockBodySize
+	setBlockSizeLimit(maxBlockBodySize)
 }
 
 func setConsensusName(val string) error {
@@ -0,0 +1,80 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/pkg/storage"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var ErrGenesisNoBP = errors.New("dpos genesis requires bps")
+
+// CheckGenesis creates the genesis block of genesis in a temporary data
+// directory, by the same way as a node initializes its chain, and returns the
+// block. genesis itself is not modified.
+func CheckGenesis(genesis *types.Genesis) (*types.Block, error) {
+	if err := genesis.Validate(); err != nil {
+		return nil, err
+	}
+	known := false
+	for _, name := range consensus.ConsensusName {
+		if genesis.ConsensusType() == name {
+			known = true
+		}
+	}
+	if !known {
+		return nil, ErrInvalidConsensus
+	}
+	if genesis.ConsensusType() == consensus.ConsensusName[consensus.ConsensusDPOS] && len(genesis.BPs) == 0 {
+		return nil, ErrGenesisNoBP
+	}
+
+	// SetGenesis orders the BPs and caches the block in the genesis
+	b, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, err
+	}
+	g := new(types.Genesis)
+	if err := json.Unmarshal(b, g); err != nil {
+		return nil, err
+	}
+
+	dataDir, err := ioutil.TempDir("", "aergo-genesis")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dataDir)
+
+	cdb := NewChainDB()
+	if err := cdb.Init(storage.MemoryImpl, dataDir, false); err != nil {
+		return nil, err
+	}
+	defer cdb.Close()
+	best, err := cdb.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	sdb := state.NewChainStateDB()
+	if err := sdb.Init(storage.MemoryImpl, dataDir, best, false); err != nil {
+		return nil, err
+	}
+	defer sdb.Close()
+
+	if err := sdb.SetGenesis(g, InitGenesisBPs); err != nil {
+		return nil, err
+	}
+	if err := cdb.addGenesisBlock(g); err != nil {
+		return nil, err
+	}
+	return cdb.GetBlockByNo(0)
+}
@@ -0,0 +1,56 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testGenesis() *types.Genesis {
+	return &types.Genesis{
+		ID:        types.ChainID{Magic: "test.chain", Consensus: "dpos"},
+		Timestamp: 1545195494,
+		Balance: map[string]string{
+			"AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ": "500000000000000000000000000",
+		},
+		BPs: []string{
+			"16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n",
+			"16Uiu2HAm4xYtGsqk7WGKUxr8prfVpJ25hD23AQ3Be6anEL9Kxkgw",
+		},
+	}
+}
+
+func TestCheckGenesis(t *testing.T) {
+	g := testGenesis()
+	block, err := CheckGenesis(g)
+	assert.NoError(t, err)
+	assert.Equal(t, types.BlockNo(0), block.BlockNo())
+	assert.NotEmpty(t, block.GetHeader().GetBlocksRootHash())
+	// genesis is not modified, and the same genesis makes the same block
+	assert.Nil(t, g.TotalBalance())
+	again, err := CheckGenesis(g)
+	assert.NoError(t, err)
+	assert.Equal(t, block.BlockHash(), again.BlockHash())
+
+	g.Stakes = map[string]string{
+		"AmLsSfxo9aQRZJBvMBoLFb9QZABQK2RiG3Uq1JBhyAfbDYPf31J2": types.StakingMinimum.String(),
+	}
+	staked, err := CheckGenesis(g)
+	assert.NoError(t, err)
+	assert.NotEqual(t, block.GetHeader().GetBlocksRootHash(), staked.GetHeader().GetBlocksRootHash())
+
+	g.Stakes["AmLsSfxo9aQRZJBvMBoLFb9QZABQK2RiG3Uq1JBhyAfbDYPf31J2"] = "1"
+	_, err = CheckGenesis(g)
+	assert.Error(t, err, "stake less than the minimum")
+
+	g = testGenesis()
+	g.BPs = nil
+	_, err = CheckGenesis(g)
+	assert.Equal(t, ErrGenesisNoBP, err)
+
+	g = testGenesis()
+	g.ID.Consensus = "pow"
+	_, err = CheckGenesis(g)
+	assert.Equal(t, ErrInvalidConsensus, err)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
@@ -48,7 +49,7 @@ func executeGovernanceTx(bs *state.BlockState, txBody *types.TxBody, sender, rec
 	return events, err
 }
 
-// InitGenesisBPs opens system contract and put initial voting result
+// InitGenesisBPs opens system contract and put initial voting result and stakes
 // it also set *State in Genesis to use statedb
 func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
 	aid := types.ToAccountID([]byte(types.AergoSystem))
@@ -64,6 +65,13 @@ func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
 	if err = system.InitVoteResult(scs, voteResult); err != nil {
 		return err
 	}
+	stakes, err := genesis.StakeAmounts()
+	if err != nil {
+		return err
+	}
+	if err = system.InitGenesisStakes(scs, stakes); err != nil {
+		return err
+	}
 
 	// Set genesis.BPs to the votes-ordered BPs. This will be used later for
 	// bootstrapping.
@@ -80,3 +88,53 @@ func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
 
 	return nil
 }
+
+// ApplyProposals closes the voting and applies the passed proposals of the
+// system contract which are due at blockNo. It must be called once for each
+// block, after its transactions are executed. Nothing is done before the fork
+// of governance.
+func ApplyProposals(bState *state.BlockState, blockNo types.BlockNo) error {
+	if !types.IsForkActive(types.ForkGovernance, blockNo) {
+		return nil
+	}
+	scs, err := bState.StateDB.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	applied, err := system.ApplyProposals(scs, blockNo)
+	if err != nil {
+		return err
+	}
+	for i, p := range applied {
+		logger.Info().Uint64("id", p.GetId()).Str("param", p.GetParam()).
+			Str("value", p.GetValueBigInt().String()).Uint64("no", blockNo).Msg("proposal applied")
+		bState.AddBlockEvent(system.AppliedEvent(p, int32(i)))
+	}
+	return bState.StateDB.StageContractState(scs)
+}
+
+// refreshParams sets the chain parameters, which can be changed by the
+// proposals of the system contract, from the latest state.
+func refreshParams(sdb *state.ChainStateDB) error {
+	scs, err := sdb.GetStateDB().GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	params, err := system.GetParams(scs)
+	if err != nil {
+		return err
+	}
+
+	size := defaultMaxBlockBodySize
+	if v, exist := params[system.ParamMaxBlockSize]; exist {
+		size = uint32(v.Uint64())
+	}
+	if size != MaxBlockBodySize() {
+		logger.Info().Uint32("size", size).Msg("max block body size changed")
+		setBlockSizeLimit(size)
+	}
+
+	fee.SetRates(params[system.ParamBaseTxFee], params[system.ParamAerPerByte])
+
+	return nil
+}
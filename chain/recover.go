@@ -165,7 +165,7 @@ func (rm *ReorgMarker) RecoverChainMapping(cdb *ChainDB) error {
 	// remove unnecessary chain mapping of new chain
 	for tmpBlkNo = rm.BrTopNo; tmpBlkNo > rm.BrBestNo; tmpBlkNo-- {
 		logger.Debug().Uint64("no", tmpBlkNo).Msg("delete chain mapping of new chain")
-		bulk.Delete(types.BlockNoToBytes(tmpBlkNo))
+		bulk.Delete(cdb.blockIdxKey(tmpBlkNo))
 	}
 
 	tmpBlk = bestBlock
@@ -174,7 +174,7 @@ func (rm *ReorgMarker) RecoverChainMapping(cdb *ChainDB) error {
 	for tmpBlkNo > rm.BrStartNo {
 		logger.Debug().Str("hash", tmpBlk.ID()).Uint64("no", tmpBlkNo).Msg("update chain mapping to old chain")
 
-		bulk.Set(types.BlockNoToBytes(tmpBlkNo), tmpBlk.BlockHash())
+		bulk.Set(cdb.blockIdxKey(tmpBlkNo), tmpBlk.BlockHash())
 
 		if tmpBlk, err = cdb.getBlock(tmpBlk.GetHeader().GetPrevBlockHash()); err != nil {
 			return err
@@ -324,7 +324,7 @@ func (reorg *reorganizer) swapTxMapping() error {
 	defer bulk.DiscardLast()
 
 	for _, oldTx := range oldTxs {
-		bulk.Delete(oldTx.Hash)
+		bulk.Delete(cdb.txKey(oldTx.Hash))
 	}
 
 	bulk.Flush()
@@ -483,6 +483,11 @@ func (reorg *reorganizer) rollback() error {
 			brStartBlock.ID())
 	}
 
+	// the parameters applied by the proposals of old blocks are rolled back too
+	if err := refreshParams(reorg.cs.sdb); err != nil {
+		return err
+	}
+
 	reorg.cs.Update(brStartBlock)
 
 	return nil
@@ -0,0 +1,289 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/storage"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+var ErrReplayNoGenesis = errors.New("no genesis block in data directory")
+
+// Replayer re-executes the blocks of a data directory on a scratch state, and compares the state roots and the
+// receipts with the stored ones. The scratch state reads the states of data directory and writes to its own store,
+// so that the data directory is not modified.
+type Replayer struct {
+	cdb     *ChainDB
+	src     *state.ChainStateDB
+	scratch *state.ChainStateDB
+}
+
+// Divergence is the first difference between the replayed block and the stored one. The accounts and the storage
+// keys are named by the tx which wrote them last. nil tx means that they are written after the txs of block, such as
+// the reward of BP.
+type Divergence struct {
+	Block        *types.Block
+	ExpectedRoot []byte
+	ActualRoot   []byte
+	Receipts     []*ReceiptDiff
+	Accounts     []*state.AccountDiff
+
+	accountTx map[types.AccountID][]byte
+	storageTx map[types.AccountID]map[types.HashID][]byte
+	addresses map[types.AccountID][]byte
+}
+
+// ReceiptDiff is the difference of the receipt of a tx. nil receipt means that it doesn't exist.
+type ReceiptDiff struct {
+	Index    int
+	Expected *types.Receipt
+	Actual   *types.Receipt
+}
+
+func (d *Divergence) Error() string {
+	return fmt.Sprintf("replayed block diverged: blockNo=%d, hash=%s, expected root=%s, actual root=%s",
+		d.Block.BlockNo(), d.Block.ID(), enc.ToString(d.ExpectedRoot), enc.ToString(d.ActualRoot))
+}
+
+// AccountTx returns the hash of tx which wrote the account last
+func (d *Divergence) AccountTx(id types.AccountID) []byte {
+	return d.accountTx[id]
+}
+
+// Address returns the address of account, if it is known from the txs of block
+func (d *Divergence) Address(id types.AccountID) []byte {
+	return d.addresses[id]
+}
+
+// StorageTx returns the hash of tx which wrote the storage key of contract last
+func (d *Divergence) StorageTx(id types.AccountID, key types.HashID) []byte {
+	return d.storageTx[id][key]
+}
+
+// NewReplayer opens the chain and the state of dataDir, and the scratch state in scratchDir. The sql databases of
+// contracts must be copied to scratchDir before, since they are modified by replay.
+func NewReplayer(dbType, dataDir string, prefixed bool, scratchDir string, zeroFee bool) (*Replayer, error) {
+	r := &Replayer{cdb: NewChainDB(), src: state.NewChainStateDB()}
+	if err := r.cdb.Init(dbType, dataDir, prefixed); err != nil {
+		return nil, err
+	}
+	// reads the archived blocks, but never archives
+	if err := r.cdb.openArchive(dataDir, ArchiveNone); err != nil {
+		r.Close()
+		return nil, err
+	}
+	best, err := r.cdb.GetBestBlock()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	if err := r.src.Init(dbType, dataDir, best, false); err != nil {
+		r.Close()
+		return nil, err
+	}
+	top, err := storage.NewDB(dbType, common.PathMkdirAll(scratchDir, "state"))
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	r.scratch = state.OpenChainStateDB(storage.Overlay(top, r.src.GetStore()), nil)
+
+	gen := r.cdb.GetGenesisInfo()
+	if gen == nil {
+		r.Close()
+		return nil, ErrReplayNoGenesis
+	}
+	initChainParams(gen)
+	if !pubNet && zeroFee {
+		fee.EnableZeroFee()
+	}
+	contract.PubNet = pubNet
+	if err := contract.LoadDatabase(scratchDir); err != nil {
+		r.Close()
+		return nil, err
+	}
+	contract.StartLStateFactory()
+
+	return r, nil
+}
+
+// BestBlockNo returns the best block number of data directory
+func (r *Replayer) BestBlockNo() types.BlockNo {
+	return r.cdb.getBestBlockNo()
+}
+
+// Replay re-executes the blocks in [from, to]. If the result of a block differs from the stored one, it stops and
+// returns *Divergence.
+func (r *Replayer) Replay(from, to types.BlockNo, progress func(types.BlockNo)) error {
+	if from == 0 {
+		from = 1
+	}
+	prev, err := r.cdb.GetBlockByNo(from - 1)
+	if err != nil {
+		return err
+	}
+	if err = r.scratch.SetRoot(prev.GetHeader().GetBlocksRootHash()); err != nil {
+		return err
+	}
+	if err = refreshParams(r.scratch); err != nil {
+		return err
+	}
+
+	for no := from; no <= to; no++ {
+		block, err := r.cdb.GetBlockByNo(no)
+		if err != nil {
+			return err
+		}
+		if err = r.cdb.checkPruned(no); err != nil {
+			return err
+		}
+		if err = r.replayBlock(block); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(no)
+		}
+	}
+	return nil
+}
+
+func (r *Replayer) replayBlock(block *types.Block) error {
+	bState := state.NewBlockState(r.scratch.OpenNewStateDB(r.scratch.GetRoot()))
+	d := &Divergence{
+		Block:     block,
+		accountTx: make(map[types.AccountID][]byte),
+		storageTx: make(map[types.AccountID]map[types.HashID][]byte),
+		addresses: make(map[types.AccountID][]byte),
+	}
+	for _, addr := range [][]byte{block.GetHeader().GetCoinbaseAccount(), []byte(types.AergoSystem)} {
+		d.addresses[types.ToAccountID(addr)] = addr
+	}
+	for _, tx := range block.GetBody().GetTxs() {
+		body := tx.GetBody()
+		for _, addr := range [][]byte{body.GetAccount(), body.GetRecipient(), contract.CreateContractID(body.GetAccount(), body.GetNonce())} {
+			d.addresses[types.ToAccountID(addr)] = addr
+		}
+	}
+
+	header := block.GetHeader()
+	execTx := NewTxExecutor(r.cdb, block.BlockNo(), header.GetTimestamp(), header.GetPrevBlockHash(), contract.ChainService, header.GetChainID())
+
+	ex := &blockExecutor{
+		BlockState: bState,
+		sdb:        r.scratch,
+		execTx: func(bs *state.BlockState, tx types.Transaction) error {
+			before := bs.Written()
+			if err := execTx(bs, tx); err != nil {
+				return err
+			}
+			d.written(before, bs.Written(), tx.GetHash())
+			return nil
+		},
+		txs:              block.GetBody().GetTxs(),
+		coinbaseAcccount: header.GetCoinbaseAccount(),
+		blockNo:          block.BlockNo(),
+		validatePost: func() error {
+			return r.verify(d, bState)
+		},
+	}
+	return ex.execute()
+}
+
+// written records tx as the last writer of the accounts and the storage keys written more than before
+func (d *Divergence) written(before, after *state.Written, txHash []byte) {
+	for id, n := range after.Accounts {
+		if n > before.Accounts[id] {
+			d.accountTx[id] = txHash
+		}
+	}
+	for id, keys := range after.Storage {
+		for key, n := range keys {
+			if n > before.Storage[id][key] {
+				if d.storageTx[id] == nil {
+					d.storageTx[id] = make(map[types.HashID][]byte)
+				}
+				d.storageTx[id][key] = txHash
+			}
+		}
+	}
+}
+
+// verify compares the state root and the receipts of replayed block with the stored ones
+func (r *Replayer) verify(d *Divergence, bState *state.BlockState) error {
+	block := d.Block
+	d.ExpectedRoot = block.GetHeader().GetBlocksRootHash()
+	d.ActualRoot = bState.GetRoot()
+
+	var expected []*types.Receipt
+	if len(block.GetBody().GetTxs()) > 0 {
+		receipts, err := r.cdb.getReceipts(block.BlockHash(), block.BlockNo())
+		if err != nil {
+			return err
+		}
+		expected = receipts.Get()
+	}
+	d.Receipts = diffReceipts(expected, bState.Receipts().Get())
+
+	if bytes.Equal(d.ExpectedRoot, d.ActualRoot) && len(d.Receipts) == 0 {
+		return nil
+	}
+
+	var err error
+	if d.Accounts, err = state.Diff(r.src.OpenNewStateDB(d.ExpectedRoot), &bState.StateDB); err != nil {
+		return err
+	}
+	return d
+}
+
+func diffReceipts(expected, actual []*types.Receipt) []*ReceiptDiff {
+	var diffs []*ReceiptDiff
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		var exp, act *types.Receipt
+		if i < len(expected) {
+			exp = expected[i]
+		}
+		if i < len(actual) {
+			act = actual[i]
+		}
+		if !receiptEqual(exp, act) {
+			diffs = append(diffs, &ReceiptDiff{Index: i, Expected: exp, Actual: act})
+		}
+	}
+	return diffs
+}
+
+// receiptEqual compares the results of tx, except the location of receipt in the chain
+func receiptEqual(a, b *types.Receipt) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	strip := func(r *types.Receipt) *types.Receipt {
+		c := proto.Clone(r).(*types.Receipt)
+		c.BlockNo, c.BlockHash, c.TxIndex, c.From, c.To = 0, nil, 0, nil, nil
+		return c
+	}
+	return proto.Equal(strip(a), strip(b))
+}
+
+// Close closes the stores of replayer. The scratch state is left in its directory.
+func (r *Replayer) Close() {
+	if r.scratch != nil {
+		r.scratch.Close()
+	}
+	r.src.Close()
+	r.cdb.Close()
+	contract.CloseDatabase()
+}
@@ -0,0 +1,30 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayDiffReceipts(t *testing.T) {
+	expected := []*types.Receipt{
+		{TxHash: []byte("tx1"), Status: "SUCCESS"},
+		{TxHash: []byte("tx2"), Status: "SUCCESS", Ret: "1"},
+	}
+	actual := []*types.Receipt{
+		// the location of receipt is not compared
+		{TxHash: []byte("tx1"), Status: "SUCCESS", BlockNo: 10, TxIndex: 0},
+		{TxHash: []byte("tx2"), Status: "ERROR", Ret: "1"},
+		{TxHash: []byte("tx3"), Status: "SUCCESS"},
+	}
+
+	diffs := diffReceipts(expected, actual)
+	assert.Len(t, diffs, 2)
+	assert.Equal(t, 1, diffs[0].Index)
+	assert.Equal(t, "ERROR", diffs[0].Actual.Status)
+	assert.Equal(t, 2, diffs[1].Index)
+	assert.Nil(t, diffs[1].Expected)
+
+	assert.Empty(t, diffReceipts(expected, expected))
+}
@@ -0,0 +1,116 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	ErrSnapshotNotEmptyChain = errors.New("snapshot can be applied only to the chain which has genesis block only")
+	ErrSnapshotInvalidPivot  = errors.New("pivot of snapshot is not the configured snapshot block")
+)
+
+func (cs *ChainService) getSnapshotChunk(root, start, end []byte, accounts bool) ([]*types.StateChunkEntry, bool, error) {
+	return cs.sdb.GetStateDB().GetStateChunk(root, start, end, accounts)
+}
+
+// getSnapshotSQL must be called by chain manager, since the sql database is copied between the executions of blocks
+func (cs *ChainService) getSnapshotSQL(name string, version, offset uint64) ([]byte, uint64, uint64, error) {
+	if version == 0 {
+		var err error
+		if version, err = contract.SnapshotDatabase(name, cs.getBestBlockNo()); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	data, size, err := contract.ReadDatabaseSnapshot(name, version, offset)
+	return data, version, size, err
+}
+
+func (cs *ChainService) putSnapshotChunk(root []byte, entries []*types.StateChunkEntry) error {
+	if cs.getBestBlockNo() != 0 {
+		return ErrSnapshotNotEmptyChain
+	}
+	if cs.snapshot == nil {
+		cs.snapshot = cs.sdb.NewSnapshotWriter()
+	}
+	return cs.snapshot.Put(root, entries)
+}
+
+func (cs *ChainService) putSnapshotSQL(name string, offset uint64, data []byte) error {
+	if cs.getBestBlockNo() != 0 {
+		return ErrSnapshotNotEmptyChain
+	}
+	return contract.WriteDatabaseChunk(name, offset, data)
+}
+
+// applySnapshot sets the downloaded state as the state of block, and connects the block to the genesis block as the
+// best block. The blocks between them are not stored, so the block must be the snapshot block of configuration,
+// which is known to be irreversible. The bps in charge of it are not checked, since they are elected in the state
+// which is not verified yet.
+func (cs *ChainService) applySnapshot(block *types.Block, storageRoots [][]byte, sqls map[string]*message.SnapshotSQL) error {
+	if cs.getBestBlockNo() != 0 {
+		return ErrSnapshotNotEmptyChain
+	}
+	if cs.snapshot == nil {
+		return state.ErrIncompleteSnapshot
+	}
+	for _, root := range storageRoots {
+		if err := cs.snapshot.Finish(root); err != nil {
+			return err
+		}
+	}
+	if err := cs.checkSnapshotBlock(block); err != nil {
+		return err
+	}
+	for name, sql := range sqls {
+		if err := contract.RestoreDatabase(name, sql.RecoveryPoint, sql.Digest); err != nil {
+			return err
+		}
+	}
+	if err := cs.sdb.CommitSnapshot(cs.snapshot, block.GetHeader().GetBlocksRootHash()); err != nil {
+		return err
+	}
+	cs.snapshot = nil
+
+	tx := cs.cdb.NewTx()
+	cs.cdb.connectToChain(&tx, block, false)
+	tx.Commit()
+
+	if err := refreshParams(cs.sdb); err != nil {
+		return err
+	}
+	cs.Update(block)
+	cs.notifyAllowedPeers()
+
+	logger.Info().Uint64("no", block.BlockNo()).Str("hash", block.ID()).
+		Str("stateRoot", enc.ToString(block.GetHeader().GetBlocksRootHash())).Msg("applied state snapshot")
+	return nil
+}
+
+// checkSnapshotBlock checks that block is the snapshot block of configuration, by the hash calculated from its
+// header
+func (cs *ChainService) checkSnapshotBlock(block *types.Block) error {
+	id, err := types.DecodeBlockID(cs.cfg.Blockchain.SnapshotBlock)
+	if err != nil || block.GetHeader() == nil {
+		return ErrSnapshotInvalidPivot
+	}
+	header := &types.Block{Header: block.GetHeader()}
+	if !bytes.Equal(header.BlockHash(), id[:]) {
+		return ErrSnapshotInvalidPivot
+	}
+	if valid, err := block.VerifySign(); err != nil || !valid {
+		return ErrSnapshotInvalidPivot
+	}
+	return nil
+}
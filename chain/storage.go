@@ -0,0 +1,35 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/pkg/storage"
+)
+
+const (
+	chainStoreName = "chain"
+	stateStoreName = "state"
+)
+
+func (cs *ChainService) stores() map[string]db.DB {
+	return map[string]db.DB{
+		chainStoreName: cs.cdb.store,
+		stateStoreName: cs.sdb.GetStore(),
+	}
+}
+
+func (cs *ChainService) dbStats() map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for name, store := range cs.stores() {
+		stats := storage.Stats(store)
+		if name == chainStoreName && cs.cdb.layout != nil && cs.cdb.layout.Prefixed {
+			stats["layout"] = "prefixed"
+		}
+		result[name] = stats
+	}
+	return result
+}
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"syscall"
 
 	"github.com/aergoio/aergo/account/key"
@@ -14,6 +17,14 @@ import (
 	"golang.org/x/crypto/ssh/terminal"
 )
 
+var (
+	importFile string
+	exportJSON bool
+	useHD      bool
+	mnemonic   string
+	hdCount    uint32
+)
+
 func init() {
 	accountCmd := &cobra.Command{
 		Use:               "account [flags] subcommand",
@@ -24,8 +35,18 @@ func init() {
 
 	newCmd.Flags().StringVar(&pw, "password", "", "Password")
 	newCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	newCmd.Flags().StringVar(&keystoreDir, "keystore", "", "Path to keystore files directory, used with path (default \"keystore\" under path)")
+	newCmd.Flags().BoolVar(&useHD, "mnemonic", false, "Derive accounts from hd wallet. New mnemonic is created if there is no hd wallet")
+	newCmd.Flags().Uint32Var(&hdCount, "count", 1, "Count of accounts derived from hd wallet, used with mnemonic")
+
+	recoverCmd.Flags().StringVar(&mnemonic, "mnemonic", "", "Mnemonic words of hd wallet")
+	recoverCmd.Flags().Uint32Var(&hdCount, "count", 1, "Count of accounts derived from hd wallet")
+	recoverCmd.Flags().StringVar(&pw, "password", "", "Password")
+	recoverCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	recoverCmd.Flags().StringVar(&keystoreDir, "keystore", "", "Path to keystore files directory, used with path (default \"keystore\" under path)")
 
 	listCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	listCmd.Flags().StringVar(&keystoreDir, "keystore", "", "Path to keystore files directory, used with path (default \"keystore\" under path)")
 
 	unlockCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	unlockCmd.MarkFlagRequired("address")
@@ -36,15 +57,18 @@ func init() {
 	lockCmd.Flags().StringVar(&pw, "password", "", "Password")
 
 	importCmd.Flags().StringVar(&importFormat, "if", "", "Base58 import format string")
-	importCmd.MarkFlagRequired("if")
+	importCmd.Flags().StringVar(&importFile, "file", "", "Path to json keystore file, instead of import format string")
 	importCmd.Flags().StringVar(&pw, "password", "", "Password when exporting")
 	importCmd.Flags().StringVar(&to, "newpassword", "", "Password to be reset")
 	importCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	importCmd.Flags().StringVar(&keystoreDir, "keystore", "", "Path to keystore files directory, used with path (default \"keystore\" under path)")
 
 	exportCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	exportCmd.MarkFlagRequired("address")
 	exportCmd.Flags().StringVar(&pw, "password", "", "Password")
+	exportCmd.Flags().BoolVar(&exportJSON, "json", false, "Export as json keystore file")
 	exportCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	exportCmd.Flags().StringVar(&keystoreDir, "keystore", "", "Path to keystore files directory, used with path (default \"keystore\" under path)")
 
 	voteCmd.Flags().StringVar(&address, "address", "", "Account address of voter")
 	voteCmd.MarkFlagRequired("address")
@@ -60,8 +84,53 @@ func init() {
 	unstakeCmd.MarkFlagRequired("address")
 	unstakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount of staking")
 	unstakeCmd.MarkFlagRequired("amount")
+	withdrawCmd.Flags().StringVar(&address, "address", "", "Account address")
+	withdrawCmd.MarkFlagRequired("address")
+	slashCmd.Flags().StringVar(&address, "address", "", "Account address to send evidence")
+	slashCmd.MarkFlagRequired("address")
+
+	delegateCmd.Flags().StringVar(&address, "address", "", "Account address")
+	delegateCmd.MarkFlagRequired("address")
+	delegateCmd.Flags().StringVar(&to, "bp", "", "Coinbase account address of bp")
+	delegateCmd.MarkFlagRequired("bp")
+	delegateCmd.Flags().StringVar(&amount, "amount", "0", "Amount of delegation")
+	delegateCmd.MarkFlagRequired("amount")
+	undelegateCmd.Flags().StringVar(&address, "address", "", "Account address")
+	undelegateCmd.MarkFlagRequired("address")
+	undelegateCmd.Flags().StringVar(&amount, "amount", "0", "Amount of delegation")
+	undelegateCmd.MarkFlagRequired("amount")
+	claimCmd.Flags().StringVar(&address, "address", "", "Account address")
+	claimCmd.MarkFlagRequired("address")
+	commissionCmd.Flags().StringVar(&address, "address", "", "Coinbase account address of bp")
+	commissionCmd.MarkFlagRequired("address")
+	commissionCmd.Flags().Uint32Var(&commissionRate, "rate", 0, "Commission rate in basis points (10000 = 100%)")
+	commissionCmd.MarkFlagRequired("rate")
 
-	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd)
+	proposeCmd.Flags().StringVar(&address, "address", "", "Account address of proposer")
+	proposeCmd.MarkFlagRequired("address")
+	proposeCmd.Flags().StringVar(&proposalParam, "param", "", "Name of system parameter")
+	proposeCmd.MarkFlagRequired("param")
+	proposeCmd.Flags().StringVar(&proposalValue, "value", "", "New value of the parameter")
+	proposeCmd.MarkFlagRequired("value")
+	voteProposalCmd.Flags().StringVar(&address, "address", "", "Account address of voter")
+	voteProposalCmd.MarkFlagRequired("address")
+	voteProposalCmd.Flags().Uint64Var(&proposalID, "id", 0, "Id of proposal")
+	voteProposalCmd.MarkFlagRequired("id")
+	voteProposalCmd.Flags().StringVar(&proposalChoice, "choice", "", "yes or no")
+	voteProposalCmd.MarkFlagRequired("choice")
+
+	allowPeerCmd.Flags().StringVar(&address, "address", "", "Account address of bp")
+	allowPeerCmd.MarkFlagRequired("address")
+	allowPeerCmd.Flags().StringVar(&allowedPeerID, "peer", "", "Peer id to allow")
+	allowPeerCmd.MarkFlagRequired("peer")
+	disallowPeerCmd.Flags().StringVar(&address, "address", "", "Account address of bp")
+	disallowPeerCmd.MarkFlagRequired("address")
+	disallowPeerCmd.Flags().StringVar(&allowedPeerID, "peer", "", "Peer id to disallow")
+	disallowPeerCmd.MarkFlagRequired("peer")
+
+	accountCmd.AddCommand(newCmd, recoverCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd,
+		withdrawCmd, slashCmd, delegateCmd, undelegateCmd, claimCmd, commissionCmd,
+		proposeCmd, voteProposalCmd, allowPeerCmd, disallowPeerCmd)
 	rootCmd.AddCommand(accountCmd)
 }
 
@@ -80,20 +149,19 @@ var newCmd = &cobra.Command{
 				return
 			}
 		}
+		if useHD {
+			createHDAccounts(cmd, param.Passphrase)
+			return
+		}
 		var msg *types.Account
 		var addr []byte
 		if cmd.Flags().Changed("path") == false {
 			msg, err = client.CreateAccount(context.Background(), &param)
 		} else {
 			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
 			defer ks.CloseStore()
 			addr, err = ks.CreateKey(param.Passphrase)
-			if err != nil {
-				cmd.Printf("Failed: %s\n", err.Error())
-				return
-			}
-			err = ks.SaveAddress(addr)
 		}
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -107,6 +175,85 @@ var newCmd = &cobra.Command{
 	},
 }
 
+var recoverCmd = &cobra.Command{
+	Use:   "recover [flags]",
+	Short: "Recover hd wallet from mnemonic and derive accounts from it in the node or cli",
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		param := &types.PersonalHD{Mnemonic: mnemonic, Count: hdCount}
+		if param.Mnemonic == "" {
+			cmd.Print("Enter Mnemonic: ")
+			param.Mnemonic, err = bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+			if err != nil {
+				cmd.Printf("Failed get mnemonic: %s\n", err.Error())
+				return
+			}
+		}
+		if pw != "" {
+			param.Passphrase = pw
+		} else {
+			param.Passphrase, err = getPasswd(cmd, true)
+			if err != nil {
+				cmd.Printf("Failed get password: %s\n", err.Error())
+				return
+			}
+		}
+		var addrs [][]byte
+		if cmd.Flags().Changed("path") == false {
+			var msg *types.HDAccounts
+			msg, err = client.RecoverAccountHD(context.Background(), param)
+			for _, a := range msg.GetAccounts() {
+				addrs = append(addrs, a.GetAddress())
+			}
+		} else {
+			dataEnvPath := os.ExpandEnv(dataDir)
+			ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
+			defer ks.CloseStore()
+			addrs, err = ks.RecoverHDWallet(param.Mnemonic, param.Passphrase, int(param.Count))
+		}
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, a := range addrs {
+			cmd.Println(types.EncodeAddress(a))
+		}
+	},
+}
+
+// createHDAccounts derives accounts from hd wallet, and prints the mnemonic first if the wallet is newly created.
+func createHDAccounts(cmd *cobra.Command, pass string) {
+	var err error
+	var words string
+	var addrs [][]byte
+	if cmd.Flags().Changed("path") == false {
+		var msg *types.HDAccounts
+		msg, err = client.CreateAccountHD(context.Background(), &types.PersonalHD{Passphrase: pass, Count: hdCount})
+		words = msg.GetMnemonic()
+		for _, a := range msg.GetAccounts() {
+			addrs = append(addrs, a.GetAddress())
+		}
+	} else {
+		dataEnvPath := os.ExpandEnv(dataDir)
+		ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
+		defer ks.CloseStore()
+		addrs, err = ks.DeriveHDAccounts(pass, int(hdCount))
+		if err == key.ErrHDWalletNotExist {
+			words, addrs, err = ks.CreateHDWallet(pass, int(hdCount))
+		}
+	}
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	if words != "" {
+		cmd.Printf("Mnemonic (write it down, it is not saved): %s\n", words)
+	}
+	for _, a := range addrs {
+		cmd.Println(types.EncodeAddress(a))
+	}
+}
+
 var listCmd = &cobra.Command{
 	Use:   "list [flags]",
 	Short: "Get account list in the node or cli",
@@ -118,7 +265,7 @@ var listCmd = &cobra.Command{
 			msg, err = client.GetAccounts(context.Background(), &types.Empty{})
 		} else {
 			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
 			defer ks.CloseStore()
 			addrs, err = ks.GetAddresses()
 		}
@@ -188,7 +335,14 @@ var importCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		var err error
 		var address []byte
-		importBuf, err := types.DecodePrivKey(importFormat)
+		var importBuf []byte
+		if importFile != "" {
+			importBuf, err = ioutil.ReadFile(importFile)
+		} else if importFormat != "" {
+			importBuf, err = types.DecodePrivKey(importFormat)
+		} else {
+			err = errors.New("either if or file is required")
+		}
 		if err != nil {
 			cmd.Printf("Failed to decode input: %s\n", err.Error())
 			return
@@ -219,7 +373,7 @@ var importCmd = &cobra.Command{
 			address = msg.GetAddress()
 		} else {
 			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
 			defer ks.CloseStore()
 			address, err = ks.ImportKey(importBuf, wif.Oldpass, wif.Newpass)
 			if err != nil {
@@ -242,7 +396,12 @@ var exportCmd = &cobra.Command{
 		}
 		var result []byte
 		if cmd.Flags().Changed("path") == false {
-			msg, err := client.ExportAccount(context.Background(), param)
+			var msg *types.SingleBytes
+			if exportJSON {
+				msg, err = client.ExportAccountKeystore(context.Background(), param)
+			} else {
+				msg, err = client.ExportAccount(context.Background(), param)
+			}
 			if err != nil {
 				cmd.Printf("Failed: %s\n", err.Error())
 				return
@@ -250,19 +409,34 @@ var exportCmd = &cobra.Command{
 			result = msg.Value
 		} else {
 			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
 			defer ks.CloseStore()
-			wif, err := ks.ExportKey(param.Account.Address, param.Passphrase)
+			if exportJSON {
+				result, err = ks.ExportKeystore(param.Account.Address, param.Passphrase)
+			} else {
+				result, err = ks.ExportKey(param.Account.Address, param.Passphrase)
+			}
 			if err != nil {
 				cmd.Printf("Failed: %s\n", err.Error())
 				return
 			}
-			result = wif
 		}
-		cmd.Println(types.EncodePrivKey(result))
+		if exportJSON {
+			cmd.Println(string(result))
+		} else {
+			cmd.Println(types.EncodePrivKey(result))
+		}
 	},
 }
 
+// keystorePath returns the directory of keystore files for the local key store at dataEnvPath.
+func keystorePath(dataEnvPath string) string {
+	if keystoreDir == "" {
+		return filepath.Join(dataEnvPath, "keystore")
+	}
+	return os.ExpandEnv(keystoreDir)
+}
+
 func parsePersonalParam(cmd *cobra.Command) (*types.Personal, error) {
 	var err error
 	param := &types.Personal{Account: &types.Account{}}
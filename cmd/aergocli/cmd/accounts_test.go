@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -13,6 +15,7 @@ import (
 func TestAccountWithPath(t *testing.T) {
 	const testDir = "test"
 	const testDir2 = "test2"
+	const testDir3 = "test3"
 	outputNew, err := executeCommand(rootCmd, "account", "new", "--password", "1", "--path", testDir)
 	assert.NoError(t, err, "should be success")
 	re := regexp.MustCompile(`\r?\n`)
@@ -38,6 +41,15 @@ func TestAccountWithPath(t *testing.T) {
 
 	outputImport, err = executeCommand(rootCmd, "account", "import", "--if", importFormat, "--password", "1", "--path", testDir2)
 	assert.Equal(t, outputAddress+"\n", outputImport)
+
+	outputExport, err = executeCommand(rootCmd, "account", "export", "--address", outputAddress, "--password", "1", "--path", testDir, "--json")
+	assert.NoError(t, err, "should be success")
+	exportFile := filepath.Join(testDir, "exported.json")
+	assert.NoError(t, ioutil.WriteFile(exportFile, []byte(outputExport), 0600))
+
+	outputImport, err = executeCommand(rootCmd, "account", "import", "--file", exportFile, "--password", "1", "--path", testDir3)
+	assert.Equal(t, outputAddress+"\n", outputImport)
 	os.RemoveAll(testDir)
 	os.RemoveAll(testDir2)
+	os.RemoveAll(testDir3)
 }
@@ -0,0 +1,64 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/spf13/cobra"
+)
+
+var allowedPeerID string
+
+func init() {
+	rootCmd.AddCommand(allowedPeersCmd)
+}
+
+var allowPeerCmd = &cobra.Command{
+	Use:   "allowpeer",
+	Short: "Approve to add a peer to the allowlist of permissioned network (bp only, 2/3+1 of bps)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return execAllowlist(cmd, types.AddAllowedPeer)
+	},
+}
+
+var disallowPeerCmd = &cobra.Command{
+	Use:   "disallowpeer",
+	Short: "Approve to remove a peer from the allowlist of permissioned network (bp only, 2/3+1 of bps)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return execAllowlist(cmd, types.RemoveAllowedPeer)
+	},
+}
+
+func execAllowlist(cmd *cobra.Command, name string) error {
+	if _, err := peer.IDB58Decode(allowedPeerID); err != nil {
+		return errors.New("Failed to parse --peer flag (" + allowedPeerID + ")")
+	}
+	ci := types.CallInfo{
+		Name: name,
+		Args: []interface{}{allowedPeerID},
+	}
+	return sendSystemTx(cmd, ci, big.NewInt(0))
+}
+
+var allowedPeersCmd = &cobra.Command{
+	Use:   "allowedpeers",
+	Short: "Show the allowlist of permissioned network. Any peer can join if it is empty",
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.GetAllowedPeers(context.Background(), &types.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, id := range msg.GetIds() {
+			cmd.Println(peer.ID(id).Pretty())
+		}
+	},
+}
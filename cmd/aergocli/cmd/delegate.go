@@ -0,0 +1,104 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var commissionRate uint32
+
+var delegateCmd = &cobra.Command{
+	Use:   "delegate",
+	Short: "Delegate balance to bp",
+	RunE:  execDelegate,
+}
+
+var undelegateCmd = &cobra.Command{
+	Use:   "undelegate",
+	Short: "Withdraw delegated balance from bp",
+	RunE:  execUndelegate,
+}
+
+var claimCmd = &cobra.Command{
+	Use:   "claim",
+	Short: "Claim reward of delegation",
+	RunE:  execClaim,
+}
+
+var commissionCmd = &cobra.Command{
+	Use:   "commission",
+	Short: "Declare commission rate of bp, which is sent from the coinbase account of bp",
+	RunE:  execCommission,
+}
+
+func execDelegate(cmd *cobra.Command, args []string) error {
+	if _, err := types.DecodeAddress(to); err != nil {
+		return errors.New("Failed to parse --bp flag (" + to + ")\n" + err.Error())
+	}
+	amountBigInt, err := util.ParseUnit(amount)
+	if err != nil {
+		return errors.New("Failed to parse --amount flag\n" + err.Error())
+	}
+	return sendSystemTx(cmd, types.CallInfo{Name: types.Delegate, Args: []interface{}{to}}, amountBigInt)
+}
+
+func execUndelegate(cmd *cobra.Command, args []string) error {
+	amountBigInt, err := util.ParseUnit(amount)
+	if err != nil {
+		return errors.New("Failed to parse --amount flag\n" + err.Error())
+	}
+	return sendSystemTx(cmd, types.CallInfo{Name: types.Undelegate}, amountBigInt)
+}
+
+func execClaim(cmd *cobra.Command, args []string) error {
+	return sendSystemTx(cmd, types.CallInfo{Name: types.Claim}, big.NewInt(0))
+}
+
+func execCommission(cmd *cobra.Command, args []string) error {
+	if commissionRate > types.MaxCommission {
+		return errors.New("--rate must be at most 10000")
+	}
+	rate := strconv.FormatUint(uint64(commissionRate), 10)
+	return sendSystemTx(cmd, types.CallInfo{Name: types.SetCommission, Args: []interface{}{rate}}, big.NewInt(0))
+}
+
+func sendSystemTx(cmd *cobra.Command, ci types.CallInfo, amount *big.Int) error {
+	account, err := types.DecodeAddress(address)
+	if err != nil {
+		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	payload, err := json.Marshal(ci)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return nil
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoSystem),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	msg, err := client.SendTX(context.Background(), tx)
+	if err != nil {
+		cmd.Println(err.Error())
+		return nil
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
@@ -6,11 +6,15 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"log"
+	"os"
+	"strconv"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
 	"github.com/spf13/cobra"
 )
 
@@ -54,9 +58,17 @@ func init() {
 	streamCmd.Flags().StringVarP(&argFilter, "argfilter", "", "", "argument filter")
 	streamCmd.MarkFlagRequired("address")
 
+	proofCmd := &cobra.Command{
+		Use:   "proof [flags] tx_hash event_idx",
+		Short: "Get a merkle proof of event and verify it with the receipts root of block",
+		Args:  cobra.MinimumNArgs(2),
+		Run:   execEventProof,
+	}
+
 	eventCmd.AddCommand(
 		listCmd,
 		streamCmd,
+		proofCmd,
 	)
 	rootCmd.AddCommand(eventCmd)
 }
@@ -111,3 +123,32 @@ func execStreamEvent(cmd *cobra.Command, args []string) {
 		cmd.Println(util.JSON(ev))
 	}
 }
+
+func execEventProof(cmd *cobra.Command, args []string) {
+	txHash, err := base58.Decode(args[0])
+	if err != nil {
+		cmd.Printf("Failed decode: %s\n", err.Error())
+		return
+	}
+	eventIdx, err := strconv.ParseInt(args[1], 10, 32)
+	if err != nil {
+		cmd.Printf("Failed: invalid event index %s\n", args[1])
+		return
+	}
+	proof, err := client.GetEventProof(context.Background(), &aergorpc.EventProofParams{TxHash: txHash, EventIdx: int32(eventIdx)})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.JSON(proof))
+	if !bytes.Equal(proof.GetReceiptProof().GetReceipt().GetTxHash(), txHash) || proof.GetEvent().GetEventIdx() != int32(eventIdx) {
+		cmd.Printf("Failed: proof is for other event %d of tx %s\n", proof.GetEvent().GetEventIdx(),
+			base58.Encode(proof.GetReceiptProof().GetReceipt().GetTxHash()))
+		os.Exit(1)
+	}
+	if !proof.VerifyEvent(txHash, int32(eventIdx)) {
+		cmd.Println("Failed: invalid event proof")
+		os.Exit(1)
+	}
+	cmd.Printf("verified (block %s)\n", base58.Encode(proof.GetReceiptProof().GetTxIdx().GetBlockHash()))
+}
@@ -27,6 +27,7 @@ func init() {
 	getstateCmd.Flags().BoolVar(&proof, "proof", false, "Get the proof for the state")
 	getstateCmd.Flags().BoolVar(&compressed, "compressed", false, "Get a compressed proof for the state")
 	getstateCmd.Flags().BoolVar(&staking, "staking", false, "Get the staking info from the address")
+	getstateCmd.Flags().BoolVar(&delegation, "delegation", false, "Get the delegation info from the address")
 	getstateCmd.Flags().StringVar(&unit, "unit", "aergo", "display unit of balance")
 	rootCmd.AddCommand(getstateCmd)
 }
@@ -58,8 +59,35 @@ func execGetState(cmd *cobra.Command, args []string) {
 			cmd.Printf("Failed: %s", err.Error())
 			return
 		}
-		cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d}`+"\n",
-			address, amount, msg.GetWhen())
+		unbonding, err := util.ConvertUnit(msg.GetUnbondingBigInt(), unit)
+		if err != nil {
+			cmd.Printf("Failed: %s", err.Error())
+			return
+		}
+		cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d, "unbonding":"%s", "release":%d}`+"\n",
+			address, amount, msg.GetWhen(), unbonding, msg.GetRelease())
+
+		return
+	}
+	if delegation {
+		msg, err := client.GetDelegation(context.Background(),
+			&types.AccountAddress{Value: addr})
+		if err != nil {
+			cmd.Printf("Failed: %s", err.Error())
+			return
+		}
+		amount, err := util.ConvertUnit(msg.GetAmountBigInt(), unit)
+		if err != nil {
+			cmd.Printf("Failed: %s", err.Error())
+			return
+		}
+		reward, err := util.ConvertUnit(msg.GetRewardBigInt(), unit)
+		if err != nil {
+			cmd.Printf("Failed: %s", err.Error())
+			return
+		}
+		cmd.Printf(`{"account":"%s", "bp":"%s", "delegated":"%s", "reward":"%s", "commission":%d, "when":%d}`+"\n",
+			address, types.EncodeAddress(msg.GetBp()), amount, reward, msg.GetCommission(), msg.GetWhen())
 
 		return
 	}
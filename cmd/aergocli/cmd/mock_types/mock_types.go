@@ -90,6 +90,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) CreateAccount(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).CreateAccount), varargs...)
 }
 
+// CreateAccountHD mocks base method
+func (m *MockAergoRPCServiceClient) CreateAccountHD(arg0 context.Context, arg1 *types.PersonalHD, arg2 ...grpc.CallOption) (*types.HDAccounts, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAccountHD", varargs...)
+	ret0, _ := ret[0].(*types.HDAccounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountHD indicates an expected call of CreateAccountHD
+func (mr *MockAergoRPCServiceClientMockRecorder) CreateAccountHD(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountHD", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).CreateAccountHD), varargs...)
+}
+
 // ExportAccount mocks base method
 func (m *MockAergoRPCServiceClient) ExportAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.SingleBytes, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -108,6 +126,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ExportAccount(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ExportAccount), varargs...)
 }
 
+// ExportAccountKeystore mocks base method
+func (m *MockAergoRPCServiceClient) ExportAccountKeystore(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.SingleBytes, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportAccountKeystore", varargs...)
+	ret0, _ := ret[0].(*types.SingleBytes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAccountKeystore indicates an expected call of ExportAccountKeystore
+func (mr *MockAergoRPCServiceClientMockRecorder) ExportAccountKeystore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAccountKeystore", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ExportAccountKeystore), varargs...)
+}
+
 // GetABI mocks base method
 func (m *MockAergoRPCServiceClient) GetABI(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ABI, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -162,6 +198,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetAccounts(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetAccounts), varargs...)
 }
 
+// GetAllowedPeers mocks base method
+func (m *MockAergoRPCServiceClient) GetAllowedPeers(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.AllowedPeers, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllowedPeers", varargs...)
+	ret0, _ := ret[0].(*types.AllowedPeers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllowedPeers indicates an expected call of GetAllowedPeers
+func (mr *MockAergoRPCServiceClientMockRecorder) GetAllowedPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllowedPeers", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetAllowedPeers), varargs...)
+}
+
 // GetBlock mocks base method
 func (m *MockAergoRPCServiceClient) GetBlock(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Block, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -288,6 +342,78 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetConsensusInfo(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsensusInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetConsensusInfo), varargs...)
 }
 
+// GetDBStats mocks base method
+func (m *MockAergoRPCServiceClient) GetDBStats(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.DBStats, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDBStats", varargs...)
+	ret0, _ := ret[0].(*types.DBStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDBStats indicates an expected call of GetDBStats
+func (mr *MockAergoRPCServiceClientMockRecorder) GetDBStats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDBStats", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetDBStats), varargs...)
+}
+
+// GetDelegation mocks base method
+func (m *MockAergoRPCServiceClient) GetDelegation(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.Delegation, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDelegation", varargs...)
+	ret0, _ := ret[0].(*types.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelegation indicates an expected call of GetDelegation
+func (mr *MockAergoRPCServiceClientMockRecorder) GetDelegation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegation", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetDelegation), varargs...)
+}
+
+// GetEventProof mocks base method
+func (m *MockAergoRPCServiceClient) GetEventProof(arg0 context.Context, arg1 *types.EventProofParams, arg2 ...grpc.CallOption) (*types.EventProof, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventProof", varargs...)
+	ret0, _ := ret[0].(*types.EventProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventProof indicates an expected call of GetEventProof
+func (mr *MockAergoRPCServiceClientMockRecorder) GetEventProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetEventProof), varargs...)
+}
+
+// GetNameByAddress mocks base method
+func (m *MockAergoRPCServiceClient) GetNameByAddress(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.NameInfo, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNameByAddress", varargs...)
+	ret0, _ := ret[0].(*types.NameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNameByAddress indicates an expected call of GetNameByAddress
+func (mr *MockAergoRPCServiceClientMockRecorder) GetNameByAddress(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameByAddress", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetNameByAddress), varargs...)
+}
+
 // GetNameInfo mocks base method
 func (m *MockAergoRPCServiceClient) GetNameInfo(arg0 context.Context, arg1 *types.Name, arg2 ...grpc.CallOption) (*types.NameInfo, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -342,6 +468,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetReceipt(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetReceipt), varargs...)
 }
 
+// GetReceiptProof mocks base method
+func (m *MockAergoRPCServiceClient) GetReceiptProof(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ReceiptProof, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReceiptProof", varargs...)
+	ret0, _ := ret[0].(*types.ReceiptProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptProof indicates an expected call of GetReceiptProof
+func (mr *MockAergoRPCServiceClientMockRecorder) GetReceiptProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetReceiptProof), varargs...)
+}
+
 // GetServerInfo mocks base method
 func (m *MockAergoRPCServiceClient) GetServerInfo(arg0 context.Context, arg1 *types.KeyParams, arg2 ...grpc.CallOption) (*types.ServerInfo, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -432,6 +576,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetTX(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetTX), varargs...)
 }
 
+// GetTxProof mocks base method
+func (m *MockAergoRPCServiceClient) GetTxProof(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.TxProof, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTxProof", varargs...)
+	ret0, _ := ret[0].(*types.TxProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxProof indicates an expected call of GetTxProof
+func (mr *MockAergoRPCServiceClientMockRecorder) GetTxProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetTxProof), varargs...)
+}
+
 // GetVotes mocks base method
 func (m *MockAergoRPCServiceClient) GetVotes(arg0 context.Context, arg1 *types.VoteParams, arg2 ...grpc.CallOption) (*types.VoteList, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -576,6 +738,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ListEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListEvents), varargs...)
 }
 
+// ListProposals mocks base method
+func (m *MockAergoRPCServiceClient) ListProposals(arg0 context.Context, arg1 *types.ListParams, arg2 ...grpc.CallOption) (*types.ProposalList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProposals", varargs...)
+	ret0, _ := ret[0].(*types.ProposalList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProposals indicates an expected call of ListProposals
+func (mr *MockAergoRPCServiceClientMockRecorder) ListProposals(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProposals", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListProposals), varargs...)
+}
+
 // LockAccount mocks base method
 func (m *MockAergoRPCServiceClient) LockAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.Account, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -666,6 +846,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) QueryContractState(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContractState", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).QueryContractState), varargs...)
 }
 
+// RecoverAccountHD mocks base method
+func (m *MockAergoRPCServiceClient) RecoverAccountHD(arg0 context.Context, arg1 *types.PersonalHD, arg2 ...grpc.CallOption) (*types.HDAccounts, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecoverAccountHD", varargs...)
+	ret0, _ := ret[0].(*types.HDAccounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverAccountHD indicates an expected call of RecoverAccountHD
+func (mr *MockAergoRPCServiceClientMockRecorder) RecoverAccountHD(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverAccountHD", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).RecoverAccountHD), varargs...)
+}
+
 // SendTX mocks base method
 func (m *MockAergoRPCServiceClient) SendTX(arg0 context.Context, arg1 *types.Tx, arg2 ...grpc.CallOption) (*types.CommitResult, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -9,8 +9,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -27,7 +30,7 @@ func init() {
 	rootCmd.AddCommand(nameCmd)
 	newCmd := &cobra.Command{
 		Use:                   "new",
-		Short:                 "Create account name. It spend at least 1 aergo",
+		Short:                 "Create account name. It spend at least 1 aergo except subname such as pay.<name>",
 		RunE:                  execNameNew,
 		DisableFlagsInUseLine: true,
 	}
@@ -51,6 +54,29 @@ func init() {
 	updateCmd.MarkFlagRequired("name")
 	updateCmd.Flags().StringVar(&spending, "amount", "1aergo", "Spending for create name. at least 1 aergo")
 
+	renewCmd := &cobra.Command{
+		Use:                   "renew",
+		Short:                 "Extend the expiry of account name",
+		RunE:                  execNameRenew,
+		DisableFlagsInUseLine: true,
+	}
+	renewCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	renewCmd.MarkFlagRequired("from")
+	renewCmd.Flags().StringVar(&name, "name", "", "Name of account to renew")
+	renewCmd.MarkFlagRequired("name")
+	renewCmd.Flags().StringVar(&spending, "amount", "1aergo", "Spending for renew name. at least 1 aergo")
+
+	revokeCmd := &cobra.Command{
+		Use:                   "revoke",
+		Short:                 "Revoke subname by the owner of parent name",
+		RunE:                  execNameRevoke,
+		DisableFlagsInUseLine: true,
+	}
+	revokeCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	revokeCmd.MarkFlagRequired("from")
+	revokeCmd.Flags().StringVar(&name, "name", "", "Subname to revoke")
+	revokeCmd.MarkFlagRequired("name")
+
 	ownerCmd := &cobra.Command{
 		Use:                   "owner",
 		Short:                 "Owner of account name",
@@ -60,7 +86,16 @@ func init() {
 	ownerCmd.Flags().StringVar(&name, "name", "", "Name of account to create")
 	ownerCmd.MarkFlagRequired("name")
 
-	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd)
+	lookupCmd := &cobra.Command{
+		Use:                   "lookup",
+		Short:                 "Primary name of account address",
+		Run:                   execNameLookup,
+		DisableFlagsInUseLine: true,
+	}
+	lookupCmd.Flags().StringVar(&address, "address", "", "Account address to look up")
+	lookupCmd.MarkFlagRequired("address")
+
+	nameCmd.AddCommand(newCmd, updateCmd, renewCmd, revokeCmd, ownerCmd, lookupCmd)
 }
 
 func execNameNew(cmd *cobra.Command, args []string) error {
@@ -69,13 +104,17 @@ func execNameNew(cmd *cobra.Command, args []string) error {
 		return errors.New("Wrong address in --from flag\n" + err.Error())
 	}
 
-	if len(name) != types.NameLength {
-		return errors.New("The name must be 12 alphabetic characters\n")
+	if err := checkNameLength(name); err != nil {
+		return err
 	}
 	amount, err := util.ParseUnit(spending)
 	if err != nil {
 		return errors.New("Wrong value in --amount flag\n" + err.Error())
 	}
+	// subname is free
+	if isSubName(name) && !cmd.Flags().Changed("amount") {
+		amount = big.NewInt(0)
+	}
 	var ci types.CallInfo
 	ci.Name = types.NameCreate
 	err = json.Unmarshal([]byte("[\""+name+"\"]"), &ci.Args)
@@ -128,8 +167,11 @@ func execNameUpdate(cmd *cobra.Command, args []string) error {
 		amount = big.NewInt(0)
 	} else {
 		ci.Name = types.NameUpdate
-		if len(name) != types.NameLength {
-			return errors.New("The name must be 12 alphabetic characters\n")
+		if err := checkNameLength(name); err != nil {
+			return err
+		}
+		if isSubName(name) && !cmd.Flags().Changed("amount") {
+			amount = big.NewInt(0)
 		}
 		err = json.Unmarshal([]byte("[\""+name+"\",\""+to+"\"]"), &ci.Args)
 		if err != nil {
@@ -159,13 +201,116 @@ func execNameUpdate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func execNameRenew(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(from)
+	if err != nil {
+		return errors.New("Wrong address in --from flag\n" + err.Error())
+	}
+	if len(name) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	amount, err := util.ParseUnit(spending)
+	if err != nil {
+		return errors.New("Wrong value in --amount flag\n" + err.Error())
+	}
+	ci := types.CallInfo{Name: types.NameRenew, Args: []interface{}{name}}
+	payload, err := json.Marshal(ci)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	msg, err := client.SendTX(context.Background(), tx)
+	if err != nil {
+		cmd.Printf("Failed request to aergo sever\n" + err.Error())
+		return nil
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
+
+func execNameRevoke(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(from)
+	if err != nil {
+		return errors.New("Wrong address in --from flag\n" + err.Error())
+	}
+	if !isSubName(name) {
+		return errors.New("Only subname such as pay.<name> can be revoked\n")
+	}
+	ci := types.CallInfo{Name: types.NameRevoke, Args: []interface{}{name}}
+	payload, err := json.Marshal(ci)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	msg, err := client.SendTX(context.Background(), tx)
+	if err != nil {
+		cmd.Printf("Failed request to aergo sever\n" + err.Error())
+		return nil
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
+
+func isSubName(name string) bool {
+	return strings.Contains(name, ".")
+}
+
+func checkNameLength(name string) error {
+	if isSubName(name) {
+		if len(name) > types.MaxNameLength {
+			return fmt.Errorf("The subname must be at most %d characters\n", types.MaxNameLength)
+		}
+		return nil
+	}
+	if len(name) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	return nil
+}
+
 func execNameOwner(cmd *cobra.Command, args []string) {
 	msg, err := client.GetNameInfo(context.Background(), &types.Name{Name: name})
 	if err != nil {
 		cmd.Println(err.Error())
 		return
 	}
+	printNameInfo(cmd, msg)
+}
+
+func execNameLookup(cmd *cobra.Command, args []string) {
+	addr, err := types.DecodeAddress(address)
+	if err != nil {
+		cmd.Println("Wrong address in --address flag\n" + err.Error())
+		return
+	}
+	msg, err := client.GetNameByAddress(context.Background(), &types.AccountAddress{Value: addr})
+	if err != nil {
+		cmd.Println(err.Error())
+		return
+	}
+	printNameInfo(cmd, msg)
+}
+
+func printNameInfo(cmd *cobra.Command, msg *types.NameInfo) {
 	cmd.Println("{\n \"" + msg.Name.Name + "\": {\n  " +
 		"\"Owner\": \"" + types.EncodeAddress(msg.Owner) + "\",\n  " +
-		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\"\n  }\n}")
+		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\",\n  " +
+		"\"ExpireBlock\": " + strconv.FormatUint(msg.ExpireBlock, 10) + "\n  }\n}")
 }
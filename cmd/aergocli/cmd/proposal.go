@@ -0,0 +1,88 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var proposalParam string
+var proposalValue string
+var proposalID uint64
+var proposalChoice string
+var proposalsSize uint32
+var proposalsOffset uint32
+var proposalsAsc bool
+
+func init() {
+	rootCmd.AddCommand(proposalsCmd)
+	proposalsCmd.Flags().Uint32Var(&proposalsSize, "size", 20, "maximum number of proposals to show")
+	proposalsCmd.Flags().Uint32Var(&proposalsOffset, "offset", 0, "number of proposals to skip")
+	proposalsCmd.Flags().BoolVar(&proposalsAsc, "asc", false, "show the oldest proposal first")
+}
+
+var proposeCmd = &cobra.Command{
+	Use:   "propose",
+	Short: "Propose a new value of a system parameter",
+	Long: "Propose a new value of a system parameter.\n" +
+		"Parameters: bpcount, maxblocksize, basetxfee, aerperbyte, nameprice, minstaking",
+	RunE: execPropose,
+}
+
+func execPropose(cmd *cobra.Command, args []string) error {
+	value, ok := new(big.Int).SetString(proposalValue, 10)
+	if !ok {
+		return errors.New("Failed to parse --value flag (" + proposalValue + ")")
+	}
+	ci := types.CallInfo{
+		Name: types.Propose,
+		Args: []interface{}{proposalParam, value.String()},
+	}
+	return sendSystemTx(cmd, ci, big.NewInt(0))
+}
+
+var voteProposalCmd = &cobra.Command{
+	Use:   "voteproposal",
+	Short: "Vote yes or no to a proposal with the staked balance",
+	RunE:  execVoteProposal,
+}
+
+func execVoteProposal(cmd *cobra.Command, args []string) error {
+	if proposalChoice != types.ProposalYes && proposalChoice != types.ProposalNo {
+		return errors.New("Failed to parse --choice flag (" + proposalChoice + "), it must be yes or no")
+	}
+	ci := types.CallInfo{
+		Name: types.VoteProposal,
+		Args: []interface{}{strconv.FormatUint(proposalID, 10), proposalChoice},
+	}
+	return sendSystemTx(cmd, ci, big.NewInt(0))
+}
+
+var proposalsCmd = &cobra.Command{
+	Use:   "proposals",
+	Short: "Show governance proposals",
+	Run:   execProposals,
+}
+
+func execProposals(cmd *cobra.Command, args []string) {
+	msg, err := client.ListProposals(context.Background(), &types.ListParams{
+		Size:   proposalsSize,
+		Offset: proposalsOffset,
+		Asc:    proposalsAsc,
+	})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.JSON(msg))
+}
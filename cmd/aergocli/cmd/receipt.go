@@ -6,6 +6,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"log"
 
@@ -39,5 +40,28 @@ func init() {
 				cmd.Println(util.JSON(msg))
 			},
 		},
+		&cobra.Command{
+			Use:   "proof [flags] tx_hash",
+			Short: "Get a merkle proof of receipt and verify it with the receipts root of block",
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				txHash, err := base58.Decode(args[0])
+				if err != nil {
+					log.Fatal(err)
+				}
+				msg, err := client.GetReceiptProof(context.Background(), &aergorpc.SingleBytes{Value: txHash})
+				if err != nil {
+					log.Fatal(err)
+				}
+				cmd.Println(util.JSON(msg))
+				if !bytes.Equal(msg.GetReceipt().GetTxHash(), txHash) {
+					log.Fatalf("proof is for other tx %s", base58.Encode(msg.GetReceipt().GetTxHash()))
+				}
+				if !msg.VerifyTx(txHash) {
+					log.Fatal("failed to verify receipt proof")
+				}
+				cmd.Printf("verified (block %s)\n", base58.Encode(msg.GetTxIdx().GetBlockHash()))
+			},
+		},
 	)
 }
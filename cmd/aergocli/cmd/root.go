@@ -28,9 +28,17 @@ var (
 	host    string
 	port    int32
 
-	privKey string
-	pw      string
-	dataDir string
+	// rpc auth
+	authToken  string
+	useTLS     bool
+	caCertFile string
+	certFile   string
+	keyFile    string
+
+	privKey     string
+	pw          string
+	dataDir     string
+	keystoreDir string
 
 	from   string
 	to     string
@@ -43,7 +51,8 @@ var (
 	proof      bool
 	compressed bool
 
-	staking bool
+	staking    bool
+	delegation bool
 
 	remote       bool
 	importFormat string
@@ -67,6 +76,11 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is cliconfig.toml)")
 	rootCmd.PersistentFlags().StringVarP(&host, "host", "H", "localhost", "Host address to aergo server")
 	rootCmd.PersistentFlags().Int32VarP(&port, "port", "p", 7845, "Port number to aergo server")
+	rootCmd.PersistentFlags().StringVar(&authToken, "token", "", "API token or JWT for the server with rpc auth")
+	rootCmd.PersistentFlags().BoolVar(&useTLS, "tls", false, "Connect to aergo server with TLS")
+	rootCmd.PersistentFlags().StringVar(&caCertFile, "tlsca", "", "CA certificate file to verify aergo server, used with tls (default is system CAs)")
+	rootCmd.PersistentFlags().StringVar(&certFile, "tlscert", "", "Client certificate file, used with tls")
+	rootCmd.PersistentFlags().StringVar(&keyFile, "tlskey", "", "Private key file of client certificate, used with tls")
 }
 
 func initConfig() {
@@ -100,7 +114,10 @@ func connectAergo(cmd *cobra.Command, args []string) {
 	}
 
 	serverAddr := GetServerAddress()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+	opts, err := dialOptions()
+	if err != nil {
+		log.Fatal(err)
+	}
 	var ok bool
 	client, ok = util.GetClient(serverAddr, opts).(*util.ConnClient)
 	if !ok {
@@ -108,6 +125,23 @@ func connectAergo(cmd *cobra.Command, args []string) {
 	}
 }
 
+func dialOptions() ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+	if useTLS {
+		creds, err := util.NewTLSCredentials(caCertFile, certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if authToken != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(util.NewTokenCredentials(authToken, useTLS)))
+	}
+	return opts, nil
+}
+
 func disconnectAergo(cmd *cobra.Command, args []string) {
 	if test {
 		return
@@ -16,6 +16,7 @@ func init() {
 	rootCmd.AddCommand(signCmd)
 	signCmd.Flags().StringVar(&jsonTx, "jsontx", "", "transaction json to sign")
 	signCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data/cli", "path to data directory")
+	signCmd.Flags().StringVar(&keystoreDir, "keystore", "", "path to keystore files directory (default \"keystore\" under path)")
 	signCmd.Flags().StringVar(&address, "address", "1", "address of account to use for signing")
 	signCmd.Flags().StringVar(&pw, "password", "", "local account password")
 	signCmd.Flags().StringVar(&privKey, "key", "", "base58 encoded key for sign")
@@ -72,7 +73,7 @@ var signCmd = &cobra.Command{
 			}
 
 			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks := key.NewStore(dataEnvPath, keystorePath(dataEnvPath), 0)
 			defer ks.CloseStore()
 			addr, err := types.DecodeAddress(address)
 			if err != nil {
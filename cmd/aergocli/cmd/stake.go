@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math/big"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -35,6 +36,36 @@ func execUnstake(cmd *cobra.Command, args []string) error {
 	return sendStake(cmd, false)
 }
 
+var withdrawCmd = &cobra.Command{
+	Use:   "withdraw",
+	Short: "Withdraw unstaked or undelegated balance after the unbonding period",
+	RunE:  execWithdraw,
+}
+
+func execWithdraw(cmd *cobra.Command, args []string) error {
+	return sendSystemTx(cmd, types.CallInfo{Name: types.Withdraw}, big.NewInt(0))
+}
+
+var slashCmd = &cobra.Command{
+	Use:   "slash <header1> <header2>",
+	Short: "Submit evidence of a bp which signed two different blocks of the same block number",
+	Long: "Submit evidence of a bp which signed two different blocks of the same block number.\n" +
+		"Each header is the base58 encoded protobuf bytes of a signed block header.",
+	Args: cobra.ExactArgs(2),
+	RunE: execSlash,
+}
+
+func execSlash(cmd *cobra.Command, args []string) error {
+	evidence := make([]interface{}, len(args))
+	for i, arg := range args {
+		if _, err := types.DecodeBlockHeader(arg); err != nil {
+			return errors.New("Failed to decode block header\n" + err.Error())
+		}
+		evidence[i] = arg
+	}
+	return sendSystemTx(cmd, types.CallInfo{Name: types.Slash, Args: evidence}, big.NewInt(0))
+}
+
 func sendStake(cmd *cobra.Command, s bool) error {
 	account, err := types.DecodeAddress(address)
 	if err != nil {
@@ -0,0 +1,65 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// NewTLSCredentials returns transport credentials of grpc. The server is verified by CA certificate in
+// caCertFile, or system CAs if it is empty. Client certificate is sent if certFile and keyFile are given.
+func NewTLSCredentials(caCertFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	tlsConfig := &tls.Config{}
+	if caCertFile != "" {
+		caPEM, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate in %s", caCertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, errors.New("both of client certificate and key are required")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// tokenCredentials sends api token or JWT as bearer token in every rpc call
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+// NewTokenCredentials returns per rpc credentials of token. secure is whether the connection is over TLS.
+func NewTokenCredentials(token string, secure bool) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token, secure: secure}
+}
+
+func (tc *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + tc.token}, nil
+}
+
+// RequireTransportSecurity allows token over insecure connection, which is used to the server at local.
+func (tc *tokenCredentials) RequireTransportSecurity() bool {
+	return tc.secure
+}
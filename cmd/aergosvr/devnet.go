@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/devnet"
+	"github.com/spf13/cobra"
+)
+
+var (
+	devnetNodes     int
+	devnetConsensus string
+	devnetDir       string
+	devnetInterval  int64
+	devnetBalances  []string
+)
+
+func init() {
+	fs := devnetCmd.Flags()
+	fs.IntVar(&devnetNodes, "nodes", 3, "number of nodes")
+	fs.StringVar(&devnetConsensus, "consensus", devnet.ConsensusDPOS, "consensus type (dpos, raft or sbp)")
+	fs.StringVar(&devnetDir, "dir", "devnet", "directory of nodes, which must not exist")
+	fs.Int64Var(&devnetInterval, "interval", 1, "block interval (sec)")
+	fs.StringArrayVar(&devnetBalances, "balance", nil, "initial balance in the form of address=amount (repeatable)")
+
+	rootCmd.AddCommand(devnetCmd)
+}
+
+const devnetHelp = `commands:
+  status                      print the best block numbers of nodes
+  start|stop|kill|restart <n> control nth node
+  partition <n,n> <n,n> ...   cut the links between the groups of nodes, isolating the others
+  heal                        restore all the links
+  wait <height> [sec]         wait until all the running nodes reach height
+  quit                        stop all the nodes and exit`
+
+var devnetCmd = &cobra.Command{
+	Use:   "devnet",
+	Short: "Launch a local network of nodes",
+	Long: "Launch a local network of nodes on loopback with generated keys and genesis, for testing consensus and " +
+		"sync. The nodes are child processes of this command, and connect to each other through the links of " +
+		"this command, which partitions them. The nodes are controlled by the commands read from stdin.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bin, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		balances, err := parsePairs(devnetBalances, "balance")
+		if err != nil {
+			return err
+		}
+		d, err := devnet.New(devnet.Config{
+			Bin:           bin,
+			Dir:           devnetDir,
+			Nodes:         devnetNodes,
+			Consensus:     devnetConsensus,
+			BlockInterval: devnetInterval,
+			Balance:       balances,
+		})
+		if err != nil {
+			return err
+		}
+		defer d.Close()
+		if err := d.Start(); err != nil {
+			return err
+		}
+
+		fmt.Printf("devnet of %d %s nodes is started (genesis hash: %s)\n", devnetNodes, devnetConsensus, enc.ToString(d.GenesisHash))
+		for i, n := range d.Nodes {
+			fmt.Printf("  %d: %s rpc=%s peer=%s dir=%s\n", i, n.Name, n.RPCAddr, n.PeerID, n.Dir)
+		}
+		fmt.Println(devnetHelp)
+
+		lines := make(chan string)
+		go func() {
+			scanner := bufio.NewScanner(os.Stdin)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+			close(lines)
+		}()
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+
+		for {
+			fmt.Print("> ")
+			select {
+			case <-interrupt:
+				fmt.Println()
+				return nil
+			case line, ok := <-lines:
+				if !ok || strings.TrimSpace(line) == "quit" {
+					return nil
+				}
+				if err := runDevnetCommand(d, strings.Fields(line)); err != nil {
+					fmt.Printf("error: %s\n", err.Error())
+				}
+			}
+		}
+	},
+}
+
+func runDevnetCommand(d *devnet.Devnet, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	node := func() (int, error) {
+		if len(args) != 2 {
+			return 0, fmt.Errorf("usage: %s <n>", args[0])
+		}
+		return strconv.Atoi(args[1])
+	}
+
+	switch args[0] {
+	case "status":
+		for i, n := range d.Nodes {
+			if !n.Running() {
+				fmt.Printf("  %d: %s stopped\n", i, n.Name)
+			} else if height, err := n.Height(); err != nil {
+				fmt.Printf("  %d: %s error: %s\n", i, n.Name, err.Error())
+			} else {
+				fmt.Printf("  %d: %s height=%d\n", i, n.Name, height)
+			}
+		}
+		return nil
+	case "start", "stop", "kill", "restart":
+		i, err := node()
+		if err != nil {
+			return err
+		}
+		control := map[string]func(int) error{
+			"start":   d.StartNode,
+			"stop":    d.StopNode,
+			"kill":    d.KillNode,
+			"restart": d.RestartNode,
+		}
+		return control[args[0]](i)
+	case "partition":
+		var groups [][]int
+		for _, arg := range args[1:] {
+			var group []int
+			for _, s := range strings.Split(arg, ",") {
+				i, err := strconv.Atoi(s)
+				if err != nil {
+					return err
+				}
+				group = append(group, i)
+			}
+			groups = append(groups, group)
+		}
+		return d.Partition(groups...)
+	case "heal":
+		d.Heal()
+		return nil
+	case "wait":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: wait <height> [sec]")
+		}
+		height, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		timeout := time.Minute
+		if len(args) > 2 {
+			sec, err := strconv.Atoi(args[2])
+			if err != nil {
+				return err
+			}
+			timeout = time.Duration(sec) * time.Second
+		}
+		return d.WaitHeight(height, timeout)
+	default:
+		fmt.Println(devnetHelp)
+		return nil
+	}
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	genesisMagic       string
+	genesisConsensus   string
+	genesisPublic      bool
+	genesisTimestamp   int64
+	genesisBPs         []string
+	genesisGenBPs      int
+	genesisKeyDir      string
+	genesisBalances    []string
+	genesisStakes      []string
+	genesisForks       []string
+	genesisOut         string
+	genesisInteractive bool
+)
+
+func init() {
+	fs := genesisCreateCmd.Flags()
+	fs.StringVar(&genesisMagic, "magic", "", "chain magic, which identifies the chain")
+	fs.StringVar(&genesisConsensus, "consensus", "dpos", "consensus type (dpos, raft or sbp)")
+	fs.BoolVar(&genesisPublic, "public", false, "create genesis of public net")
+	fs.Int64Var(&genesisTimestamp, "timestamp", 0, "timestamp of genesis block in nanoseconds (default is now)")
+	fs.StringArrayVar(&genesisBPs, "bp", nil, "peer id of bp (repeatable)")
+	fs.IntVar(&genesisGenBPs, "genbp", 0, "number of bp keys to generate in keydir, whose peer ids are added to bps")
+	fs.StringVar(&genesisKeyDir, "keydir", ".", "directory of generated bp keys")
+	fs.StringArrayVar(&genesisBalances, "balance", nil, "initial balance in the form of address=amount (repeatable)")
+	fs.StringArrayVar(&genesisStakes, "stake", nil, "initial stake in the form of address=amount (repeatable)")
+	fs.StringArrayVar(&genesisForks, "fork", nil, "fork schedule in the form of name=blockNo (repeatable)")
+	fs.StringVar(&genesisOut, "out", "genesis.json", "genesis json file to create, which must not exist")
+	fs.BoolVarP(&genesisInteractive, "interactive", "i", false, "ask the fields of genesis, which default to the flags")
+
+	genesisCmd.AddCommand(genesisCreateCmd, genesisValidateCmd)
+	rootCmd.AddCommand(genesisCmd)
+}
+
+var genesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "Create or validate genesis json for private net",
+}
+
+var genesisCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create genesis json",
+	Long: "Create genesis json from the flags, or interactively with --interactive. The genesis is validated by " +
+		"creating its genesis block in a temporary directory, and the genesis hash and the chain id are printed.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := os.Stat(genesisOut); !os.IsNotExist(err) {
+			return fmt.Errorf("%s already exists", genesisOut)
+		}
+		if genesisInteractive {
+			if err := askGenesis(bufio.NewReader(os.Stdin), os.Stdout); err != nil {
+				return err
+			}
+		}
+
+		genesis, err := buildGenesis()
+		if err != nil {
+			return err
+		}
+		b, err := json.MarshalIndent(genesis, "", "    ")
+		if err != nil {
+			return err
+		}
+		if err := checkGenesis(genesis); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(genesisOut, append(b, '\n'), 0644); err != nil {
+			return err
+		}
+		fmt.Printf("genesis is written to %s\n", genesisOut)
+		return nil
+	},
+}
+
+var genesisValidateCmd = &cobra.Command{
+	Use:   "validate <genesis json>",
+	Short: "Validate genesis json",
+	Long: "Validate genesis json by creating its genesis block in a temporary directory, and print the genesis " +
+		"hash and the chain id.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		b, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		genesis := new(types.Genesis)
+		if err := json.Unmarshal(b, genesis); err != nil {
+			return fmt.Errorf("fail to deserialize %s (error:%s)", args[0], err.Error())
+		}
+		return checkGenesis(genesis)
+	},
+}
+
+// checkGenesis creates the genesis block in a temporary directory, and prints its hash and the chain id
+func checkGenesis(genesis *types.Genesis) error {
+	block, err := chain.CheckGenesis(genesis)
+	if err != nil {
+		return fmt.Errorf("invalid genesis: %s", err.Error())
+	}
+	fmt.Printf("genesis hash: %s\n", enc.ToString(block.BlockHash()))
+	fmt.Printf("chain id: %s\n", genesis.ID.ToJSON())
+	return nil
+}
+
+// buildGenesis creates genesis from the flags, generating bp keys if asked
+func buildGenesis() (*types.Genesis, error) {
+	if genesisMagic == "" {
+		return nil, fmt.Errorf("chain magic is required")
+	}
+	genesis := &types.Genesis{
+		ID: types.ChainID{
+			Magic:     genesisMagic,
+			PublicNet: genesisPublic,
+			Consensus: genesisConsensus,
+		},
+		Timestamp: genesisTimestamp,
+		BPs:       append([]string{}, genesisBPs...),
+	}
+	if genesis.Timestamp == 0 {
+		genesis.Timestamp = time.Now().UnixNano()
+	}
+
+	var err error
+	if genesis.Balance, err = parsePairs(genesisBalances, "balance"); err != nil {
+		return nil, err
+	}
+	if len(genesisStakes) > 0 {
+		if genesis.Stakes, err = parsePairs(genesisStakes, "stake"); err != nil {
+			return nil, err
+		}
+	}
+	if len(genesisForks) > 0 {
+		if genesis.Forks, err = types.ParseForks(genesisForks); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := 0; i < genesisGenBPs; i++ {
+		prefix := fmt.Sprintf("bp%d", len(genesis.BPs)+1)
+		_, pub, err := p2putil.GenerateKeyFile(genesisKeyDir, prefix)
+		if err != nil {
+			return nil, err
+		}
+		id, err := peer.IDFromPublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("bp key %s is generated in %s (peer id: %s)\n", prefix, genesisKeyDir, peer.IDB58Encode(id))
+		genesis.BPs = append(genesis.BPs, peer.IDB58Encode(id))
+	}
+	return genesis, nil
+}
+
+// parsePairs parses the list in the form of key=value
+func parsePairs(list []string, what string) (map[string]string, error) {
+	pairs := make(map[string]string, len(list))
+	for _, item := range list {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid %s %s: not in the form of address=amount", what, item)
+		}
+		pairs[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return pairs, nil
+}
+
+// askGenesis asks the fields of genesis, and sets the flags to the answers
+func askGenesis(r *bufio.Reader, w io.Writer) error {
+	ask := func(question, dflt string) (string, error) {
+		if dflt != "" {
+			fmt.Fprintf(w, "%s [%s]: ", question, dflt)
+		} else {
+			fmt.Fprintf(w, "%s: ", question)
+		}
+		line, err := r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		if line = strings.TrimSpace(line); line == "" {
+			return dflt, nil
+		}
+		return line, nil
+	}
+	askList := func(question string, list []string) ([]string, error) {
+		for {
+			line, err := ask(question+" (empty to finish)", "")
+			if err != nil || line == "" {
+				return list, err
+			}
+			list = append(list, line)
+		}
+	}
+
+	var err error
+	if genesisMagic, err = ask("chain magic", genesisMagic); err != nil {
+		return err
+	}
+	if genesisConsensus, err = ask("consensus type (dpos, raft or sbp)", genesisConsensus); err != nil {
+		return err
+	}
+	public := "n"
+	if genesisPublic {
+		public = "y"
+	}
+	if public, err = ask("public net (y/n)", public); err != nil {
+		return err
+	}
+	genesisPublic = strings.HasPrefix(strings.ToLower(public), "y")
+
+	if genesisBPs, err = askList("bp peer id", genesisBPs); err != nil {
+		return err
+	}
+	genbp, err := ask("number of bp keys to generate", strconv.Itoa(genesisGenBPs))
+	if err != nil {
+		return err
+	}
+	if genesisGenBPs, err = strconv.Atoi(genbp); err != nil {
+		return fmt.Errorf("invalid number of bp keys %s", genbp)
+	}
+	if genesisGenBPs > 0 {
+		if genesisKeyDir, err = ask("directory of bp keys", genesisKeyDir); err != nil {
+			return err
+		}
+	}
+
+	if genesisBalances, err = askList("initial balance (address=amount)", genesisBalances); err != nil {
+		return err
+	}
+	if genesisStakes, err = askList("initial stake (address=amount)", genesisStakes); err != nil {
+		return err
+	}
+	return nil
+}
@@ -109,7 +109,7 @@ func getCore(dataDir string) *chain.Core {
 		}
 	}
 
-	core, err := chain.NewCore(cfg.DbType, dataDir, false, 0)
+	core, err := chain.NewCore(cfg.DbType, dataDir, cfg.DbPrefixed, false, 0)
 	if err != nil {
 		fmt.Printf("fail to init a blockchain core (error:%s)\n", err)
 		return nil
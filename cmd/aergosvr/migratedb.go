@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/aergoio/aergo/pkg/storage"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateFromType string
+	migrateToType   string
+	migrateToDir    string
+)
+
+// stores of data directory, which are copied by key. The other files are copied as they are.
+var migrateStores = []string{"chain", "state"}
+
+func init() {
+	migrateDB.Flags().StringVar(&migrateFromType, "fromtype", "", "db implementation of data directory (default is dbtype of config)")
+	migrateDB.Flags().StringVar(&migrateToType, "totype", "", "db implementation of new data directory")
+	migrateDB.Flags().StringVar(&migrateToDir, "to", "", "new data directory, which must not exist")
+	migrateDB.MarkFlagRequired("totype")
+	migrateDB.MarkFlagRequired("to")
+
+	rootCmd.AddCommand(migrateDB)
+}
+
+var migrateDB = &cobra.Command{
+	Use:   "migratedb",
+	Short: "Copy data directory to a new one with another db implementation",
+	Long: "Copy data directory of config to a new one with another db implementation. The server must be stopped. " +
+		"The key layout of stores is kept, so change dbtype of config after migration.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if migrateFromType == "" {
+			migrateFromType = cfg.DbType
+		}
+		if _, err := os.Stat(migrateToDir); !os.IsNotExist(err) {
+			return fmt.Errorf("%s already exists", migrateToDir)
+		}
+		if err := os.MkdirAll(migrateToDir, 0755); err != nil {
+			return err
+		}
+
+		for _, name := range migrateStores {
+			if err := migrateStore(name); err != nil {
+				return fmt.Errorf("failed to migrate %s store: %s", name, err.Error())
+			}
+		}
+		if err := copyOtherFiles(cfg.DataDir, migrateToDir); err != nil {
+			return fmt.Errorf("failed to copy files: %s", err.Error())
+		}
+		fmt.Printf("data directory is migrated to %s (%s). set datadir and dbtype of config to use it\n", migrateToDir, migrateToType)
+		return nil
+	},
+}
+
+func migrateStore(name string) error {
+	srcDir := filepath.Join(cfg.DataDir, name)
+	if _, err := os.Stat(srcDir); err != nil {
+		return err
+	}
+	src, err := storage.NewDB(migrateFromType, srcDir)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := storage.NewDB(migrateToType, filepath.Join(migrateToDir, name))
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	fmt.Printf("copying %s store (%s -> %s)\n", name, migrateFromType, migrateToType)
+	copied := storage.Copy(dst, src, 0, func(copied int) {
+		fmt.Printf("\r%d keys", copied)
+	})
+	fmt.Printf("\r%d keys are copied\n", copied)
+	return nil
+}
+
+// copyOtherFiles copies the files of data directory except stores, such as sql databases of contracts
+func copyOtherFiles(srcDir, dstDir string) error {
+	return copyDir(srcDir, dstDir, migrateStores...)
+}
+
+// copyDir copies the files of srcDir to dstDir, except the entries of skip
+func copyDir(srcDir, dstDir string, skip ...string) error {
+	return filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		for _, name := range skip {
+			if rel == name {
+				return filepath.SkipDir
+			}
+		}
+		target := filepath.Join(dstDir, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
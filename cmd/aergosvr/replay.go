@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	replayFrom    uint64
+	replayTo      uint64
+	replayScratch string
+)
+
+// the sql databases of contracts, which are copied to the scratch directory
+const statesqlDir = "statesql"
+
+func init() {
+	replayCmd.Flags().Uint64Var(&replayFrom, "from", 1, "first block number to replay")
+	replayCmd.Flags().Uint64Var(&replayTo, "to", 0, "last block number to replay (default is the best block)")
+	replayCmd.Flags().StringVar(&replayScratch, "scratch", "", "directory of the scratch state, which must not exist (default is a temporary directory removed after replay)")
+
+	rootCmd.AddCommand(replayCmd)
+}
+
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Re-execute blocks of data directory and verify the state",
+	Long: "Re-execute a block range of data directory into a scratch state, and compare the state roots and the " +
+		"receipts block by block. At the first divergence, the differing accounts and contract storage keys are " +
+		"dumped with the tx which wrote them. The data directory is not modified, but the server must be stopped.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		scratch := replayScratch
+		if scratch == "" {
+			tmp, err := ioutil.TempDir("", "aergo-replay")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmp)
+			scratch = tmp
+		} else if _, err := os.Stat(scratch); !os.IsNotExist(err) {
+			return fmt.Errorf("%s already exists", scratch)
+		}
+
+		src := filepath.Join(cfg.DataDir, statesqlDir)
+		if _, err := os.Stat(src); err == nil {
+			if err := copyDir(src, filepath.Join(scratch, statesqlDir)); err != nil {
+				return fmt.Errorf("failed to copy sql databases of contracts: %s", err.Error())
+			}
+		}
+
+		r, err := chain.NewReplayer(cfg.DbType, cfg.DataDir, cfg.DbPrefixed, scratch, cfg.Blockchain.ZeroFee)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		to := replayTo
+		if to == 0 || to > r.BestBlockNo() {
+			to = r.BestBlockNo()
+		}
+		fmt.Printf("replaying blocks %d-%d\n", replayFrom, to)
+		err = r.Replay(replayFrom, to, func(no types.BlockNo) {
+			fmt.Printf("\rblock %d", no)
+		})
+		fmt.Println()
+		if d, ok := err.(*chain.Divergence); ok {
+			printDivergence(d)
+			return fmt.Errorf("state diverged at block %d", d.Block.BlockNo())
+		} else if err != nil {
+			return err
+		}
+		fmt.Printf("blocks %d-%d are verified\n", replayFrom, to)
+		return nil
+	},
+}
+
+func printDivergence(d *chain.Divergence) {
+	fmt.Printf("block %d (%s) diverged\n", d.Block.BlockNo(), d.Block.ID())
+	fmt.Printf("  state root: expected %s, actual %s\n", enc.ToString(d.ExpectedRoot), enc.ToString(d.ActualRoot))
+
+	for _, r := range d.Receipts {
+		fmt.Printf("  receipt #%d\n", r.Index)
+		fmt.Printf("    expected: %v\n", r.Expected)
+		fmt.Printf("    actual:   %v\n", r.Actual)
+	}
+	for _, a := range d.Accounts {
+		name := enc.ToString(a.ID[:])
+		if addr := d.Address(a.ID); addr != nil {
+			name = types.EncodeAddress(addr)
+		}
+		fmt.Printf("  account %s (written by %s)\n", name, txName(d.AccountTx(a.ID)))
+		fmt.Printf("    expected: %v\n", a.Expected)
+		fmt.Printf("    actual:   %v\n", a.Actual)
+		for _, s := range a.Storage {
+			fmt.Printf("    storage key %s (written by %s)\n", enc.ToString(s.Key[:]), txName(d.StorageTx(a.ID, s.Key)))
+			fmt.Printf("      expected: %x\n", s.Expected)
+			fmt.Printf("      actual:   %x\n", s.Actual)
+		}
+	}
+}
+
+func txName(hash []byte) string {
+	if hash == nil {
+		return "block execution"
+	}
+	return "tx " + enc.ToString(hash)
+}
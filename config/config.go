@@ -54,6 +54,7 @@ func (ctx *ServerContext) GetDefaultBaseConfig() BaseConfig {
 	return BaseConfig{
 		DataDir:        ctx.ExpandPathEnv("$HOME/data"),
 		DbType:         "badgerdb",
+		DbPrefixed:     false,
 		EnableProfile:  false,
 		ProfilePort:    6060,
 		EnableTestmode: false,
@@ -77,14 +78,19 @@ func (ctx *ServerContext) GetDefaultP2PConfig() *P2PConfig {
 		NetProtocolPort: 7846,
 		NPBindAddr:      "",
 		NPBindPort:      -1,
+		NPAdvertiseOnly: false,
 		NPEnableTLS:     false,
 		NPCert:          "",
+		NPCertKey:       "",
+		NPCACert:        "",
 		NPKey:           "",
 		NPAddPeers:      nil,
 		NPDiscoverPeers: true,
 		NPMaxPeers:      100,
 		NPPeerPool:      100,
 		NPUsePolaris:    true,
+		NPUseDHT:        false,
+		NPCompressions:  []string{"zstd", "snappy"},
 		NPExposeSelf:    true,
 	}
 }
@@ -93,6 +99,8 @@ func (ctx *ServerContext) GetDefaultPolarisConfig() *PolarisConfig {
 	return &PolarisConfig{
 		GenesisFile:  "",
 		AllowPrivate: false,
+		PeerMapFile:  "peermap.json",
+		AllowlistRPC: "",
 	}
 }
 
@@ -104,6 +112,10 @@ func (ctx *ServerContext) GetDefaultBlockchainConfig() *BlockchainConfig {
 		VerifierCount:    types.DefaultVerifierCnt,
 		ForceResetHeight: 0,
 		ZeroFee:          true,
+		SnapshotSync:     false,
+		SnapshotBlock:    "",
+		ArchiveMode:      "none",
+		Retention:        100000,
 	}
 }
 
@@ -133,5 +145,6 @@ func (ctx *ServerContext) GetDefaultMonitorConfig() *MonitorConfig {
 func (ctx *ServerContext) GetDefaultAccountConfig() *AccountConfig {
 	return &AccountConfig{
 		UnlockTimeout: 60,
+		KDF:           "scrypt",
 	}
 }
@@ -27,7 +27,8 @@ type Config struct {
 // BaseConfig defines base configurations for aergo server
 type BaseConfig struct {
 	DataDir        string `mapstructure:"datadir" description:"Directory to store datafiles"`
-	DbType         string `mapstructure:"dbtype" description:"db implementation to store data"`
+	DbType         string `mapstructure:"dbtype" description:"db implementation to store data (badgerdb or leveldb)"`
+	DbPrefixed     bool   `mapstructure:"dbprefixed" description:"store blocks, receipts and tx index under separate key prefixes. it is applied only when the data directory is created"`
 	EnableProfile  bool   `mapstructure:"enableprofile" description:"enable profiling"`
 	ProfilePort    int    `mapstructure:"profileport" description:"profiling port (default:6060)"`
 	EnableTestmode bool   `mapstructure:"enabletestmode" description:"enable unsafe test mode"`
@@ -47,6 +48,16 @@ type RPCConfig struct {
 	NSCert      string `mapstructure:"nscert" description:"Certificate file for RPC or REST API"`
 	NSKey       string `mapstructure:"nskey" description:"Private Key file for RPC or REST API"`
 	NSAllowCORS bool   `mapstructure:"nsallowcors" description:"Allow CORS to RPC or REST API"`
+	// Authentication and access policy of RPC API
+	NSClientCA  string   `mapstructure:"nsclientca" description:"CA certificate file to verify client certificates. The common name of verified certificate is the identity of client"`
+	NSAuth      bool     `mapstructure:"nsauth" description:"Enable authentication and access policy on RPC API"`
+	NSTokens    []string `mapstructure:"nstokens" description:"API tokens in the form of identity:token"`
+	NSJWTSecret string   `mapstructure:"nsjwtsecret" description:"Secret of HS256 JWT. The subject of JWT is the identity of client"`
+	NSPolicy    []string `mapstructure:"nspolicy" description:"Method groups (public, tx, personal, admin) allowed to identity in the form of identity=group,group. anonymous is the client without credential, and * is other authenticated clients"`
+	// Rate limit of RPC API per client
+	NSRateLimit   float64  `mapstructure:"nsratelimit" description:"Request cost allowed per second to each client, identified by auth identity or ip address. 0 disables rate limit"`
+	NSRateBurst   int      `mapstructure:"nsrateburst" description:"Max request cost of burst of each client (default is same as nsratelimit)"`
+	NSMethodCosts []string `mapstructure:"nsmethodcosts" description:"Cost of methods in the form of method=cost. The cost of other methods is 1"`
 }
 
 // P2PConfig defines configurations for p2p service
@@ -56,8 +67,11 @@ type P2PConfig struct {
 	NetProtocolPort int      `mapstructure:"netprotocolport" description:"N2N listen port to which other peer can connect. This port is advertized to other peers."`
 	NPBindAddr      string   `mapstructure:"npbindaddr" description:"N2N bind address. If it was set, it only accept connection to this addresse only"`
 	NPBindPort      int      `mapstructure:"npbindport" description:"N2N bind port. It not set, bind port is same as netprotocolport. Set if server is configured with NAT and port is differ."`
+	NPAdvertiseOnly bool     `mapstructure:"npadvertiseonly" description:"Tell peers only netprotocoladdr and netprotocolport instead of the bound addresses. Set if the bound addresses must not be reachable from peers, such as nodes of local devnet"`
 	NPEnableTLS     bool     `mapstructure:"nptls" description:"Enable TLS on N2N network"`
-	NPCert          string   `mapstructure:"npcert" description:"Certificate file for N2N network"`
+	NPCert          string   `mapstructure:"npcert" description:"Certificate file for N2N network. Its common name must be the peer id of node. A temporary self-signed certificate is used if not set"`
+	NPCertKey       string   `mapstructure:"npcertkey" description:"Private key file of the certificate for N2N network"`
+	NPCACert        string   `mapstructure:"npcacert" description:"CA certificate file. If set, only the peers with certificate signed by this CA can connect"`
 	NPKey           string   `mapstructure:"npkey" description:"Private Key file for N2N network"`
 	NPAddPeers      []string `mapstructure:"npaddpeers" description'':"Add peers to connect to at startup"`
 	NPHiddenPeers   []string `mapstructure:"nphiddenpeers" description:"List of peerids which will not show to other peers"`
@@ -68,6 +82,8 @@ type P2PConfig struct {
 	NPExposeSelf   bool     `mapstructure:"npexposeself" description:"Whether to request expose self to polaris and other connected node"`
 	NPUsePolaris   bool     `mapstructure:"npusepolaris" description:"Whether to connect and get node list from polaris"`
 	NPAddPolarises []string `mapstructure:"npaddpolarises" description:"Add addresses of polarises if default polaris is not sufficient"`
+	NPUseDHT       bool     `mapstructure:"npusedht" description:"Whether to discover peers of same chain by Kademlia DHT. It works only if npdiscoverpeers is true"`
+	NPCompressions []string `mapstructure:"npcompressions" description:"Compression algorithms of message payload in order of preference (zstd, snappy). The first one supported by remote peer is used, and compression is disabled if empty"`
 
 	LogFullPeerID bool `mapstructure:"logfullpeerid" description:"Whether to use full legnth peerID or short form"`
 	// NPPrivateChain and NPMainNet are not set from configfile, it must be got from genesis block. TODO this properties should not be in config
@@ -77,16 +93,23 @@ type P2PConfig struct {
 type PolarisConfig struct {
 	AllowPrivate bool   `mapstructure:"allowprivate" description:"allow peer to have private address. for private network and test"`
 	GenesisFile  string `mapstructure:"genesisfile" description:"json file containing informations of genesisblock to which polaris refer "`
+	PeerMapFile  string `mapstructure:"peermapfile" description:"file path for storing registered peers and their health check states. relative path is based on datadir. peer map is kept only in memory if empty"`
+	AllowlistRPC string `mapstructure:"allowlistrpc" description:"rpc address of aergo node to follow the on-chain peer allowlist of permissioned network. allowlist is not enforced if empty"`
 }
 
 // BlockchainConfig defines configurations for blockchain service
 type BlockchainConfig struct {
-	MaxBlockSize     uint32 `mapstructure:"maxblocksize"  description:"maximum block size in bytes"`
-	CoinbaseAccount  string `mapstructure:"coinbaseaccount" description:"wallet address for coinbase"`
-	MaxAnchorCount   int    `mapstructure:"maxanchorcount" description:"maximun anchor count for sync"`
-	VerifierCount    int    `mapstructure:"verifiercount" description:"maximun transaction verifier count"`
-	ForceResetHeight uint64 `mapstructure:"forceresetheight" description:"best height to reset chain manually"`
-	ZeroFee          bool   `mapstructure:"zerofee" description:"enable zero-fee mode(works only on private network)"`
+	MaxBlockSize     uint32   `mapstructure:"maxblocksize"  description:"maximum block size in bytes"`
+	CoinbaseAccount  string   `mapstructure:"coinbaseaccount" description:"wallet address for coinbase"`
+	MaxAnchorCount   int      `mapstructure:"maxanchorcount" description:"maximun anchor count for sync"`
+	VerifierCount    int      `mapstructure:"verifiercount" description:"maximun transaction verifier count"`
+	ForceResetHeight uint64   `mapstructure:"forceresetheight" description:"best height to reset chain manually"`
+	ZeroFee          bool     `mapstructure:"zerofee" description:"enable zero-fee mode(works only on private network)"`
+	SnapshotSync     bool     `mapstructure:"snapshotsync" description:"download the state of a recent block instead of executing all blocks from genesis in initial sync"`
+	SnapshotBlock    string   `mapstructure:"snapshotblock" description:"hash of an irreversible block got from a trusted source such as the operators of chain. Its state is downloaded in snapshot sync, which is not used without it"`
+	ArchiveMode      string   `mapstructure:"archivemode" description:"where to move the bodies and receipts of old blocks (none, archive or prune)"`
+	Retention        uint64   `mapstructure:"retention" description:"number of blocks behind the last irreversible block whose bodies and receipts are kept in chain db"`
+	Forks            []string `mapstructure:"forks" description:"forks added to the schedule of genesis in the form of name=blockNo (private chain only). All nodes of chain must have the same schedule"`
 }
 
 // MempoolConfig defines configurations for mempool service
@@ -103,6 +126,8 @@ type ConsensusConfig struct {
 	EnableBp      bool        `mapstructure:"enablebp" description:"enable block production"`
 	BlockInterval int64       `mapstructure:"blockinterval" description:"block production interval (sec)"`
 	Raft          *RaftConfig `mapstructure:"raft"`
+	Signer        string      `mapstructure:"signer" description:"endpoint of external signer holding bp key (unix:///path/to/socket or tcp://127.0.0.1:port). node key signs blocks if empty"`
+	SignerAddress string      `mapstructure:"signeraddress" description:"address of bp key in external signer. it can be omitted if signer holds only one key"`
 }
 
 type RaftConfig struct {
@@ -128,7 +153,10 @@ type MonitorConfig struct {
 
 // Account defines configurations for account service
 type AccountConfig struct {
-	UnlockTimeout uint `mapstructure:"unlocktimeout" description:"lock automatically after timeout (sec)"`
+	UnlockTimeout  uint     `mapstructure:"unlocktimeout" description:"lock automatically after timeout (sec)"`
+	KDF            string   `mapstructure:"kdf" description:"key derivation function of keystore files (scrypt or argon2id)"`
+	Signer         string   `mapstructure:"signer" description:"endpoint of external signer for the keys not in keystore (unix:///path/to/socket or tcp://127.0.0.1:port)"`
+	SignerAccounts []string `mapstructure:"signeraccounts" description:"addresses whose txs are signed by external signer without unlock. txs of the other locked accounts are refused"`
 }
 
 /*
@@ -149,6 +177,7 @@ const tomlConfigFileTemplate = `# aergo TOML Configuration File (https://github.
 # base configurations
 datadir = "{{.BaseConfig.DataDir}}"
 dbtype = "{{.BaseConfig.DbType}}"
+dbprefixed = {{.BaseConfig.DbPrefixed}}
 enableprofile = {{.BaseConfig.EnableProfile}}
 profileport = {{.BaseConfig.ProfilePort}}
 personal = {{.BaseConfig.Personal}}
@@ -162,6 +191,20 @@ nstls = {{.RPC.NSEnableTLS}}
 nscert = "{{.RPC.NSCert}}"
 nskey = "{{.RPC.NSKey}}"
 nsallowcors = {{.RPC.NSAllowCORS}}
+nsclientca = "{{.RPC.NSClientCA}}"
+nsauth = {{.RPC.NSAuth}}
+nstokens = [{{range .RPC.NSTokens}}
+"{{.}}", {{end}}
+]
+nsjwtsecret = "{{.RPC.NSJWTSecret}}"
+nspolicy = [{{range .RPC.NSPolicy}}
+"{{.}}", {{end}}
+]
+nsratelimit = {{.RPC.NSRateLimit}}
+nsrateburst = {{.RPC.NSRateBurst}}
+nsmethodcosts = [{{range .RPC.NSMethodCosts}}
+"{{.}}", {{end}}
+]
 
 [p2p]
 # Set address and port to which the inbound peers connect, and don't set loopback address or private network unless used in local network 
@@ -169,9 +212,13 @@ netprotocoladdr = "{{.P2P.NetProtocolAddr}}"
 netprotocolport = {{.P2P.NetProtocolPort}}
 npbindaddr = "{{.P2P.NPBindAddr}}"
 npbindport = {{.P2P.NPBindPort}}
-# TLS and certificate is not applied in alpha release.
+npadvertiseonly = {{.P2P.NPAdvertiseOnly}}
+# TLS on N2N network. The common name of certificate must be the peer id of node
 nptls = {{.P2P.NPEnableTLS}}
 npcert = "{{.P2P.NPCert}}"
+npcertkey = "{{.P2P.NPCertKey}}"
+# Set CA certificate file to accept only peers with certificate signed by the CA
+npcacert = "{{.P2P.NPCACert}}"
 # Set file path of key file
 npkey = "{{.P2P.NPKey}}"
 npaddpeers = [{{range .P2P.NPAddPeers}}
@@ -185,10 +232,16 @@ npusepolaris= {{.P2P.NPUsePolaris}}
 npaddpolarises = [{{range .P2P.NPAddPolarises}}
 "{{.}}", {{end}}
 ]
+npusedht = {{.P2P.NPUseDHT}}
+npcompressions = [{{range .P2P.NPCompressions}}
+"{{.}}", {{end}}
+]
 
 [polaris]
 allowprivate = {{.Polaris.AllowPrivate}}
 genesisfile = "{{.Polaris.GenesisFile}}"
+peermapfile = "{{.Polaris.PeerMapFile}}"
+allowlistrpc = "{{.Polaris.AllowlistRPC}}"
 
 [blockchain]
 # blockchain configurations
@@ -197,6 +250,13 @@ coinbaseaccount = "{{.Blockchain.CoinbaseAccount}}"
 maxanchorcount = "{{.Blockchain.MaxAnchorCount}}"
 verifiercount = "{{.Blockchain.VerifierCount}}"
 forceresetheight = "{{.Blockchain.ForceResetHeight}}"
+snapshotsync = {{.Blockchain.SnapshotSync}}
+snapshotblock = "{{.Blockchain.SnapshotBlock}}"
+archivemode = "{{.Blockchain.ArchiveMode}}"
+retention = {{.Blockchain.Retention}}
+forks = [{{range .Blockchain.Forks}}
+"{{.}}", {{end}}
+]
 
 [mempool]
 showmetrics = {{.Mempool.ShowMetrics}}
@@ -208,6 +268,8 @@ dumpfilepath = "{{.Mempool.DumpFilePath}}"
 [consensus]
 enablebp = {{.Consensus.EnableBp}}
 blockinterval = {{.Consensus.BlockInterval}}
+signer = "{{.Consensus.Signer}}"
+signeraddress = "{{.Consensus.SignerAddress}}"
 
 [monitor]
 protocol = "{{.Monitor.ServerProtocol}}"
@@ -215,4 +277,9 @@ endpoint = "{{.Monitor.ServerEndpoint}}"
 
 [account]
 unlocktimeout = "{{.Account.UnlockTimeout}}"
+kdf = "{{.Account.KDF}}"
+signer = "{{.Account.Signer}}"
+signeraccounts = [{{range .Account.SignerAccounts}}
+"{{.}}", {{end}}
+]
 `
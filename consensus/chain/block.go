@@ -66,7 +66,7 @@ func MaxBlockBodySize() uint32 {
 
 // GenerateBlock generate & return a new block
 func GenerateBlock(hs component.ICompSyncRequester, prevBlock *types.Block, bState *state.BlockState, txOp TxOp, ts int64, skipEmpty bool) (*types.Block, error) {
-	transactions, err := GatherTXs(hs, bState, txOp, MaxBlockBodySize())
+	transactions, err := GatherTXs(hs, bState, txOp, MaxBlockBodySize(), prevBlock.BlockNo()+1)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +100,8 @@ func UnlockChain() {
 
 // GatherTXs returns transactions from txIn. The selection is done by applying
 // txDo.
-func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp TxOp, maxBlockBodySize uint32) ([]types.Transaction, error) {
+func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp TxOp, maxBlockBodySize uint32,
+	blockNo types.BlockNo) ([]types.Transaction, error) {
 	var (
 		nCollected int
 		nCand      int
@@ -167,11 +168,15 @@ func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp T
 
 	nCollected = len(txRes)
 
-	if err := chain.SendRewardCoinbase(bState, chain.CoinbaseAccount); err != nil {
+	if err := chain.SendRewardCoinbase(bState, chain.CoinbaseAccount, blockNo); err != nil {
 		return nil, err
 	}
 
-	if err := contract.SaveRecoveryPoint(bState); err != nil {
+	if err := chain.ApplyProposals(bState, blockNo); err != nil {
+		return nil, err
+	}
+
+	if err := contract.SaveRecoveryPoint(bState, blockNo); err != nil {
 		return nil, err
 	}
 
@@ -102,6 +102,13 @@ type ChainConsensus interface {
 	Info() string
 }
 
+// FinalityAccessor is implemented by the consensus which finalizes blocks later than they are connected. The blocks
+// of other consensus are final as soon as they are connected.
+type FinalityAccessor interface {
+	// LibNo returns the block number of the last irreversible block
+	LibNo() types.BlockNo
+}
+
 type TxWriter interface {
 	Set(key, value []byte)
 }
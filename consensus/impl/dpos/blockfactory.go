@@ -7,12 +7,12 @@ package dpos
 
 import (
 	"fmt"
-	"github.com/aergoio/aergo/p2p/p2pkey"
 	"runtime"
 	"time"
 
 	"github.com/aergoio/aergo-lib/log"
 	bc "github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/chain"
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/internal/enc"
@@ -20,7 +20,6 @@ import (
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/davecgh/go-spew/spew"
-	"github.com/libp2p/go-libp2p-crypto"
 )
 
 const (
@@ -52,7 +51,7 @@ type BlockFactory struct {
 	quit             <-chan interface{}
 	maxBlockBodySize uint32
 	ID               string
-	privKey          crypto.PrivKey
+	signer           types.BlockSigner
 	txOp             chain.TxOp
 	sdb              *state.ChainStateDB
 }
@@ -66,8 +65,8 @@ func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, quitC
 		bpTimeoutC:       make(chan interface{}, 1),
 		maxBlockBodySize: chain.MaxBlockBodySize(),
 		quit:             quitC,
-		ID:               p2pkey.NodeSID(),
-		privKey:          p2pkey.NodePrivKey(),
+		ID:               consensus.BPSID(),
+		signer:           consensus.BlockSigner(),
 		sdb:              sdb,
 	}
 
@@ -227,7 +226,7 @@ func (bf *BlockFactory) generateBlock(bpi *bpInfo, lpbNo types.BlockNo) (block *
 
 	block.SetConfirms(block.BlockNo() - lpbNo)
 
-	if err = block.Sign(bf.privKey); err != nil {
+	if err = block.Sign(bf.signer); err != nil {
 		return nil, nil, err
 	}
 
@@ -480,6 +480,15 @@ func (sn *Snapshots) getCurrentCluster(blockNo types.BlockNo) ([]string, error)
 	return sn.loadClusterSnapshot(blockNo)
 }
 
+// ClusterAt returns the BP list in charge of the block at blockNo. Unlike getCurrentCluster, it doesn't use the
+// cached snapshots, so that it can be called while the blocks are executed.
+func (sn *Snapshots) ClusterAt(blockNo types.BlockNo) ([]string, error) {
+	if snapBlockNo(blockNo) == 0 {
+		return genesisBpList, nil
+	}
+	return sn.loadClusterSnapshot(blockNo)
+}
+
 func (sn *Snapshots) loadClusterSnapshot(blockNo types.BlockNo) ([]string, error) {
 	var (
 		block *types.Block
@@ -8,7 +8,6 @@ package dpos
 import (
 	"encoding/json"
 	"fmt"
-	"github.com/aergoio/aergo/p2p/p2pkey"
 	"time"
 
 	"github.com/aergoio/aergo-lib/log"
@@ -16,6 +15,7 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
 	"github.com/aergoio/aergo/consensus/impl/dpos/slot"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -101,8 +101,11 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
 
 	quitC := make(chan interface{})
 
+	status := NewStatus(bpc, cdb, sdb, cfg.Blockchain.ForceResetHeight)
+	system.InitMembers(&election{status.bps})
+
 	return &DPoS{
-		Status:       NewStatus(bpc, cdb, sdb, cfg.Blockchain.ForceResetHeight),
+		Status:       status,
 		ComponentHub: hub,
 		ChainDB:      cdb,
 		bpc:          bpc,
@@ -111,6 +114,21 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
 	}, nil
 }
 
+// election tells the system contract the slots and the BPs of past blocks to check the evidences of double signing.
+type election struct {
+	bps *bp.Snapshots
+}
+
+// Slot returns the index of the slot in which the block of timestamp ts is produced.
+func (e *election) Slot(ts int64) int64 {
+	return slot.NewFromUnixNano(ts).NextIndex()
+}
+
+// BPs returns the BP list in charge of the block at blockNo.
+func (e *election) BPs(blockNo types.BlockNo) ([]string, error) {
+	return e.bps.ClusterAt(blockNo)
+}
+
 // Init initilizes the DPoS parameters.
 func Init(bpCount uint16) {
 	blockProducers = bpCount
@@ -164,7 +182,7 @@ func (dpos *DPoS) QuitChan() chan interface{} {
 }
 
 func (dpos *DPoS) bpid() peer.ID {
-	return p2pkey.NodeID()
+	return consensus.BPID()
 }
 
 // VerifyTimestamp checks the validity of the block timestamp.
@@ -251,6 +269,17 @@ func (dpos *DPoS) getBpInfo(now time.Time) *bpInfo {
 	}
 }
 
+// LibNo returns the block number of the last irreversible block.
+func (dpos *DPoS) LibNo() types.BlockNo {
+	if dpos.Status == nil {
+		return 0
+	}
+	if lib := dpos.lib(); lib != nil {
+		return lib.BlockNo
+	}
+	return 0
+}
+
 // ConsensusInfo returns the basic DPoS-related info.
 func (dpos *DPoS) ConsensusInfo() *types.ConsensusInfo {
 	ci := &types.ConsensusInfo{Type: GetName()}
@@ -3,7 +3,6 @@ package dpos
 import (
 	"container/list"
 	"fmt"
-	"github.com/aergoio/aergo/p2p/p2pkey"
 	"sort"
 
 	"github.com/aergoio/aergo-lib/db"
@@ -53,7 +52,7 @@ func newLibStatus(confirmsRequired uint16) *libStatus {
 		Prpsd:            make(proposed),
 		Lib:              &blockInfo{},
 		confirms:         list.New(),
-		bpid:             p2pkey.NodeSID(),
+		bpid:             consensus.BPSID(),
 		confirmsRequired: confirmsRequired,
 	}
 }
@@ -174,3 +174,19 @@ func TestLibGC(t *testing.T) {
 	ls.gc()
 	a.True(cInfo(ls.confirms.Front()).blockInfo.BlockNo > libNo)
 }
+
+func TestConfirmsRequiredUpdate(t *testing.T) {
+	const clusterSize = 3
+
+	a := assert.New(t)
+
+	tc, err := newTestChain(clusterSize)
+	a.Nil(err)
+	a.Nil(tc.addBlock(1))
+	a.Equal(consensusBlockCount(clusterSize), tc.status.libState.confirmsRequired)
+
+	// The BP count is changed by a proposal.
+	tc.status.bpc.(*testCluster).size = 7
+	a.Nil(tc.addBlock(2))
+	a.Equal(consensusBlockCount(7), tc.status.libState.confirmsRequired)
+}
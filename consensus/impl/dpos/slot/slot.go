@@ -45,6 +45,11 @@ func (s *Slot) UnixNano() int64 {
 	return s.timeNs
 }
 
+// NextIndex returns the slot index of s, which identifies the slot of a block produced at s.
+func (s *Slot) NextIndex() int64 {
+	return s.nextIndex
+}
+
 // Time returns a Slot corresponting to the given time.
 func Time(t time.Time) *Slot {
 	return fromUnixNs(t.UnixNano())
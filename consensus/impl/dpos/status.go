@@ -19,13 +19,18 @@ type Status struct {
 	bestBlock *types.Block
 	libState  *libStatus
 	bps       *bp.Snapshots
+	bpc       bp.ClusterMember
 }
 
 // NewStatus returns a newly allocated Status.
 func NewStatus(c bp.ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateDB, resetHeight types.BlockNo) *Status {
+	// The snapshots update c by the BP list of the best block, whose size may
+	// differ from the genesis one by a proposal.
+	bps := bp.NewSnapshots(c, cdb, sdb)
 	s := &Status{
 		libState: newLibStatus(consensusBlockCount(c.Size())),
-		bps:      bp.NewSnapshots(c, cdb, sdb),
+		bps:      bps,
+		bpc:      c,
 	}
 	s.init(cdb, resetHeight)
 
@@ -79,6 +84,7 @@ func (s *Status) Update(block *types.Block) {
 		}
 
 		s.bps.AddSnapshot(block.BlockNo())
+		s.updateConfirmsRequired()
 	} else {
 		// Rollback resulting from a reorganization.
 		logger.Debug().
@@ -94,6 +100,7 @@ func (s *Status) Update(block *types.Block) {
 
 		// Rollback BP list. -- BP list is alos affected by a fork.
 		s.bps.UpdateCluster(block.BlockNo())
+		s.updateConfirmsRequired()
 	}
 
 	s.libState.gc()
@@ -101,6 +108,18 @@ func (s *Status) Update(block *types.Block) {
 	s.bestBlock = block
 }
 
+// updateConfirmsRequired recomputes the number of confirmations required for
+// LIB when the BP count is changed by a proposal.
+func (s *Status) updateConfirmsRequired() {
+	n := consensusBlockCount(s.bpc.Size())
+	if n == s.libState.confirmsRequired {
+		return
+	}
+	logger.Info().Uint16("old", s.libState.confirmsRequired).Uint16("new", n).
+		Msg("confirmations required for LIB changed")
+	s.libState.confirmsRequired = n
+}
+
 func (s *Status) libNo() types.BlockNo {
 	s.RLock()
 	defer s.RUnlock()
@@ -13,6 +13,7 @@ import (
 	"github.com/aergoio/aergo/consensus/impl/raftv2"
 	"github.com/aergoio/aergo/consensus/impl/sbp"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/rpc"
 )
@@ -34,6 +35,12 @@ func New(cfg *config.Config, hub *component.ComponentHub, cs *chain.ChainService
 
 	consensus.InitBlockInterval(blockInterval)
 
+	if cfg.Consensus.EnableBp {
+		if err = consensus.InitBlockSigner(cfg.Consensus, p2pkey.NodePrivKey()); err != nil {
+			return nil, err
+		}
+	}
+
 	if c, err = newConsensus(cfg, hub, cs, pa); err == nil {
 		// Link mutual references.
 		cs.SetChainConsensus(c)
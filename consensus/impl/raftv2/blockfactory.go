@@ -5,13 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
-	"github.com/aergoio/aergo/p2p/p2pkey"
 	"runtime"
 	"sync"
 	"time"
 
 	"github.com/aergoio/aergo/internal/enc"
-	"github.com/libp2p/go-libp2p-crypto"
 
 	"github.com/aergoio/aergo-lib/log"
 	bc "github.com/aergoio/aergo/chain"
@@ -71,7 +69,7 @@ type BlockFactory struct {
 	blockInterval    time.Duration
 	maxBlockBodySize uint32
 	ID               string
-	privKey          crypto.PrivKey
+	signer           types.BlockSigner
 	txOp             chain.TxOp
 	sdb              *state.ChainStateDB
 	prevBlock        *types.Block // best block of last job
@@ -105,8 +103,8 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainWAL
 		blockInterval:    time.Second * time.Duration(cfg.Consensus.BlockInterval),
 		maxBlockBodySize: chain.MaxBlockBodySize(),
 		quit:             make(chan interface{}),
-		ID:               p2pkey.NodeSID(),
-		privKey:          p2pkey.NodePrivKey(),
+		ID:               consensus.BPSID(),
+		signer:           consensus.BlockSigner(),
 		sdb:              sdb,
 	}
 
@@ -343,7 +341,7 @@ func (bf *BlockFactory) build(prevBlock *types.Block) error {
 		return err
 	}
 
-	if err = block.Sign(bf.privKey); err != nil {
+	if err = block.Sign(bf.signer); err != nil {
 		logger.Error().Err(err).Msg("failed to sign in block")
 		return nil
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/types"
@@ -132,6 +133,10 @@ func (cl *Cluster) Recover(snapshot *raftpb.Snapshot) error {
 	}
 
 	logger.Info().Str("snap", snapdata.ToString()).Msg("cluster recover from snapshot")
+
+	cl.Lock()
+	defer cl.Unlock()
+
 	cl.members.reset()
 
 	cl.setEffectiveMembers(cl.members)
@@ -174,6 +179,20 @@ func (cl *Cluster) Quorum() uint16 {
 	return cl.Size/2 + 1
 }
 
+// BPs returns the encoded peer ids of the raft members. The members are changed by the conf change entries of raft
+// log, so they don't depend on blockNo.
+func (cl *Cluster) BPs(blockNo types.BlockNo) ([]string, error) {
+	cl.Lock()
+	defer cl.Unlock()
+
+	bps := make([]string, 0, len(cl.getEffectiveMembers().MapByID))
+	for _, member := range cl.getEffectiveMembers().MapByID {
+		bps = append(bps, enc.ToString([]byte(member.PeerID)))
+	}
+
+	return bps, nil
+}
+
 // getAnyPeerAddressToSync returns peer address that has block of no for sync
 func (cl *Cluster) getAnyPeerAddressToSync() (peer.ID, error) {
 	for _, member := range cl.getEffectiveMembers().MapByID {
@@ -186,6 +205,9 @@ func (cl *Cluster) getAnyPeerAddressToSync() (peer.ID, error) {
 }
 
 func (cl *Cluster) addMember(member *consensus.Member, fromConfig bool) error {
+	cl.Lock()
+	defer cl.Unlock()
+
 	mbrs := cl.members
 
 	logger.Debug().Bool("fromconfig", fromConfig).Str("member", member.ToString()).Msg("add member to members")
@@ -214,6 +236,9 @@ func (cl *Cluster) addMember(member *consensus.Member, fromConfig bool) error {
 }
 
 func (cl *Cluster) removeMember(member *consensus.Member) error {
+	cl.Lock()
+	defer cl.Unlock()
+
 	mbrs := cl.members
 
 	mbrs.remove(member)
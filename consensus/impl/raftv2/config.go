@@ -11,6 +11,7 @@ import (
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/libp2p/go-libp2p-peer"
 )
 
@@ -48,6 +49,7 @@ func (bf *BlockFactory) InitCluster(cfg *config.Config) error {
 	}
 
 	bf.bpc = NewCluster(chainID, bf, raftConfig.Name, uint16(lenBPs), chain.Genesis.Timestamp)
+	system.InitMembers(bf.bpc)
 
 	if useTls, err = validateTLS(raftConfig); err != nil {
 		logger.Error().Err(err).
@@ -0,0 +1,85 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package consensus
+
+import (
+	"github.com/aergoio/aergo/account/extsigner"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+var (
+	bpSigner types.BlockSigner
+	bpID     peer.ID
+)
+
+// InitBlockSigner sets the signer of the blocks produced by this node. The node key signs blocks unless
+// external signer is configured. In that case the bp is identified by the key held by the signer, not by
+// the node key.
+func InitBlockSigner(cfg *config.ConsensusConfig, nodeKey crypto.PrivKey) error {
+	if cfg.Signer == "" {
+		return setBlockSigner(nodeKey)
+	}
+
+	client, err := extsigner.NewClient(cfg.Signer)
+	if err != nil {
+		return err
+	}
+	var address []byte
+	if cfg.SignerAddress != "" {
+		if address, err = types.DecodeAddress(cfg.SignerAddress); err != nil {
+			return err
+		}
+	}
+	signer, err := extsigner.NewBlockSigner(client, address)
+	if err != nil {
+		client.Close()
+		return err
+	}
+	if err = setBlockSigner(signer); err != nil {
+		client.Close()
+		return err
+	}
+	logger.Info().Str("signer", cfg.Signer).Str("bpid", BPSID()).Msg("blocks are signed by external signer")
+	return nil
+}
+
+func setBlockSigner(signer types.BlockSigner) error {
+	id, err := peer.IDFromPublicKey(signer.GetPublic())
+	if err != nil {
+		return err
+	}
+	bpSigner, bpID = signer, id
+	return nil
+}
+
+// BlockSigner returns the signer of the blocks produced by this node.
+func BlockSigner() types.BlockSigner {
+	if bpSigner == nil {
+		return p2pkey.NodePrivKey()
+	}
+	return bpSigner
+}
+
+// BPID returns the block producer id of this node, which is derived from the key of block signer.
+func BPID() peer.ID {
+	if bpSigner == nil {
+		return p2pkey.NodeID()
+	}
+	return bpID
+}
+
+// BPSID returns the string representation of BPID.
+func BPSID() string {
+	if bpSigner == nil {
+		return p2pkey.NodeSID()
+	}
+	return enc.ToString([]byte(bpID))
+}
@@ -369,7 +369,10 @@ static int governance(lua_State *L, char type) {
 		luaL_error(L, "cannot find execution context");
 	}
 
-    if (type != 'V') {
+    if (type == 'W') {
+        arg = (char *)"0";
+    }
+    else if (type != 'V') {
     	if (lua_isnil(L, 1))
 	    return 0;
 
@@ -419,6 +422,10 @@ static int moduleUnstake(lua_State *L) {
     return governance(L, 'U');
 }
 
+static int moduleWithdraw(lua_State *L) {
+    return governance(L, 'W');
+}
+
 static int moduleVote(lua_State *L) {
     return governance(L, 'V');
 }
@@ -461,6 +468,7 @@ static const luaL_Reg contract_lib[] = {
 	{"event", moduleEvent},
 	{"stake", moduleStake},
 	{"unstake", moduleUnstake},
+	{"withdraw", moduleWithdraw},
 	{"vote", moduleVote},
 	{NULL, NULL}
 };
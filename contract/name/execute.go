@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/state"
@@ -16,7 +17,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 
 	systemContractState, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
 
-	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState)
+	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState, blockNo)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +40,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 	switch ci.Name {
 	case types.NameCreate:
 		if err = CreateName(scs, txBody, sender, nameState,
-			ci.Args[0].(string)); err != nil {
+			ci.Args[0].(string), blockNo); err != nil {
 			return nil, err
 		}
 		events = append(events, &types.Event{
@@ -50,7 +51,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 		})
 	case types.NameUpdate:
 		if err = UpdateName(bs, scs, txBody, sender, nameState,
-			ci.Args[0].(string), ci.Args[1].(string)); err != nil {
+			ci.Args[0].(string), ci.Args[1].(string), blockNo); err != nil {
 			return nil, err
 		}
 		events = append(events, &types.Event{
@@ -60,8 +61,30 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 			JsonArgs: `{"name":"` + ci.Args[0].(string) +
 				`","to":"` + ci.Args[1].(string) + `"}`,
 		})
+	case types.NameRenew:
+		expireBlock, err := RenewName(scs, txBody, sender, nameState, ci.Args[0].(string), blockNo)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "renew name",
+			JsonArgs: `{"name":"` + ci.Args[0].(string) +
+				`","expire":` + strconv.FormatUint(expireBlock, 10) + `}`,
+		})
+	case types.NameRevoke:
+		if err = RevokeName(scs, ci.Args[0].(string)); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "revoke name",
+			JsonArgs:        `{"name":"` + ci.Args[0].(string) + `"}`,
+		})
 	case types.SetContractOwner:
-		ownerState, err := SetContractOwner(bs, scs, ci.Args[0].(string), nameState)
+		ownerState, err := SetContractOwner(bs, scs, ci.Args[0].(string), nameState, blockNo)
 		if err != nil {
 			return nil, err
 		}
@@ -72,6 +95,89 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 }
 
 func ValidateNameTx(tx *types.TxBody, sender *state.V,
+	scs, systemcs *state.ContractState, blockNo types.BlockNo) (*types.CallInfo, error) {
+	if !types.IsForkActive(types.ForkNameExpiry, blockNo) {
+		return validateNameTxV1(tx, sender, scs, systemcs)
+	}
+	if sender != nil && sender.Balance().Cmp(tx.GetAmountBigInt()) < 0 {
+		return nil, types.ErrInsufficientBalance
+	}
+	var ci types.CallInfo
+	if err := json.Unmarshal(tx.Payload, &ci); err != nil {
+		return nil, err
+	}
+	name := ci.Args[0].(string)
+	switch ci.Name {
+	case types.NameCreate:
+		if isSubName([]byte(name)) {
+			if err := validateParentOwner(scs, tx.Account, []byte(name), blockNo); err != nil {
+				return nil, err
+			}
+		} else if namePrice := system.GetNamePrice(systemcs); namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
+			return nil, types.ErrTooSmallAmount
+		}
+		nameMap := getNameMap(scs, []byte(name), false)
+		if nameMap != nil && !nameMap.Expired(blockNo) {
+			return nil, fmt.Errorf("aleady occupied %s", string(name))
+		}
+	case types.NameUpdate:
+		if isSubName([]byte(name)) {
+			// the owner of parent can transfer subname
+			if !bytes.Equal(tx.Account, getOwner(scs, []byte(name), false)) &&
+				validateParentOwner(scs, tx.Account, []byte(name), blockNo) != nil {
+				return nil, fmt.Errorf("owner not matched : %s", name)
+			}
+		} else {
+			namePrice := system.GetNamePrice(systemcs)
+			if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
+				return nil, types.ErrTooSmallAmount
+			}
+			if (!bytes.Equal(tx.Account, []byte(name))) &&
+				(!bytes.Equal(tx.Account, getOwner(scs, []byte(name), false))) {
+				return nil, fmt.Errorf("owner not matched : %s", name)
+			}
+		}
+		if nameMap := getNameMap(scs, topName([]byte(name)), false); nameMap != nil && nameMap.Expired(blockNo) {
+			return nil, fmt.Errorf("expired name %s, renew it first", name)
+		}
+	case types.NameRevoke:
+		if err := validateParentOwner(scs, tx.Account, []byte(name), blockNo); err != nil {
+			return nil, err
+		}
+		if getNameMap(scs, []byte(name), false) == nil {
+			return nil, fmt.Errorf("%s is not created yet", name)
+		}
+	case types.NameRenew:
+		if isSubName([]byte(name)) {
+			return nil, fmt.Errorf("subname %s follows the expiry of its top level name", name)
+		}
+		namePrice := system.GetNamePrice(systemcs)
+		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
+			return nil, types.ErrTooSmallAmount
+		}
+		nameMap := getNameMap(scs, []byte(name), false)
+		if nameMap == nil {
+			return nil, fmt.Errorf("%s is not created yet", name)
+		}
+		if !bytes.Equal(tx.Account, nameMap.Owner) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+		if nameMap.ExpireBlock == 0 {
+			return nil, fmt.Errorf("%s never expires", name)
+		}
+	case types.SetContractOwner:
+		owner := getOwner(scs, []byte(types.AergoName), false)
+		if owner != nil {
+			return nil, fmt.Errorf("owner aleady set to %s", types.EncodeAddress(owner))
+		}
+	default:
+		return nil, errors.New("could not execute unknown cmd")
+	}
+	return &ci, nil
+}
+
+// validateNameTxV1 validates name tx by the rules before the fork of name expiry, where names never expire.
+func validateNameTxV1(tx *types.TxBody, sender *state.V,
 	scs, systemcs *state.ContractState) (*types.CallInfo, error) {
 	if sender != nil && sender.Balance().Cmp(tx.GetAmountBigInt()) < 0 {
 		return nil, types.ErrInsufficientBalance
@@ -111,8 +217,23 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 	return &ci, nil
 }
 
+// validateParentOwner checks that account owns the parent of subname and the top level name is not expired.
+func validateParentOwner(scs *state.ContractState, account, name []byte, blockNo types.BlockNo) error {
+	parentMap := getNameMap(scs, parentName(name), false)
+	if parentMap == nil {
+		return fmt.Errorf("parent of %s is not created yet", string(name))
+	}
+	if !bytes.Equal(account, parentMap.Owner) {
+		return fmt.Errorf("owner of parent not matched : %s", string(name))
+	}
+	if topMap := getNameMap(scs, topName(name), false); topMap != nil && topMap.Expired(blockNo) {
+		return fmt.Errorf("expired name %s", string(topName(name)))
+	}
+	return nil
+}
+
 func SetContractOwner(bs *state.BlockState, scs *state.ContractState,
-	address string, nameState *state.V) (*state.V, error) {
+	address string, nameState *state.V, blockNo types.BlockNo) (*state.V, error) {
 	name := []byte(types.AergoName)
 	rawaddr, err := types.DecodeAddress(address)
 	if err != nil {
@@ -124,7 +245,7 @@ func SetContractOwner(bs *state.BlockState, scs *state.ContractState,
 	}
 	ownerState.AddBalance(nameState.Balance())
 	nameState.SubBalance(nameState.Balance())
-	if err = registerOwner(scs, name, rawaddr, name); err != nil {
+	if err = registerOwner(scs, name, rawaddr, name, blockNo); err != nil {
 		return nil, err
 	}
 	return ownerState, nil
@@ -12,33 +12,120 @@ import (
 
 var prefix = []byte("name")
 
+// reversePrefix is the prefix of the key for the primary name of an address
+var reversePrefix = []byte("rname")
+
+// serialKey is the key of the last serial number given to a created name
+var serialKey = []byte("serial")
+
+const nameMapVersion = 3
+
+// NameMap is the record of a name. ExpireBlock is 0 for names registered by version 1 and for subnames, and they
+// never expire by themselves. A subname is valid only while ParentSerial equals the Serial of its parent, so the
+// subnames are dropped when the parent is revoked or claimed again after expiry.
 type NameMap struct {
-	Version     byte
-	Owner       []byte
-	Destination []byte
+	Version      byte
+	Owner        []byte
+	Destination  []byte
+	ExpireBlock  types.BlockNo
+	Serial       uint64
+	ParentSerial uint64
+}
+
+// Expired returns whether the name is expired at blockNo. An expired name can be claimed by anyone, and the
+// owner can still renew it until then.
+func (n *NameMap) Expired(blockNo types.BlockNo) bool {
+	return n.ExpireBlock != 0 && n.ExpireBlock < blockNo
+}
+
+// recordVersion returns the version of name record written at blockNo. The names are written in version 1 without
+// expiry and reverse record until the fork of name expiry, and in version 2 without serial until the fork of subname.
+func recordVersion(blockNo types.BlockNo) byte {
+	if !types.IsForkActive(types.ForkNameExpiry, blockNo) {
+		return 1
+	}
+	if !types.IsForkActive(types.ForkSubName, blockNo) {
+		return 2
+	}
+	return nameMapVersion
 }
 
-func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string) error {
+func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string,
+	blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
-	return createName(scs, []byte(name), sender.ID())
+	return createName(scs, []byte(name), sender.ID(), blockNo)
 }
 
-func createName(scs *state.ContractState, name []byte, owner []byte) error {
+func createName(scs *state.ContractState, name []byte, owner []byte, blockNo types.BlockNo) error {
 	//	return setAddress(scs, name, owner)
-	return registerOwner(scs, name, owner, owner)
+	version := recordVersion(blockNo)
+	if version == 1 {
+		return registerOwner(scs, name, owner, owner, blockNo)
+	}
+	expireBlock := blockNo + types.NameValidPeriod
+	if isSubName(name) {
+		expireBlock = 0
+	}
+	// the previous record remains only when the name is expired
+	if prev := getNameMap(scs, name, false); prev != nil {
+		if err := removeReverse(scs, name, prev.Destination); err != nil {
+			return err
+		}
+	}
+	var serial, parentSerial uint64
+	if version >= 3 {
+		if parent := parentName(name); parent != nil {
+			parentMap := getNameMap(scs, parent, false)
+			if parentMap == nil {
+				return fmt.Errorf("parent of %s is not created yet", string(name))
+			}
+			parentSerial = parentMap.Serial
+		}
+		var err error
+		if serial, err = nextSerial(scs); err != nil {
+			return err
+		}
+	}
+	nameMap := &NameMap{
+		Version:      version,
+		Owner:        owner,
+		Destination:  owner,
+		ExpireBlock:  expireBlock,
+		Serial:       serial,
+		ParentSerial: parentSerial,
+	}
+	if err := setNameMap(scs, name, nameMap); err != nil {
+		return err
+	}
+	return setReverse(scs, owner, name)
+}
+
+func nextSerial(scs *state.ContractState) (uint64, error) {
+	data, err := scs.GetData(serialKey)
+	if err != nil {
+		return 0, err
+	}
+	var serial uint64
+	if len(data) == 8 {
+		serial = binary.LittleEndian.Uint64(data)
+	}
+	serial++
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, serial)
+	return serial, scs.SetData(serialKey, buf)
 }
 
 //UpdateName is avaliable after bid implement
 func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody,
-	sender, receiver *state.V, name, to string) error {
+	sender, receiver *state.V, name, to string, blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
-	if len(getAddress(scs, []byte(name))) <= types.NameLength {
+	if len(getAddress(scs, []byte(name), blockNo)) <= types.NameLength {
 		return fmt.Errorf("%s is not created yet", string(name))
 	}
 	destination, _ := types.DecodeAddress(to)
-	destination = GetAddress(scs, destination)
+	destination = GetAddress(scs, destination, blockNo)
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
 	contract, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID(destination))
@@ -56,16 +143,62 @@ func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody
 			return types.ErrTxInvalidRecipient
 		}
 	}
-	return updateName(scs, []byte(name), ownerAddr, destination)
+	return updateName(scs, []byte(name), ownerAddr, destination, blockNo)
 }
 
-func updateName(scs *state.ContractState, name []byte, owner []byte, to []byte) error {
+func updateName(scs *state.ContractState, name []byte, owner []byte, to []byte, blockNo types.BlockNo) error {
 	//return setAddress(scs, name, to)
-	return registerOwner(scs, name, owner, to)
+	version := recordVersion(blockNo)
+	if version == 1 {
+		return registerOwner(scs, name, owner, to, blockNo)
+	}
+	nameMap := getNameMap(scs, name, false)
+	if nameMap == nil {
+		nameMap = &NameMap{}
+	} else if err := removeReverse(scs, name, nameMap.Destination); err != nil {
+		return err
+	}
+	nameMap.Version = version
+	nameMap.Owner = owner
+	nameMap.Destination = to
+	if err := setNameMap(scs, name, nameMap); err != nil {
+		return err
+	}
+	return setReverse(scs, to, name)
 }
 
-//Resolve is resolve name for chain
-func Resolve(bs *state.BlockState, name []byte) []byte {
+// RevokeName removes the subname. The subnames under it are not resolved any more.
+func RevokeName(scs *state.ContractState, name string) error {
+	nameMap := getNameMap(scs, []byte(name), false)
+	if nameMap == nil {
+		return fmt.Errorf("%s is not created yet", name)
+	}
+	if err := removeReverse(scs, []byte(name), nameMap.Destination); err != nil {
+		return err
+	}
+	return scs.DeleteData(nameKey([]byte(name)))
+}
+
+// RenewName extends the expiry of name by NameValidPeriod. The period of an expired name starts from blockNo.
+func RenewName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string,
+	blockNo types.BlockNo) (types.BlockNo, error) {
+	nameMap := getNameMap(scs, []byte(name), false)
+	if nameMap == nil {
+		return 0, fmt.Errorf("%s is not created yet", name)
+	}
+	amount := tx.GetAmountBigInt()
+	sender.SubBalance(amount)
+	receiver.AddBalance(amount)
+	if nameMap.ExpireBlock < blockNo {
+		nameMap.ExpireBlock = blockNo
+	}
+	nameMap.ExpireBlock += types.NameValidPeriod
+	nameMap.Version = recordVersion(blockNo)
+	return nameMap.ExpireBlock, setNameMap(scs, []byte(name), nameMap)
+}
+
+//Resolve is resolve name for chain at blockNo. A subname is resolved through the hierarchy of its parents
+func Resolve(bs *state.BlockState, name []byte, blockNo types.BlockNo) []byte {
 	if len(name) == types.AddressLength ||
 		bytes.Equal(name, []byte(types.AergoSystem)) ||
 		bytes.Equal(name, []byte(types.AergoName)) {
@@ -75,7 +208,7 @@ func Resolve(bs *state.BlockState, name []byte) []byte {
 	if err != nil {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
 func openContract(bs *state.BlockState) (*state.ContractState, error) {
@@ -90,22 +223,28 @@ func openContract(bs *state.BlockState) (*state.ContractState, error) {
 	return scs, nil
 }
 
-//GetAddress is resolve name for mempool
-func GetAddress(scs *state.ContractState, name []byte) []byte {
+//GetAddress is resolve name for mempool at blockNo
+func GetAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	if len(name) == types.AddressLength ||
 		bytes.Equal(name, []byte(types.AergoSystem)) ||
 		bytes.Equal(name, []byte(types.AergoName)) {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
-func getAddress(scs *state.ContractState, name []byte) []byte {
+// getAddress returns the destination of name, or nil if the name or its top level name is expired at blockNo
+func getAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	nameMap := getNameMap(scs, name, true)
-	if nameMap != nil {
-		return nameMap.Destination
+	if nameMap == nil || nameMap.Expired(blockNo) {
+		return nil
 	}
-	return nil
+	if isSubName(name) {
+		if topMap := getNameMap(scs, topName(name), true); topMap == nil || topMap.Expired(blockNo) {
+			return nil
+		}
+	}
+	return nameMap.Destination
 }
 
 func GetOwner(scs *state.ContractState, name []byte) []byte {
@@ -120,9 +259,55 @@ func getOwner(scs *state.ContractState, name []byte, useInitial bool) []byte {
 	return nil
 }
 
+// GetNameMap returns the record of name
+func GetNameMap(scs *state.ContractState, name []byte) *NameMap {
+	return getNameMap(scs, name, true)
+}
+
+// GetNameByAddress returns the primary name of address at blockNo, which is the latest name pointed to the address.
+// It returns nil if the name is pointed to other address since then, or expired.
+func GetNameByAddress(scs *state.ContractState, address []byte, blockNo types.BlockNo) []byte {
+	name, err := scs.GetInitialData(reverseKey(address))
+	if err != nil || len(name) == 0 {
+		return nil
+	}
+	if !bytes.Equal(getAddress(scs, name, blockNo), address) {
+		return nil
+	}
+	return name
+}
+
+func setReverse(scs *state.ContractState, address, name []byte) error {
+	if len(address) != types.AddressLength {
+		return nil
+	}
+	return scs.SetData(reverseKey(address), []byte(strings.ToLower(string(name))))
+}
+
+// removeReverse removes the primary name of address only if it is name.
+func removeReverse(scs *state.ContractState, name, address []byte) error {
+	if len(address) != types.AddressLength {
+		return nil
+	}
+	key := reverseKey(address)
+	current, err := scs.GetData(key)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(string(current), string(name)) {
+		return nil
+	}
+	return scs.DeleteData(key)
+}
+
+func reverseKey(address []byte) []byte {
+	key := make([]byte, 0, len(reversePrefix)+len(address))
+	key = append(key, reversePrefix...)
+	return append(key, address...)
+}
+
 func getNameMap(scs *state.ContractState, name []byte, useInitial bool) *NameMap {
-	lowerCaseName := strings.ToLower(string(name))
-	key := append(prefix, lowerCaseName...)
+	key := nameKey(name)
 	var err error
 	var ownerdata []byte
 	if useInitial {
@@ -133,18 +318,53 @@ func getNameMap(scs *state.ContractState, name []byte, useInitial bool) *NameMap
 	if err != nil {
 		return nil
 	}
-	return deserializeNameMap(ownerdata)
+	nameMap := deserializeNameMap(ownerdata)
+	if nameMap != nil && isSubName(name) {
+		parentMap := getNameMap(scs, parentName(name), useInitial)
+		if parentMap == nil || parentMap.Serial != nameMap.ParentSerial {
+			return nil
+		}
+	}
+	return nameMap
+}
+
+func nameKey(name []byte) []byte {
+	lowerCaseName := strings.ToLower(string(name))
+	key := make([]byte, 0, len(prefix)+len(lowerCaseName))
+	key = append(key, prefix...)
+	return append(key, lowerCaseName...)
+}
+
+func isSubName(name []byte) bool {
+	return bytes.IndexByte(name, '.') >= 0 &&
+		!bytes.Equal(name, []byte(types.AergoSystem)) &&
+		!bytes.Equal(name, []byte(types.AergoName))
+}
+
+// parentName returns the parent of subname, or nil for a top level name.
+func parentName(name []byte) []byte {
+	if !isSubName(name) {
+		return nil
+	}
+	return name[bytes.IndexByte(name, '.')+1:]
 }
 
-func registerOwner(scs *state.ContractState, name, owner, destination []byte) error {
-	nameMap := &NameMap{Version: 1, Owner: owner, Destination: destination}
+// topName returns the top level name in the hierarchy of name.
+func topName(name []byte) []byte {
+	if !isSubName(name) {
+		return name
+	}
+	return name[bytes.LastIndexByte(name, '.')+1:]
+}
+
+// registerOwner writes the record of name which never expires
+func registerOwner(scs *state.ContractState, name, owner, destination []byte, blockNo types.BlockNo) error {
+	nameMap := &NameMap{Version: recordVersion(blockNo), Owner: owner, Destination: destination}
 	return setNameMap(scs, name, nameMap)
 }
 
 func setNameMap(scs *state.ContractState, name []byte, n *NameMap) error {
-	lowerCaseName := strings.ToLower(string(name))
-	key := append(prefix, lowerCaseName...)
-	return scs.SetData(key, serializeNameMap(n))
+	return scs.SetData(nameKey(name), serializeNameMap(n))
 }
 
 func serializeNameMap(n *NameMap) []byte {
@@ -158,14 +378,24 @@ func serializeNameMap(n *NameMap) []byte {
 		binary.LittleEndian.PutUint64(buf, uint64(len(n.Destination)))
 		ret = append(ret, buf...)
 		ret = append(ret, n.Destination...)
+		if n.Version >= 2 {
+			binary.LittleEndian.PutUint64(buf, n.ExpireBlock)
+			ret = append(ret, buf...)
+		}
+		if n.Version >= 3 {
+			binary.LittleEndian.PutUint64(buf, n.Serial)
+			ret = append(ret, buf...)
+			binary.LittleEndian.PutUint64(buf, n.ParentSerial)
+			ret = append(ret, buf...)
+		}
 	}
 	return ret
 }
 
 func deserializeNameMap(data []byte) *NameMap {
-	if data != nil {
+	if len(data) != 0 {
 		version := data[0]
-		if version != 1 {
+		if version < 1 || version > nameMapVersion {
 			panic("could not deserializeOwner, not supported version")
 		}
 		offset := 1
@@ -183,10 +413,29 @@ func deserializeNameMap(data []byte) *NameMap {
 		offset = next
 		next = offset + int(sizeOfDest)
 		destination := data[offset:next]
+
+		var expireBlock types.BlockNo
+		if version >= 2 {
+			offset = next
+			next = offset + 8
+			expireBlock = binary.LittleEndian.Uint64(data[offset:next])
+		}
+		var serial, parentSerial uint64
+		if version >= 3 {
+			offset = next
+			next = offset + 8
+			serial = binary.LittleEndian.Uint64(data[offset:next])
+			offset = next
+			next = offset + 8
+			parentSerial = binary.LittleEndian.Uint64(data[offset:next])
+		}
 		return &NameMap{
-			Version:     version,
-			Owner:       owner,
-			Destination: destination,
+			Version:      version,
+			Owner:        owner,
+			Destination:  destination,
+			ExpireBlock:  expireBlock,
+			Serial:       serial,
+			ParentSerial: parentSerial,
 		}
 	}
 	return nil
@@ -47,23 +47,23 @@ func TestName(t *testing.T) {
 	scs := openContractState(t, bs)
 	systemcs := openSystemContractState(t, bs)
 
-	err := CreateName(scs, tx, sender, receiver, name)
+	err := CreateName(scs, tx, sender, receiver, name, 0)
 	assert.NoError(t, err, "create name")
 
 	scs = nextBlockContractState(t, bs, scs)
-	_, err = ValidateNameTx(tx, sender, scs, systemcs)
+	_, err = ValidateNameTx(tx, sender, scs, systemcs, 0)
 	assert.Error(t, err, "same name")
 
-	ret := getAddress(scs, []byte(name))
+	ret := getAddress(scs, []byte(name), 0)
 	assert.Equal(t, owner, ret, "registed owner")
 
 	tx.Payload = buildNamePayload(name, types.NameUpdate, buyer)
-	err = UpdateName(bs, scs, tx, sender, receiver, name, buyer)
+	err = UpdateName(bs, scs, tx, sender, receiver, name, buyer, 0)
 	assert.NoError(t, err, "update name")
 
 	scs = nextBlockContractState(t, bs, scs)
 
-	ret = getAddress(scs, []byte(name))
+	ret = getAddress(scs, []byte(name), 0)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "registed owner")
 }
 
@@ -81,7 +81,7 @@ func TestNameRecursive(t *testing.T) {
 	receiver, _ := sdb.GetStateDB().GetAccountStateV(tx.Recipient)
 	bs := sdb.NewBlockState(sdb.GetRoot())
 	scs := openContractState(t, bs)
-	err := CreateName(scs, tx, sender, receiver, name1)
+	err := CreateName(scs, tx, sender, receiver, name1, 0)
 	assert.NoError(t, err, "create name")
 
 	tx.Account = []byte(name1)
@@ -89,11 +89,11 @@ func TestNameRecursive(t *testing.T) {
 	tx.Payload = buildNamePayload(name2, types.NameCreate, "")
 
 	scs = nextBlockContractState(t, bs, scs)
-	err = CreateName(scs, tx, sender, receiver, name2)
+	err = CreateName(scs, tx, sender, receiver, name2, 0)
 	assert.NoError(t, err, "redirect name")
 
 	scs = nextBlockContractState(t, bs, scs)
-	ret := getAddress(scs, []byte(name2))
+	ret := getAddress(scs, []byte(name2), 0)
 	assert.Equal(t, owner, ret, "registed owner")
 	name1Owner := GetOwner(scs, []byte(name1))
 	t.Logf("name1 owner is %s", types.EncodeAddress(name1Owner))
@@ -104,10 +104,10 @@ func TestNameRecursive(t *testing.T) {
 
 	tx.Payload = buildNamePayload(name1, types.NameUpdate, buyer)
 
-	err = UpdateName(bs, scs, tx, sender, receiver, name1, buyer)
+	err = UpdateName(bs, scs, tx, sender, receiver, name1, buyer, 0)
 	assert.NoError(t, err, "update name")
 	scs = nextBlockContractState(t, bs, scs)
-	ret = getAddress(scs, []byte(name1))
+	ret = getAddress(scs, []byte(name1), 0)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "registed owner")
 }
 
@@ -123,7 +123,7 @@ func TestNameNil(t *testing.T) {
 	sender, _ := sdb.GetStateDB().GetAccountStateV(tx.Account)
 	receiver, _ := sdb.GetStateDB().GetAccountStateV(tx.Recipient)
 
-	err = CreateName(scs, tx, sender, receiver, name2)
+	err = CreateName(scs, tx, sender, receiver, name2, 0)
 	assert.NoError(t, err, "create name")
 }
 
@@ -155,6 +155,213 @@ func TestNameSetContractOwner(t *testing.T) {
 	assert.Equal(t, big.NewInt(0), receiver.Balance(), "check remain")
 }
 
+func TestNameExpiry(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	defer func(period types.BlockNo) { types.NameValidPeriod = period }(types.NameValidPeriod)
+	types.NameValidPeriod = 10
+	types.InitForks(types.ForkSchedule{types.ForkNameExpiry: 0})
+	defer types.InitForks(nil)
+
+	name := "AB1234567890"
+	owner := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	other := types.ToAddress("AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay")
+
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	receiver, _ := bs.GetAccountStateV([]byte(types.AergoName))
+	execute := func(account []byte, operation string, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Amount:    types.NamePrice.Bytes(),
+			Payload:   buildNamePayload(name, operation, ""),
+		}
+		sender, _ := bs.GetAccountStateV(account)
+		sender.AddBalance(types.NamePrice)
+		_, err := ExecuteNameTx(bs, scs, tx, sender, receiver, blockNo)
+		return err
+	}
+
+	assert.NoError(t, execute(owner, types.NameCreate, 1), "create name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, types.BlockNo(11), GetNameMap(scs, []byte(name)).ExpireBlock)
+	assert.Equal(t, []byte("ab1234567890"), GetNameByAddress(scs, owner, 1))
+	assert.Nil(t, GetNameByAddress(scs, other, 1))
+
+	// expired name is not resolved
+	assert.Equal(t, owner, GetAddress(scs, []byte(name), 11))
+	assert.Nil(t, GetAddress(scs, []byte(name), 12))
+	assert.Nil(t, GetNameByAddress(scs, owner, 12))
+
+	assert.Error(t, execute(other, types.NameCreate, 11), "occupied name")
+	assert.Error(t, execute(other, types.NameRenew, 5), "renew by other")
+
+	// owner can renew the expired name until it is claimed
+	assert.NoError(t, execute(owner, types.NameRenew, 15), "renew expired name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, types.BlockNo(25), GetNameMap(scs, []byte(name)).ExpireBlock)
+
+	assert.NoError(t, execute(other, types.NameCreate, 26), "claim expired name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, other, GetOwner(scs, []byte(name)))
+	assert.Equal(t, types.BlockNo(36), GetNameMap(scs, []byte(name)).ExpireBlock)
+	assert.Nil(t, GetNameByAddress(scs, owner, 26))
+	assert.Equal(t, []byte("ab1234567890"), GetNameByAddress(scs, other, 26))
+}
+
+func TestNameExpiryFork(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	types.InitForks(types.ForkSchedule{types.ForkNameExpiry: 10})
+	defer types.InitForks(nil)
+
+	name := "AB1234567890"
+	owner := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	buyer := "AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"
+
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	receiver, _ := bs.GetAccountStateV([]byte(types.AergoName))
+	execute := func(payload []byte, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   owner,
+			Recipient: []byte(types.AergoName),
+			Amount:    types.NamePrice.Bytes(),
+			Payload:   payload,
+		}
+		sender, _ := bs.GetAccountStateV(owner)
+		sender.AddBalance(types.NamePrice)
+		_, err := ExecuteNameTx(bs, scs, tx, sender, receiver, blockNo)
+		if err == nil {
+			scs = nextBlockContractState(t, bs, scs)
+		}
+		return err
+	}
+
+	// names are written in version 1 before the fork
+	assert.NoError(t, execute(buildNamePayload(name, types.NameCreate, ""), 5))
+	nameMap := GetNameMap(scs, []byte(name))
+	assert.Equal(t, byte(1), nameMap.Version)
+	assert.Equal(t, types.BlockNo(0), nameMap.ExpireBlock)
+	assert.Nil(t, GetNameByAddress(scs, owner, 5), "no reverse record")
+	assert.Error(t, execute(buildNamePayload(name, types.NameRenew, ""), 9), "renew before fork")
+
+	// name of version 1 is updated with reverse record after the fork, and still never expires
+	assert.NoError(t, execute(buildNamePayload(name, types.NameUpdate, buyer), 10))
+	nameMap = GetNameMap(scs, []byte(name))
+	assert.True(t, nameMap.Version > 1)
+	assert.Equal(t, types.BlockNo(0), nameMap.ExpireBlock)
+	assert.Equal(t, []byte("ab1234567890"), GetNameByAddress(scs, types.ToAddress(buyer), 10))
+}
+
+func TestSubName(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	name := "AB1234567890"
+	pay := "pay." + name
+	owner := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	buyer := "AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"
+	types.InitForks(types.ForkSchedule{types.ForkNameExpiry: 0, types.ForkSubName: 0})
+	defer types.InitForks(nil)
+
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	receiver, _ := bs.GetAccountStateV([]byte(types.AergoName))
+	execute := func(account []byte, payload []byte, amount *big.Int) error {
+		tx := &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+		}
+		sender, _ := bs.GetAccountStateV(account)
+		sender.AddBalance(amount)
+		_, err := ExecuteNameTx(bs, scs, tx, sender, receiver, 1)
+		if err == nil {
+			scs = nextBlockContractState(t, bs, scs)
+		}
+		return err
+	}
+
+	assert.Error(t, execute(owner, buildNamePayload(pay, types.NameCreate, ""), big.NewInt(0)), "no parent")
+	assert.NoError(t, execute(owner, buildNamePayload(name, types.NameCreate, ""), types.NamePrice))
+	assert.NoError(t, execute(owner, buildNamePayload(pay, types.NameCreate, ""), big.NewInt(0)), "free subname")
+	assert.NoError(t, execute(owner, buildNamePayload("a."+pay, types.NameCreate, ""), big.NewInt(0)))
+	assert.Equal(t, owner, GetAddress(scs, []byte("A.PAY.ab1234567890"), 1))
+	assert.Error(t, execute(types.ToAddress(buyer), buildNamePayload("b."+pay, types.NameCreate, ""), big.NewInt(0)),
+		"not owner of parent")
+
+	// owner of parent transfers subname
+	assert.NoError(t, execute(owner, buildNamePayload(pay, types.NameUpdate, buyer), big.NewInt(0)))
+	assert.Equal(t, buyer, types.EncodeAddress(GetAddress(scs, []byte(pay), 1)))
+	assert.Equal(t, buyer, types.EncodeAddress(GetOwner(scs, []byte(pay))))
+	assert.NoError(t, execute(types.ToAddress(buyer), buildNamePayload("b."+pay, types.NameCreate, ""), big.NewInt(0)))
+
+	// revoked subname and its children are not resolved any more
+	assert.Error(t, execute(owner, buildNamePayload(name, types.NameRevoke, ""), big.NewInt(0)), "revoke top level name")
+	assert.NoError(t, execute(owner, buildNamePayload(pay, types.NameRevoke, ""), big.NewInt(0)))
+	assert.Nil(t, GetAddress(scs, []byte(pay), 1))
+	assert.Nil(t, GetAddress(scs, []byte("a."+pay), 1))
+	assert.NoError(t, execute(owner, buildNamePayload(pay, types.NameCreate, ""), big.NewInt(0)))
+	assert.Equal(t, owner, GetAddress(scs, []byte(pay), 1))
+	assert.Nil(t, GetAddress(scs, []byte("a."+pay), 1), "children of revoked name are not restored")
+	assert.Nil(t, GetAddress(scs, []byte("b."+pay), 1))
+}
+
+func TestSubNameFork(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	types.InitForks(types.ForkSchedule{types.ForkNameExpiry: 0, types.ForkSubName: 10})
+	defer types.InitForks(nil)
+
+	name := "AB1234567890"
+	pay := "pay." + name
+	owner := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	receiver, _ := bs.GetAccountStateV([]byte(types.AergoName))
+	execute := func(payload []byte, amount *big.Int, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   owner,
+			Recipient: []byte(types.AergoName),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+		}
+		sender, _ := bs.GetAccountStateV(owner)
+		sender.AddBalance(amount)
+		_, err := ExecuteNameTx(bs, scs, tx, sender, receiver, blockNo)
+		if err == nil {
+			scs = nextBlockContractState(t, bs, scs)
+		}
+		return err
+	}
+
+	// names are written in version 2 without serial before the fork
+	assert.NoError(t, execute(buildNamePayload(name, types.NameCreate, ""), types.NamePrice, 5))
+	nameMap := GetNameMap(scs, []byte(name))
+	assert.Equal(t, byte(2), nameMap.Version)
+	assert.Equal(t, uint64(0), nameMap.Serial)
+	serial, err := scs.GetData(serialKey)
+	assert.NoError(t, err)
+	assert.Nil(t, serial, "no serial is given before the fork")
+
+	// subname of the name written before the fork is resolved
+	assert.NoError(t, execute(buildNamePayload(pay, types.NameCreate, ""), big.NewInt(0), 10))
+	nameMap = GetNameMap(scs, []byte(pay))
+	assert.Equal(t, byte(nameMapVersion), nameMap.Version)
+	assert.Equal(t, uint64(0), nameMap.ParentSerial)
+	assert.Equal(t, owner, GetAddress(scs, []byte(pay), 10))
+}
+
+func TestNameMapVersion1(t *testing.T) {
+	data := serializeNameMap(&NameMap{Version: 1, Owner: []byte("owner"), Destination: []byte("dest")})
+	nameMap := deserializeNameMap(data)
+	assert.Equal(t, types.BlockNo(0), nameMap.ExpireBlock)
+	assert.False(t, nameMap.Expired(1000000000), "name of version 1 never expires")
+}
+
 func buildNamePayload(name string, operation string, buyer string) []byte {
 	var ci types.CallInfo
 	ci.Name = operation
@@ -215,7 +422,7 @@ func TestNameMap(t *testing.T) {
 
 	resOwner = GetOwner(scs, []byte(name1))
 	assert.Equal(t, testNameMap.Owner, resOwner, "GetOwner")
-	resAddr := getAddress(scs, []byte(name1))
+	resAddr := getAddress(scs, []byte(name1), 0)
 	assert.Equal(t, testNameMap.Destination, resAddr, "getAddress")
 
 }
@@ -104,7 +104,9 @@ func CloseDatabase() {
 	}
 }
 
-func SaveRecoveryPoint(bs *state.BlockState) error {
+// SaveRecoveryPoint commits the sql databases changed by the block of blockNo, and saves their recovery points in
+// the account states of contracts. After ForkSQLDigest, the digests of databases are saved too.
+func SaveRecoveryPoint(bs *state.BlockState, blockNo types.BlockNo) error {
 	defer CloseDatabase()
 
 	for id, db := range database.DBs {
@@ -128,6 +130,11 @@ func SaveRecoveryPoint(bs *state.BlockState) error {
 				}
 				receiverChange := types.State(*receiverState)
 				receiverChange.SqlRecoveryPoint = uint64(rp)
+				if types.IsForkActive(types.ForkSQLDigest, blockNo) {
+					if receiverChange.SqlDigest, err = db.digest(); err != nil {
+						return err
+					}
+				}
 				err = bs.PutState(db.accountID, &receiverChange)
 				if err != nil {
 					return err
@@ -0,0 +1,349 @@
+package contract
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/internal/enc"
+)
+
+const (
+	snapshotDir = "snapshot"
+	// snapshot files older than this are removed, when a new snapshot is made
+	snapshotTTL = 10 * time.Minute
+	// a snapshot younger than this is served instead of making a new copy of database
+	snapshotReuse = time.Minute
+	// MaxDatabaseChunkSize is the max size of a chunk of database file read at once
+	MaxDatabaseChunkSize = 1 << 22
+)
+
+var (
+	ErrInvalidDbName      = errors.New("invalid sql database name")
+	ErrSnapshotNotFound   = errors.New("snapshot of sql database not found")
+	ErrInvalidChunkOffset = errors.New("chunk of sql database is not continued from the written part")
+	ErrNoDatabaseDigest   = errors.New("no digest of sql database to verify")
+	ErrDigestMismatch     = errors.New("digest of downloaded sql database mismatch")
+)
+
+func checkDbName(dbName string) error {
+	b, err := enc.ToBytes(dbName)
+	if err != nil || len(b) != 32 {
+		return ErrInvalidDbName
+	}
+	return nil
+}
+
+func dbPath(dbName string) string {
+	return filepath.Join(database.DataDir, dbName+".db")
+}
+
+func snapshotPath(dbName string, version uint64) string {
+	return filepath.Join(database.DataDir, snapshotDir, fmt.Sprintf("%s.%d.db", dbName, version))
+}
+
+// SnapshotDatabase copies the sql database of contract to a snapshot file of version, so that the file can be
+// read by parts while new blocks are executed. If a snapshot of the database was made recently, it is reused instead
+// of copying the whole database again. It returns the version of the snapshot. It must be called between the
+// executions of blocks, when databases are closed.
+func SnapshotDatabase(dbName string, version uint64) (uint64, error) {
+	if err := checkDbName(dbName); err != nil {
+		return 0, err
+	}
+	dir := filepath.Join(database.DataDir, snapshotDir)
+	if err := checkPath(dir); err != nil {
+		return 0, err
+	}
+	removeOldSnapshots(dir)
+
+	if recent, ok := recentSnapshot(dir, dbName); ok {
+		return recent, nil
+	}
+	src, err := os.Open(dbPath(dbName))
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	tmp, err := ioutil.TempFile(dir, dbName)
+	if err != nil {
+		return 0, err
+	}
+	_, err = io.Copy(tmp, src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return 0, err
+	}
+	return version, os.Rename(tmp.Name(), snapshotPath(dbName, version))
+}
+
+// recentSnapshot returns the latest version of the snapshots of database made within snapshotReuse
+func recentSnapshot(dir, dbName string) (uint64, bool) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, false
+	}
+	var (
+		latest uint64
+		found  bool
+	)
+	for _, f := range files {
+		if time.Since(f.ModTime()) > snapshotReuse {
+			continue
+		}
+		name := strings.TrimSuffix(f.Name(), ".db")
+		if name == f.Name() || !strings.HasPrefix(name, dbName+".") {
+			continue
+		}
+		version, err := strconv.ParseUint(strings.TrimPrefix(name, dbName+"."), 10, 64)
+		if err != nil {
+			continue
+		}
+		if !found || version > latest {
+			latest, found = version, true
+		}
+	}
+	return latest, found
+}
+
+func removeOldSnapshots(dir string) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if time.Since(f.ModTime()) > snapshotTTL {
+			_ = os.Remove(filepath.Join(dir, f.Name()))
+		}
+	}
+}
+
+// ReadDatabaseSnapshot reads a part of snapshot file of version from offset. It also returns the size of file.
+func ReadDatabaseSnapshot(dbName string, version, offset uint64) ([]byte, uint64, error) {
+	if err := checkDbName(dbName); err != nil {
+		return nil, 0, err
+	}
+	f, err := os.Open(snapshotPath(dbName, version))
+	if os.IsNotExist(err) {
+		return nil, 0, ErrSnapshotNotFound
+	} else if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	size := uint64(info.Size())
+	if offset >= size {
+		return nil, size, nil
+	}
+	buf := make([]byte, MaxDatabaseChunkSize)
+	n, err := f.ReadAt(buf, int64(offset))
+	if err != nil && err != io.EOF {
+		return nil, 0, err
+	}
+	return buf[:n], size, nil
+}
+
+// WriteDatabaseChunk writes a part of downloaded sql database to a temporary file. The parts must be written in
+// order, and the file is written again from the start if offset is zero.
+func WriteDatabaseChunk(dbName string, offset uint64, data []byte) error {
+	if err := checkDbName(dbName); err != nil {
+		return err
+	}
+	flag := os.O_CREATE | os.O_WRONLY
+	if offset == 0 {
+		flag |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(dbPath(dbName)+".part", flag, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err == nil && uint64(info.Size()) != offset {
+		err = ErrInvalidChunkOffset
+	}
+	if err == nil {
+		_, err = f.WriteAt(data, int64(offset))
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// RestoreDatabase replaces the sql database with the downloaded one, and truncates the commits after the recovery
+// point of contract state, since the database may be newer than the state. The database is removed unless it has
+// the digest of contract state.
+func RestoreDatabase(dbName string, rp uint64, digest []byte) error {
+	if err := checkDbName(dbName); err != nil {
+		return err
+	}
+	if len(digest) == 0 {
+		return ErrNoDatabaseDigest
+	}
+	if err := os.Rename(dbPath(dbName)+".part", dbPath(dbName)); err != nil {
+		return err
+	}
+
+	db, err := conn(dbName)
+	if err == nil {
+		err = db.restoreRecoveryPoint(rp)
+	}
+	if err == nil {
+		var d []byte
+		if d, err = db.digest(); err == nil && !bytes.Equal(d, digest) {
+			err = ErrDigestMismatch
+		}
+	}
+	CloseDatabase()
+	if err != nil {
+		logger.Error().Err(err).Str("db_name", dbName).Uint64("rp", rp).Msg("failed to restore downloaded database")
+		_ = os.Remove(dbPath(dbName))
+	}
+	return err
+}
+
+// digest returns the hash of the schema and the rows of database. Unlike the database file, it doesn't depend on
+// the commits after the current one, nor on the layout of pages, so it is the same in all nodes.
+func (db *DB) digest() ([]byte, error) {
+	ctx := context.Background()
+	h := sha256.New()
+
+	rows, err := db.QueryContext(ctx,
+		"SELECT type, name, ifnull(sql, '') FROM sqlite_master ORDER BY type, name")
+	if err != nil {
+		return nil, err
+	}
+	type table struct {
+		name    string
+		noRowID bool
+	}
+	var tables []table
+	for rows.Next() {
+		var typ, name, ddl string
+		if err := rows.Scan(&typ, &name, &ddl); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		writeDigestValue(h, typ)
+		writeDigestValue(h, name)
+		writeDigestValue(h, ddl)
+		if typ == "table" && !strings.HasPrefix(name, "sqlite_") {
+			tables = append(tables, table{name, strings.Contains(strings.ToUpper(ddl), "WITHOUT ROWID")})
+		}
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tables {
+		order := "rowid"
+		if t.noRowID {
+			if order, err = db.primaryKey(ctx, t.name); err != nil {
+				return nil, err
+			}
+		}
+		if err = db.digestRows(ctx, h, fmt.Sprintf("SELECT * FROM %s ORDER BY %s", quoteIdent(t.name), order)); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+func (db *DB) digestRows(ctx context.Context, w io.Writer, query string) error {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	values := make([]interface{}, len(cols))
+	ptrs := make([]interface{}, len(cols))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return err
+		}
+		for _, v := range values {
+			writeDigestValue(w, v)
+		}
+	}
+	return rows.Err()
+}
+
+// primaryKey returns the columns of primary key of table, which orders the rows of table without rowid
+func (db *DB) primaryKey(ctx context.Context, table string) (string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT name FROM pragma_table_info(?) WHERE pk > 0 ORDER BY pk", table)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	var cols []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return "", err
+		}
+		cols = append(cols, quoteIdent(name))
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(cols) == 0 {
+		return "", fmt.Errorf("no primary key of table %s", table)
+	}
+	return strings.Join(cols, ", "), nil
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// writeDigestValue writes a value of column with its type and length, so that different values are not written
+// as the same bytes
+func writeDigestValue(w io.Writer, v interface{}) {
+	var (
+		typ  byte
+		data []byte
+	)
+	switch x := v.(type) {
+	case nil:
+		typ = 0
+	case int64:
+		typ, data = 1, []byte(strconv.FormatInt(x, 10))
+	case float64:
+		typ, data = 2, []byte(strconv.FormatFloat(x, 'g', -1, 64))
+	case []byte:
+		typ, data = 3, x
+	case string:
+		typ, data = 4, []byte(x)
+	default:
+		typ, data = 5, []byte(fmt.Sprint(x))
+	}
+	header := make([]byte, 9)
+	header[0] = typ
+	binary.BigEndian.PutUint64(header[1:], uint64(len(data)))
+	_, _ = w.Write(header)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,224 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+var (
+	allowlistKey         = []byte("allowedpeers")
+	allowlistApprovalKey = []byte("allowlistapproval")
+)
+
+// MaxAllowedPeers is the maximum number of peers in the allowlist of a permissioned network
+const MaxAllowedPeers = 1024
+
+// addAllowedPeer approves to add a peer to the allowlist. The peer is added when the quorum of bps approve it. The
+// network becomes permissioned when the first peer is added, so that only the peers in the allowlist can join.
+func addAllowedPeer(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	id := context.Args[0]
+	allowlist := context.Allowlist
+	if len(context.Approvals) >= context.Quorum {
+		allowlist = append(allowlist, peer.ID(id))
+	}
+	if err := approveAllowlist(scs, context, allowlist); err != nil {
+		return nil, err
+	}
+	return allowlistEvent(receiver, sender, "addAllowedPeer", id, context), nil
+}
+
+// removeAllowedPeer approves to remove a peer from the allowlist. The peer is removed when the quorum of bps
+// approve it. The network becomes permissionless again when the last peer is removed.
+func removeAllowedPeer(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	id := context.Args[0]
+	allowlist := context.Allowlist
+	if len(context.Approvals) >= context.Quorum {
+		allowlist = make([]peer.ID, 0, len(context.Allowlist))
+		for _, allowed := range context.Allowlist {
+			if string(allowed) != id {
+				allowlist = append(allowlist, allowed)
+			}
+		}
+	}
+	if err := approveAllowlist(scs, context, allowlist); err != nil {
+		return nil, err
+	}
+	return allowlistEvent(receiver, sender, "removeAllowedPeer", id, context), nil
+}
+
+// approveAllowlist saves the approvals until the quorum is reached. Then it saves the changed allowlist and clears
+// the approvals.
+func approveAllowlist(scs *state.ContractState, context *SystemContext, allowlist []peer.ID) error {
+	key := approvalKey(context.Call.Name, context.Args[0])
+	if len(context.Approvals) < context.Quorum {
+		var data []byte
+		for _, bp := range context.Approvals {
+			data = appendBytes(data, []byte(bp))
+		}
+		return scs.SetData(key, data)
+	}
+	if err := scs.DeleteData(key); err != nil {
+		return err
+	}
+	return setAllowlist(scs, allowlist)
+}
+
+func allowlistEvent(receiver, sender *state.V, name, id string, context *SystemContext) *types.Event {
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       name,
+		JsonArgs: `{"who":"` + types.EncodeAddress(sender.ID()) +
+			`", "peer":"` + peer.ID(id).Pretty() +
+			`", "approvals":` + strconv.Itoa(len(context.Approvals)) +
+			`, "quorum":` + strconv.Itoa(context.Quorum) + `}`,
+	}
+}
+
+// validateForAllowlist checks that the sender is a block producer, whose account is the block signing key, and
+// that it has not approved the same change yet.
+func validateForAllowlist(account []byte, scs *state.ContractState, context *SystemContext) error {
+	id, err := peer.IDB58Decode(context.Call.Args[0].(string))
+	if err != nil {
+		return types.ErrTxInvalidPayload
+	}
+	bpID, err := bpIDOf(account)
+	if err != nil {
+		return err
+	}
+	bps, err := blockProducers(scs, context.BlockNo)
+	if err != nil {
+		return err
+	}
+	if !bps[enc.ToString([]byte(bpID))] {
+		return types.ErrNotBlockProducer
+	}
+	allowlist, err := GetAllowedPeers(scs)
+	if err != nil {
+		return err
+	}
+	exist := false
+	for _, allowed := range allowlist {
+		if allowed == id {
+			exist = true
+			break
+		}
+	}
+	switch context.Call.Name {
+	case types.AddAllowedPeer:
+		if exist {
+			return types.ErrPeerAlreadyAllowed
+		}
+		if len(allowlist) >= MaxAllowedPeers {
+			return types.ErrTooManyAllowedPeers
+		}
+	case types.RemoveAllowedPeer:
+		if !exist {
+			return types.ErrPeerNotInAllowlist
+		}
+	}
+	approvals, err := getApprovals(scs, approvalKey(context.Call.Name, string(id)))
+	if err != nil {
+		return err
+	}
+	// the approvals of the bps which are not in charge any more don't count
+	valid := make([]peer.ID, 0, len(approvals)+1)
+	for _, bp := range approvals {
+		if bp == bpID {
+			return types.ErrAlreadyApproved
+		}
+		if bps[enc.ToString([]byte(bp))] {
+			valid = append(valid, bp)
+		}
+	}
+	context.Allowlist = allowlist
+	context.Approvals = append(valid, bpID)
+	context.Quorum = len(bps)*2/3 + 1
+	context.Args = []string{string(id)}
+	return nil
+}
+
+func approvalKey(name, id string) []byte {
+	return append(append(append([]byte{}, allowlistApprovalKey...), name...), id...)
+}
+
+func getApprovals(scs *state.ContractState, key []byte) ([]peer.ID, error) {
+	data, err := scs.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	var approvals []peer.ID
+	for len(data) > 0 {
+		var id []byte
+		id, data = readBytes(data)
+		approvals = append(approvals, peer.ID(id))
+	}
+	return approvals, nil
+}
+
+// GetAllowedPeers returns the peers allowed to join the network. The network is permissionless if it is empty.
+func GetAllowedPeers(scs *state.ContractState) ([]peer.ID, error) {
+	data, err := scs.GetData(allowlistKey)
+	if err != nil {
+		return nil, err
+	}
+	var allowlist []peer.ID
+	for len(data) > 0 {
+		var id []byte
+		id, data = readBytes(data)
+		allowlist = append(allowlist, peer.ID(id))
+	}
+	return allowlist, nil
+}
+
+// GetPermittedPeers returns the peers which can join the network at blockNo. They are the peers of the allowlist and
+// the bps in charge of the block, which are always permitted so that an allowlist can't shut the bps out of their
+// own network. It is empty if the network is permissionless.
+func GetPermittedPeers(scs *state.ContractState, blockNo types.BlockNo) ([]peer.ID, error) {
+	allowlist, err := GetAllowedPeers(scs)
+	if err != nil || len(allowlist) == 0 {
+		return allowlist, err
+	}
+	bps, err := blockProducers(scs, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range allowlist {
+		delete(bps, enc.ToString([]byte(id)))
+	}
+	ids := make([]string, 0, len(bps))
+	for id := range bps {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		raw, err := enc.ToBytes(id)
+		if err != nil {
+			return nil, err
+		}
+		allowlist = append(allowlist, peer.ID(raw))
+	}
+	return allowlist, nil
+}
+
+func setAllowlist(scs *state.ContractState, allowlist []peer.ID) error {
+	if len(allowlist) == 0 {
+		return scs.DeleteData(allowlistKey)
+	}
+	var data []byte
+	for _, id := range allowlist {
+		data = appendBytes(data, []byte(id))
+	}
+	return scs.SetData(allowlistKey, data)
+}
@@ -0,0 +1,160 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowlist(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	defer func() { genesisBPs = nil }()
+
+	priv, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	assert.NoError(t, err)
+	b := &types.Block{Header: &types.BlockHeader{}}
+	assert.NoError(t, b.Sign(priv))
+	addr, err := b.BPAddress()
+	assert.NoError(t, err)
+	bp, err := sdb.GetAccountStateV(addr)
+	assert.NoError(t, err)
+	bpID, err := peer.IDFromPublicKey(priv.GetPublic())
+	assert.NoError(t, err)
+	_, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	nodeID, _ := peer.IDFromPublicKey(pub)
+
+	execute := func(v *state.V, name string, id peer.ID) ([]*types.Event, error) {
+		tx := &types.TxBody{
+			Account:   v.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    big.NewInt(0).Bytes(),
+			Payload:   []byte(`{"Name":"` + name + `","Args":["` + id.Pretty() + `"]}`),
+		}
+		return ExecuteSystemTx(scs, tx, v, receiver, 1)
+	}
+
+	types.InitForks(types.ForkSchedule{types.ForkAllowlist: 2})
+	_, err = execute(bp, types.AddAllowedPeer, nodeID)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "before the fork of allowlist")
+	types.InitForks(types.ForkSchedule{types.ForkAllowlist: 1})
+
+	_, err = execute(bp, types.AddAllowedPeer, nodeID)
+	assert.Equal(t, types.ErrNotBlockProducer, err)
+	InitGenesisBPs([]string{enc.ToString([]byte(bpID))})
+	_, err = execute(sender, types.AddAllowedPeer, nodeID)
+	assert.Equal(t, types.ErrNotBlockProducer, err, "sender is not a secp256k1 public key")
+
+	events, err := execute(bp, types.AddAllowedPeer, nodeID)
+	assert.NoError(t, err)
+	assert.Equal(t, "addAllowedPeer", events[0].EventName)
+	_, err = execute(bp, types.AddAllowedPeer, nodeID)
+	assert.Equal(t, types.ErrPeerAlreadyAllowed, err)
+	_, err = execute(bp, types.AddAllowedPeer, bpID)
+	assert.NoError(t, err)
+
+	allowlist, err := GetAllowedPeers(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, []peer.ID{nodeID, bpID}, allowlist)
+
+	_, err = execute(bp, types.RemoveAllowedPeer, nodeID)
+	assert.NoError(t, err)
+	_, err = execute(bp, types.RemoveAllowedPeer, nodeID)
+	assert.Equal(t, types.ErrPeerNotInAllowlist, err)
+	_, err = execute(bp, types.RemoveAllowedPeer, bpID)
+	assert.NoError(t, err)
+	allowlist, err = GetAllowedPeers(scs)
+	assert.NoError(t, err)
+	assert.Empty(t, allowlist, "network is permissionless again")
+}
+
+func TestAllowlistQuorum(t *testing.T) {
+	scs, _, receiver := initTest(t)
+	defer deinitTest()
+
+	bps := make([]*state.V, 3)
+	ids := make([]string, 3)
+	for i := range bps {
+		priv, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+		assert.NoError(t, err)
+		b := &types.Block{Header: &types.BlockHeader{}}
+		assert.NoError(t, b.Sign(priv))
+		addr, err := b.BPAddress()
+		assert.NoError(t, err)
+		bps[i], err = sdb.GetAccountStateV(addr)
+		assert.NoError(t, err)
+		bpID, err := peer.IDFromPublicKey(priv.GetPublic())
+		assert.NoError(t, err)
+		ids[i] = enc.ToString([]byte(bpID))
+	}
+	_, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	nodeID, _ := peer.IDFromPublicKey(pub)
+
+	// the consensus doesn't have the last bp as a member any more
+	InitMembers(&testElection{bps: ids[:2]})
+	defer InitMembers(nil)
+
+	execute := func(v *state.V, name string) ([]*types.Event, error) {
+		tx := &types.TxBody{
+			Account:   v.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    big.NewInt(0).Bytes(),
+			Payload:   []byte(`{"Name":"` + name + `","Args":["` + nodeID.Pretty() + `"]}`),
+		}
+		return ExecuteSystemTx(scs, tx, v, receiver, 1)
+	}
+
+	_, err := execute(bps[2], types.AddAllowedPeer)
+	assert.Equal(t, types.ErrNotBlockProducer, err)
+
+	events, err := execute(bps[0], types.AddAllowedPeer)
+	assert.NoError(t, err)
+	assert.Contains(t, events[0].JsonArgs, `"approvals":1, "quorum":2`)
+	_, err = execute(bps[0], types.AddAllowedPeer)
+	assert.Equal(t, types.ErrAlreadyApproved, err)
+	allowlist, err := GetAllowedPeers(scs)
+	assert.NoError(t, err)
+	assert.Empty(t, allowlist, "a single bp can't change the allowlist")
+
+	events, err = execute(bps[1], types.AddAllowedPeer)
+	assert.NoError(t, err)
+	assert.Contains(t, events[0].JsonArgs, `"approvals":2, "quorum":2`)
+	allowlist, err = GetAllowedPeers(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, []peer.ID{nodeID}, allowlist)
+	// the first peer makes the network permissioned, but the bps in charge are still permitted
+	permitted, err := GetPermittedPeers(scs, 1)
+	assert.NoError(t, err)
+	assert.Len(t, permitted, 3)
+	assert.Contains(t, permitted, nodeID)
+	for _, id := range ids[:2] {
+		raw, _ := enc.ToBytes(id)
+		assert.Contains(t, permitted, peer.ID(raw))
+	}
+	raw, _ := enc.ToBytes(ids[2])
+	assert.NotContains(t, permitted, peer.ID(raw), "not a member of the consensus")
+
+	_, err = execute(bps[1], types.RemoveAllowedPeer)
+	assert.NoError(t, err)
+	allowlist, err = GetAllowedPeers(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, []peer.ID{nodeID}, allowlist)
+	_, err = execute(bps[0], types.RemoveAllowedPeer)
+	assert.NoError(t, err)
+	allowlist, err = GetAllowedPeers(scs)
+	assert.NoError(t, err)
+	assert.Empty(t, allowlist)
+	permitted, err = GetPermittedPeers(scs, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, permitted, "network is permissionless again")
+}
@@ -0,0 +1,99 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+var genesisBPs map[string]bool
+
+// InitGenesisBPs sets the block producers of the genesis block, which are members of the bp cluster until the
+// first election.
+//
+// Caution: This function must be called only once before all the aergosvr
+// services start.
+func InitGenesisBPs(bps []string) {
+	if genesisBPs != nil {
+		return
+	}
+	genesisBPs = make(map[string]bool, len(bps))
+	for _, id := range bps {
+		genesisBPs[id] = true
+	}
+}
+
+// Members is implemented by the consensus which knows the bps in charge of the blocks.
+type Members interface {
+	// BPs returns the ids of the bps in charge of the block at blockNo.
+	BPs(blockNo types.BlockNo) ([]string, error)
+}
+
+var members Members
+
+// InitMembers sets the members of the consensus. Without it, the bps are the genesis bps and the elected ones.
+//
+// Caution: This function must be called only once before all the aergosvr
+// services start.
+func InitMembers(m Members) {
+	members = m
+}
+
+// blockProducers returns the encoded ids of the bps in charge of the block at blockNo.
+func blockProducers(scs *state.ContractState, blockNo types.BlockNo) (map[string]bool, error) {
+	if members != nil {
+		bps, err := members.BPs(blockNo)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]bool, len(bps))
+		for _, id := range bps {
+			ids[id] = true
+		}
+		return ids, nil
+	}
+	ids := make(map[string]bool, len(genesisBPs))
+	for id := range genesisBPs {
+		ids[id] = true
+	}
+	n, err := getBpCount(scs)
+	if err != nil {
+		return nil, err
+	}
+	vl, err := getVoteResult(scs, defaultVoteKey, n)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vl.Votes {
+		ids[enc.ToString(v.Candidate)] = true
+	}
+	return ids, nil
+}
+
+// isBlockProducer reports whether id is one of the bps in charge of the block at blockNo.
+func isBlockProducer(scs *state.ContractState, id peer.ID, blockNo types.BlockNo) (bool, error) {
+	bps, err := blockProducers(scs, blockNo)
+	if err != nil {
+		return false, err
+	}
+	return bps[enc.ToString([]byte(id))], nil
+}
+
+// bpIDOf returns the peer id of a bp whose account is its block signing key.
+func bpIDOf(account []byte) (peer.ID, error) {
+	pub, err := crypto.UnmarshalSecp256k1PublicKey(account)
+	if err != nil {
+		return "", types.ErrNotBlockProducer
+	}
+	id, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		return "", types.ErrNotBlockProducer
+	}
+	return id, nil
+}
@@ -0,0 +1,404 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var delegationKey = []byte("delegation")
+var bpPoolKey = []byte("bppool")
+
+// rewardPrecision scales the accumulated reward per delegated aer to keep the precision of division
+var rewardPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// bpPool is the delegation pool of a bp, identified by its coinbase account. AccReward is the accumulated reward
+// for a delegated aer multiplied by rewardPrecision. So a block reward is distributed to all delegators by
+// updating AccReward only, and the reward of each delegator is calculated when it is touched.
+type bpPool struct {
+	Commission uint32
+	When       types.BlockNo // block of the last change of commission
+	Total      *big.Int
+	AccReward  *big.Int
+}
+
+// delegation of an account. RewardDebt is the part of Amount * AccReward which is already counted in Reward.
+type delegation struct {
+	Bp         []byte
+	Amount     *big.Int
+	RewardDebt *big.Int
+	Reward     *big.Int
+	When       types.BlockNo
+}
+
+// settle moves the reward accumulated since the last settlement to Reward.
+func (d *delegation) settle(pool *bpPool) {
+	if pool == nil {
+		return
+	}
+	accumulated := new(big.Int).Mul(d.Amount, pool.AccReward)
+	accumulated.Div(accumulated, rewardPrecision)
+	d.Reward = new(big.Int).Add(d.Reward, accumulated.Sub(accumulated, d.RewardDebt))
+}
+
+func (d *delegation) resetDebt(pool *bpPool) {
+	d.RewardDebt = new(big.Int).Mul(d.Amount, pool.AccReward)
+	d.RewardDebt.Div(d.RewardDebt, rewardPrecision)
+}
+
+func setCommission(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	pool := context.Pool
+	if pool == nil {
+		pool = newBpPool()
+	}
+	rate := context.Args[0]
+	commission, _ := strconv.ParseUint(rate, 10, 32)
+	pool.Commission = uint32(commission)
+	pool.When = blockNo
+	if err := setBpPool(scs, sender.ID(), pool); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "set commission",
+		JsonArgs: `{"bp":"` + types.EncodeAddress(sender.ID()) +
+			`", "commission":` + rate + `}`,
+	}, nil
+}
+
+func delegate(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	d, pool := context.Delegation, context.Pool
+	amount := txBody.GetAmountBigInt()
+	d.settle(context.PrevPool)
+	d.Bp = context.Bp
+	d.Amount = new(big.Int).Add(d.Amount, amount)
+	d.When = blockNo
+	d.resetDebt(pool)
+	pool.Total = new(big.Int).Add(pool.Total, amount)
+	if err := setDelegation(scs, sender.ID(), d); err != nil {
+		return nil, err
+	}
+	if err := setBpPool(scs, d.Bp, pool); err != nil {
+		return nil, err
+	}
+	sender.SubBalance(amount)
+	receiver.AddBalance(amount)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "delegate",
+		JsonArgs: `{"who":"` + types.EncodeAddress(sender.ID()) +
+			`", "bp":"` + types.EncodeAddress(d.Bp) +
+			`", "amount":"` + amount.String() + `"}`,
+	}, nil
+}
+
+func undelegate(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	d, pool := context.Delegation, context.Pool
+	amount := txBody.GetAmountBigInt()
+	d.settle(pool)
+	d.Amount = new(big.Int).Sub(d.Amount, amount)
+	d.When = blockNo
+	d.resetDebt(pool)
+	pool.Total = new(big.Int).Sub(pool.Total, amount)
+	if err := setDelegation(scs, sender.ID(), d); err != nil {
+		return nil, err
+	}
+	if err := setBpPool(scs, d.Bp, pool); err != nil {
+		return nil, err
+	}
+	release, err := unbond(scs, sender, receiver, context.Unbonding, amount, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "undelegate",
+		JsonArgs: `{"who":"` + types.EncodeAddress(sender.ID()) +
+			`", "bp":"` + types.EncodeAddress(d.Bp) +
+			`", "amount":"` + amount.String() + `"` + releaseJson(release) + `}`,
+	}, nil
+}
+
+func claim(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	d, pool := context.Delegation, context.Pool
+	d.settle(pool)
+	if pool != nil {
+		d.resetDebt(pool)
+	}
+	reward := d.Reward
+	d.Reward = new(big.Int)
+	if err := setDelegation(scs, sender.ID(), d); err != nil {
+		return nil, err
+	}
+	sender.AddBalance(reward)
+	receiver.SubBalance(reward)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "claim",
+		JsonArgs: `{"who":"` + types.EncodeAddress(sender.ID()) +
+			`", "amount":"` + reward.String() + `"}`,
+	}, nil
+}
+
+func validateForSetCommission(account []byte, scs *state.ContractState, context *SystemContext) error {
+	pool, err := getBpPool(scs, account)
+	if err != nil {
+		return err
+	}
+	if pool != nil && pool.When+StakingDelay > context.BlockNo {
+		return types.ErrLessTimeHasPassed
+	}
+	context.Args = []string{context.Call.Args[0].(string)}
+	context.Pool = pool
+	return nil
+}
+
+func validateForDelegation(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	context *SystemContext) error {
+	bp, err := types.DecodeAddress(context.Call.Args[0].(string))
+	if err != nil {
+		return types.ErrTxInvalidPayload
+	}
+	if txBody.GetAmountBigInt().Sign() <= 0 {
+		return types.ErrTooSmallAmount
+	}
+	pool, err := getBpPool(scs, bp)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		return types.ErrNoCommission
+	}
+	d, err := getDelegation(scs, account)
+	if err != nil {
+		return err
+	}
+	// the settled reward is kept when the delegation moves to other bp
+	prevPool := pool
+	if len(d.Bp) != 0 && string(d.Bp) != string(bp) {
+		if d.Amount.Sign() != 0 {
+			return types.ErrDelegatedToOtherBP
+		}
+		if prevPool, err = getBpPool(scs, d.Bp); err != nil {
+			return err
+		}
+	}
+	context.Bp = bp
+	context.Delegation = d
+	context.Pool = pool
+	context.PrevPool = prevPool
+	return nil
+}
+
+func validateForUndelegation(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	context *SystemContext) error {
+	d, err := getDelegation(scs, account)
+	if err != nil {
+		return err
+	}
+	if d.Amount.Sign() == 0 {
+		return types.ErrMustDelegateBeforeUndelegate
+	}
+	amount := txBody.GetAmountBigInt()
+	if amount.Sign() <= 0 {
+		return types.ErrTooSmallAmount
+	}
+	if d.Amount.Cmp(amount) < 0 {
+		return types.ErrExceedAmount
+	}
+	if d.When+StakingDelay > context.BlockNo {
+		return types.ErrLessTimeHasPassed
+	}
+	pool, err := getBpPool(scs, d.Bp)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		return types.ErrNoCommission
+	}
+	context.Delegation = d
+	context.Pool = pool
+	return nil
+}
+
+func validateForClaim(account []byte, scs *state.ContractState, context *SystemContext) error {
+	d, err := getDelegation(scs, account)
+	if err != nil {
+		return err
+	}
+	pool, err := getBpPool(scs, d.Bp)
+	if err != nil {
+		return err
+	}
+	if reward := pendingReward(d, pool); reward.Sign() == 0 {
+		return types.ErrNoReward
+	}
+	context.Delegation = d
+	context.Pool = pool
+	return nil
+}
+
+func pendingReward(d *delegation, pool *bpPool) *big.Int {
+	settled := *d
+	settled.settle(pool)
+	return settled.Reward
+}
+
+// DistributeReward splits the block reward of bp between the bp and its delegators. It returns the commission
+// of bp, and the rest is kept in the system account until delegators claim it. The cost is constant regardless
+// of the number of delegators.
+func DistributeReward(scs *state.ContractState, bp []byte, reward *big.Int) (*big.Int, error) {
+	pool, err := getBpPool(scs, bp)
+	if err != nil {
+		return nil, err
+	}
+	if pool == nil || pool.Total.Sign() == 0 {
+		return reward, nil
+	}
+	commission := new(big.Int).Mul(reward, big.NewInt(int64(pool.Commission)))
+	commission.Div(commission, big.NewInt(types.MaxCommission))
+	rest := new(big.Int).Sub(reward, commission)
+	inc := new(big.Int).Mul(rest, rewardPrecision)
+	pool.AccReward = new(big.Int).Add(pool.AccReward, inc.Div(inc, pool.Total))
+	if err := setBpPool(scs, bp, pool); err != nil {
+		return nil, err
+	}
+	return commission, nil
+}
+
+// GetDelegation returns the delegation of address with the reward which can be claimed now.
+func GetDelegation(scs *state.ContractState, address []byte) (*types.Delegation, error) {
+	if address == nil {
+		return nil, errors.New("invalid argument: address should not be nil")
+	}
+	d, err := getDelegation(scs, address)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := getBpPool(scs, d.Bp)
+	if err != nil {
+		return nil, err
+	}
+	ret := &types.Delegation{
+		Bp:     d.Bp,
+		Amount: d.Amount.Bytes(),
+		Reward: pendingReward(d, pool).Bytes(),
+		When:   d.When,
+	}
+	if pool != nil {
+		ret.Commission = pool.Commission
+	}
+	return ret, nil
+}
+
+func newBpPool() *bpPool {
+	return &bpPool{Total: new(big.Int), AccReward: new(big.Int)}
+}
+
+func getBpPool(scs *state.ContractState, bp []byte) (*bpPool, error) {
+	if len(bp) == 0 {
+		return nil, nil
+	}
+	data, err := scs.GetData(append(bpPoolKey, bp...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return deserializeBpPool(data), nil
+}
+
+func setBpPool(scs *state.ContractState, bp []byte, pool *bpPool) error {
+	return scs.SetData(append(bpPoolKey, bp...), serializeBpPool(pool))
+}
+
+func getDelegation(scs *state.ContractState, who []byte) (*delegation, error) {
+	data, err := scs.GetData(append(delegationKey, who...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &delegation{Amount: new(big.Int), RewardDebt: new(big.Int), Reward: new(big.Int)}, nil
+	}
+	return deserializeDelegation(data), nil
+}
+
+func setDelegation(scs *state.ContractState, who []byte, d *delegation) error {
+	key := append(delegationKey, who...)
+	if d.Amount.Sign() == 0 && d.Reward.Sign() == 0 {
+		return scs.DeleteData(key)
+	}
+	return scs.SetData(key, serializeDelegation(d))
+}
+
+func serializeBpPool(p *bpPool) []byte {
+	ret := make([]byte, 12)
+	binary.LittleEndian.PutUint32(ret, p.Commission)
+	binary.LittleEndian.PutUint64(ret[4:], p.When)
+	ret = appendBytes(ret, p.Total.Bytes())
+	return appendBytes(ret, p.AccReward.Bytes())
+}
+
+func deserializeBpPool(data []byte) *bpPool {
+	p := &bpPool{
+		Commission: binary.LittleEndian.Uint32(data),
+		When:       binary.LittleEndian.Uint64(data[4:]),
+	}
+	total, data := readBytes(data[12:])
+	acc, _ := readBytes(data)
+	p.Total = new(big.Int).SetBytes(total)
+	p.AccReward = new(big.Int).SetBytes(acc)
+	return p
+}
+
+func serializeDelegation(d *delegation) []byte {
+	ret := make([]byte, 8)
+	binary.LittleEndian.PutUint64(ret, d.When)
+	ret = appendBytes(ret, d.Bp)
+	ret = appendBytes(ret, d.Amount.Bytes())
+	ret = appendBytes(ret, d.RewardDebt.Bytes())
+	return appendBytes(ret, d.Reward.Bytes())
+}
+
+func deserializeDelegation(data []byte) *delegation {
+	d := &delegation{When: binary.LittleEndian.Uint64(data)}
+	var amount, debt, reward []byte
+	d.Bp, data = readBytes(data[8:])
+	amount, data = readBytes(data)
+	debt, data = readBytes(data)
+	reward, _ = readBytes(data)
+	d.Amount = new(big.Int).SetBytes(amount)
+	d.RewardDebt = new(big.Int).SetBytes(debt)
+	d.Reward = new(big.Int).SetBytes(reward)
+	return d
+}
+
+func appendBytes(buf []byte, b []byte) []byte {
+	size := make([]byte, 8)
+	binary.LittleEndian.PutUint64(size, uint64(len(b)))
+	buf = append(buf, size...)
+	return append(buf, b...)
+}
+
+func readBytes(data []byte) ([]byte, []byte) {
+	size := binary.LittleEndian.Uint64(data)
+	next := 8 + int(size)
+	return data[8:next], data[next:]
+}
@@ -0,0 +1,114 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegation(t *testing.T) {
+	scs, delegator1, receiver := initTest(t)
+	defer deinitTest()
+	bpAddress := "AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL"
+	bp, _ := sdb.GetAccountStateV(types.ToAddress(bpAddress))
+	delegator2, _ := sdb.GetAccountStateV(types.ToAddress("AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"))
+	delegator1.AddBalance(big.NewInt(1000))
+	delegator2.AddBalance(big.NewInt(1000))
+
+	execute := func(sender *state.V, payload string, amount int64, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   sender.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    big.NewInt(amount).Bytes(),
+			Payload:   []byte(payload),
+		}
+		_, err := ExecuteSystemTx(scs, tx, sender, receiver, blockNo)
+		return err
+	}
+	delegatePayload := `{"Name":"v1delegate","Args":["` + bpAddress + `"]}`
+
+	assert.Equal(t, types.ErrNoCommission, execute(delegator1, delegatePayload, 300, 1))
+	assert.NoError(t, execute(bp, `{"Name":"v1setCommission","Args":["1000"]}`, 0, 1))
+	assert.Equal(t, types.ErrLessTimeHasPassed, execute(bp, `{"Name":"v1setCommission","Args":["500"]}`, 0, 2))
+	assert.NoError(t, execute(delegator1, delegatePayload, 300, 1))
+	assert.NoError(t, execute(delegator2, delegatePayload, 100, 1))
+	assert.Equal(t, big.NewInt(700), delegator1.Balance())
+
+	// 10% of reward is commission, and the rest is distributed by share
+	commission, err := DistributeReward(scs, bp.ID(), big.NewInt(1000))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), commission)
+	d, err := GetDelegation(scs, delegator1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(300), d.GetAmountBigInt())
+	assert.Equal(t, big.NewInt(675), d.GetRewardBigInt())
+	assert.Equal(t, uint32(1000), d.GetCommission())
+
+	receiver.AddBalance(big.NewInt(900))
+	assert.NoError(t, execute(delegator1, `{"Name":"v1claim"}`, 0, 2))
+	assert.Equal(t, big.NewInt(1375), delegator1.Balance())
+	assert.Equal(t, types.ErrNoReward, execute(delegator1, `{"Name":"v1claim"}`, 0, 2))
+
+	assert.Equal(t, types.ErrLessTimeHasPassed, execute(delegator2, `{"Name":"v1undelegate"}`, 100, 2))
+	assert.NoError(t, execute(delegator2, `{"Name":"v1undelegate"}`, 100, StakingDelay+1))
+	assert.Equal(t, big.NewInt(900), delegator2.Balance())
+	assert.Equal(t, types.ErrNothingToWithdraw, execute(delegator2, `{"Name":"v1withdraw"}`, 0, StakingDelay+2))
+	assert.NoError(t, execute(delegator2, `{"Name":"v1withdraw"}`, 0, StakingDelay+1+UnbondingPeriod))
+	assert.Equal(t, big.NewInt(1000), delegator2.Balance())
+
+	// reward after undelegation goes to remaining delegator
+	commission, err = DistributeReward(scs, bp.ID(), big.NewInt(1000))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), commission)
+	d, _ = GetDelegation(scs, delegator1.ID())
+	assert.Equal(t, big.NewInt(900), d.GetRewardBigInt())
+	d, _ = GetDelegation(scs, delegator2.ID())
+	assert.Equal(t, big.NewInt(0), d.GetAmountBigInt())
+	assert.Equal(t, big.NewInt(225), d.GetRewardBigInt())
+
+	// bp without delegators takes all reward
+	commission, err = DistributeReward(scs, delegator2.ID(), big.NewInt(1000))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), commission)
+}
+
+func TestDelegationFork(t *testing.T) {
+	scs, bp, receiver := initTest(t)
+	defer deinitTest()
+	types.InitForks(types.ForkSchedule{types.ForkUnbonding: 0, types.ForkDelegation: 10})
+
+	for _, payload := range []string{
+		`{"Name":"v1setCommission","Args":["1000"]}`,
+		`{"Name":"v1delegate","Args":["AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL"]}`,
+		`{"Name":"v1undelegate"}`,
+		`{"Name":"v1claim"}`,
+	} {
+		tx := &types.TxBody{Account: bp.ID(), Recipient: []byte(types.AergoSystem), Payload: []byte(payload)}
+		_, err := ExecuteSystemTx(scs, tx, bp, receiver, 9)
+		assert.Equal(t, types.ErrTxInvalidPayload, err, payload)
+	}
+	tx := &types.TxBody{Account: bp.ID(), Recipient: []byte(types.AergoSystem),
+		Payload: []byte(`{"Name":"v1setCommission","Args":["1000"]}`)}
+	_, err := ExecuteSystemTx(scs, tx, bp, receiver, 10)
+	assert.NoError(t, err)
+}
+
+func TestDelegationSerialize(t *testing.T) {
+	d := &delegation{
+		Bp:         []byte("bp"),
+		Amount:     big.NewInt(100),
+		RewardDebt: big.NewInt(5),
+		Reward:     big.NewInt(3),
+		When:       10,
+	}
+	assert.Equal(t, d, deserializeDelegation(serializeDelegation(d)))
+	pool := &bpPool{Commission: 500, When: 3, Total: big.NewInt(100), AccReward: big.NewInt(7)}
+	assert.Equal(t, pool, deserializeBpPool(serializeBpPool(pool)))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
+	peer "github.com/libp2p/go-libp2p-peer"
 )
 
 type SystemContext struct {
@@ -20,6 +21,21 @@ type SystemContext struct {
 	Vote     *types.Vote
 	Sender   *state.V
 	Receiver *state.V
+
+	Bp         []byte
+	Delegation *delegation
+	Pool       *bpPool
+	PrevPool   *bpPool
+
+	Unbonding unbondingQueue
+	Evidence  *evidence
+
+	Proposal *types.Proposal
+	Pending  []uint64
+
+	Allowlist []peer.ID
+	Approvals []peer.ID
+	Quorum    int
 }
 
 func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
@@ -39,6 +55,44 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 		event, err = voting(txBody, sender, receiver, scs, blockNo, context)
 	case types.Unstake:
 		event, err = unstaking(txBody, sender, receiver, scs, blockNo, context)
+	case types.SetCommission:
+		if !types.IsForkActive(types.ForkDelegation, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		event, err = setCommission(txBody, sender, receiver, scs, blockNo, context)
+	case types.Delegate:
+		if !types.IsForkActive(types.ForkDelegation, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		event, err = delegate(txBody, sender, receiver, scs, blockNo, context)
+	case types.Undelegate:
+		if !types.IsForkActive(types.ForkDelegation, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		event, err = undelegate(txBody, sender, receiver, scs, blockNo, context)
+	case types.Claim:
+		if !types.IsForkActive(types.ForkDelegation, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		event, err = claim(txBody, sender, receiver, scs, blockNo, context)
+	case types.Withdraw:
+		event, err = withdraw(txBody, sender, receiver, scs, blockNo, context)
+	case types.Slash:
+		event, err = slash(txBody, sender, receiver, scs, blockNo, context)
+	case types.Propose:
+		if !types.IsForkActive(types.ForkGovernance, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		event, err = propose(txBody, sender, receiver, scs, blockNo, context)
+	case types.VoteProposal:
+		if !types.IsForkActive(types.ForkGovernance, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		event, err = voteProposal(txBody, sender, receiver, scs, blockNo, context)
+	case types.AddAllowedPeer:
+		event, err = addAllowedPeer(txBody, sender, receiver, scs, blockNo, context)
+	case types.RemoveAllowedPeer:
+		event, err = removeAllowedPeer(txBody, sender, receiver, scs, blockNo, context)
 	default:
 		err = types.ErrTxInvalidPayload
 	}
@@ -51,6 +105,11 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 }
 
 func GetNamePrice(scs *state.ContractState) *big.Int {
+	if price, err := GetParam(scs, ParamNamePrice); err != nil {
+		panic("could not get name price parameter")
+	} else if price != nil {
+		return price
+	}
 	votelist, err := getVoteResult(scs, []byte(types.VoteNamePrice[2:]), 1)
 	if err != nil {
 		panic("could not get vote result for min staking")
@@ -62,6 +121,11 @@ func GetNamePrice(scs *state.ContractState) *big.Int {
 }
 
 func GetMinimumStaking(scs *state.ContractState) *big.Int {
+	if minimum, err := GetParam(scs, ParamMinStaking); err != nil {
+		panic("could not get min staking parameter")
+	} else if minimum != nil {
+		return minimum
+	}
 	votelist, err := getVoteResult(scs, []byte(types.VoteMinStaking[2:]), 1)
 	if err != nil {
 		panic("could not get vote result for min staking")
@@ -117,6 +181,69 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 			return nil, err
 		}
 		context.Staked = staked
+		if err := validateForUnbonding(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.SetCommission:
+		if err := validateForSetCommission(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.Delegate:
+		if sender != nil && sender.Balance().Cmp(txBody.GetAmountBigInt()) < 0 {
+			return nil, types.ErrInsufficientBalance
+		}
+		if err := validateForDelegation(account, txBody, scs, context); err != nil {
+			return nil, err
+		}
+	case types.Undelegate:
+		if err := validateForUndelegation(account, txBody, scs, context); err != nil {
+			return nil, err
+		}
+		if err := validateForUnbonding(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.Claim:
+		if err := validateForClaim(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.Withdraw:
+		if !types.IsForkActive(types.ForkUnbonding, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		if err := validateForWithdraw(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.Slash:
+		if !types.IsForkActive(types.ForkUnbonding, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		if err := validateForSlash(scs, context); err != nil {
+			return nil, err
+		}
+	case types.Propose:
+		if err := types.ValidateSystemTx(txBody); err != nil {
+			return nil, err
+		}
+		if err := validateForPropose(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.VoteProposal:
+		if err := types.ValidateSystemTx(txBody); err != nil {
+			return nil, err
+		}
+		if err := validateForVoteProposal(account, scs, context); err != nil {
+			return nil, err
+		}
+	case types.AddAllowedPeer, types.RemoveAllowedPeer:
+		if !types.IsForkActive(types.ForkAllowlist, blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		if err := types.ValidateSystemTx(txBody); err != nil {
+			return nil, err
+		}
+		if err := validateForAllowlist(account, scs, context); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, types.ErrTxInvalidPayload
 	}
@@ -152,6 +279,13 @@ func validateForUnstaking(account []byte, txBody *types.TxBody, scs *state.Contr
 	if staked.GetWhen()+StakingDelay > blockNo {
 		return nil, types.ErrLessTimeHasPassed
 	}
+	locked, err := stakingLockedUntil(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if locked > blockNo {
+		return nil, types.ErrStakingLocked
+	}
 	toBe := new(big.Int).Sub(staked.GetAmountBigInt(), txBody.GetAmountBigInt())
 	if toBe.Cmp(big.NewInt(0)) != 0 && GetMinimumStaking(scs).Cmp(toBe) > 0 {
 		return nil, types.ErrTooSmallAmount
@@ -49,10 +49,19 @@ func TestBasicExecute(t *testing.T) {
 	tx.Body.Amount = types.StakingMinimum.Bytes()
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay+StakingDelay)
 	assert.NoError(t, err, "Execute system tx failed in unstaking")
-	assert.Equal(t, types.StakingMinimum.Bytes(), sender.Balance().Bytes(),
-		"sender.Balance() should be turn back")
+	assert.Equal(t, uint64(0), sender.Balance().Uint64(), "sender.Balance() should be 0 while unbonding")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
 	assert.Equal(t, big.NewInt(0), new(big.Int).SetBytes(staking.Amount), "check amount of staking")
+
+	tx.Body.Payload = []byte(`{"Name":"v1withdraw"}`)
+	tx.Body.Amount = big.NewInt(0).Bytes()
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay+StakingDelay+UnbondingPeriod-1)
+	assert.EqualError(t, err, types.ErrNothingToWithdraw.Error(), "withdraw before unbonding period")
+	events, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay+StakingDelay+UnbondingPeriod)
+	assert.NoError(t, err, "Execute system tx failed in withdrawing")
+	assert.Equal(t, events[0].EventName, types.Withdraw[2:], "check event")
+	assert.Equal(t, types.StakingMinimum.Bytes(), sender.Balance().Bytes(),
+		"sender.Balance() should be turn back")
 }
 
 func TestBalanceExecute(t *testing.T) {
@@ -119,26 +128,26 @@ func TestBalanceExecute(t *testing.T) {
 	tx.Body.Amount = types.StakingMinimum.Bytes()
 	blockNo += StakingDelay
 	//unstaking 3-1 = 2
-	//balance 0+1 = 1
+	//unbonding 0+1 = 1
 	//voting still 1
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "Execute system tx failed in unstaking")
-	assert.Equal(t, types.StakingMinimum, new(big.Int).SetBytes(sender.Balance().Bytes()), "sender.Balance() should be turn back")
+	assert.Equal(t, big.NewInt(0), sender.Balance(), "sender.Balance() should not be changed while unbonding")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
 	assert.Equal(t, balance2, new(big.Int).SetBytes(staking.Amount), "check amount of staking")
-	assert.Equal(t, balance2, receiver.Balance(), "check amount of staking")
+	assert.Equal(t, balance3, receiver.Balance(), "check amount of staking and unbonding")
 	voteResult, err = getVoteResult(scs, defaultVoteKey, 1)
 	assert.NoError(t, err, "get vote reulst")
 	assert.Equal(t, types.StakingMinimum, new(big.Int).SetBytes(voteResult.Votes[0].Amount), "")
 
 	//unstaking 2-3 = -1(fail)
-	//balance 1
+	//unbonding 1
 	//voting 1
 	tx.Body.Amount = balance3.Bytes()
 	blockNo += StakingDelay
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo)
 	assert.EqualError(t, types.ErrExceedAmount, err.Error(), "should return exceed error")
-	assert.Equal(t, types.StakingMinimum, new(big.Int).SetBytes(sender.Balance().Bytes()), "sender.Balance() should be turn back")
+	assert.Equal(t, big.NewInt(0), sender.Balance(), "sender.Balance() should not be changed")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
 	assert.Equal(t, balance2, new(big.Int).SetBytes(staking.Amount), "check amount of staking")
 	voteResult, err = getVoteResult(scs, defaultVoteKey, 1)
@@ -148,16 +157,34 @@ func TestBalanceExecute(t *testing.T) {
 	tx.Body.Amount = balance2.Bytes()
 	blockNo += StakingDelay
 	//unstaking 2-2 = 0
-	//balance 1+2 = 3
+	//unbonding 1+2 = 3
 	//voting 0
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "Execute system tx failed in unstaking")
-	assert.Equal(t, balance3, new(big.Int).SetBytes(sender.Balance().Bytes()), "sender.Balance() should be turn back")
-	staking, err = getStaking(scs, tx.GetBody().GetAccount())
+	staking, err = GetStaking(scs, tx.GetBody().GetAccount())
+	assert.NoError(t, err, "could not get staking")
 	assert.Equal(t, big.NewInt(0), new(big.Int).SetBytes(staking.Amount), "check amount of staking")
+	assert.Equal(t, balance3, staking.GetUnbondingBigInt(), "check amount of unbonding")
+	assert.Equal(t, blockNo+UnbondingPeriod, staking.GetRelease(), "check release of unbonding")
 	voteResult, err = getVoteResult(scs, defaultVoteKey, 1)
 	assert.NoError(t, err, "get vote reulst")
 	assert.Equal(t, big.NewInt(0), new(big.Int).SetBytes(voteResult.Votes[0].Amount), "")
+
+	//withdraw the first unbonding only
+	//balance 0+1 = 1
+	tx.Body.Payload = []byte(`{"Name":"v1withdraw"}`)
+	tx.Body.Amount = big.NewInt(0).Bytes()
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo+UnbondingPeriod-2*StakingDelay)
+	assert.NoError(t, err, "Execute system tx failed in withdrawing")
+	assert.Equal(t, types.StakingMinimum, sender.Balance(), "sender.Balance() should be turn back")
+
+	//balance 1+2 = 3
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo+UnbondingPeriod)
+	assert.NoError(t, err, "Execute system tx failed in withdrawing")
+	assert.Equal(t, balance3, sender.Balance(), "sender.Balance() should be turn back")
+	assert.Equal(t, big.NewInt(0), receiver.Balance(), "check balance of system")
+	staking, err = GetStaking(scs, tx.GetBody().GetAccount())
+	assert.Nil(t, staking.GetUnbonding(), "unbonding should be cleared")
 }
 
 func TestBasicFailedExecute(t *testing.T) {
@@ -212,13 +239,13 @@ func TestBasicFailedExecute(t *testing.T) {
 	assert.Equal(t, types.StakingMinimum, new(big.Int).SetBytes(staking.Amount), "check amount of staking")
 
 	//staking 1-1 = 0
-	//balance 1+1 = 2
+	//balance still 1 until unbonding ends
 	tx.Body.Amount = types.StakingMinimum.Bytes()
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay+StakingDelay)
 	assert.NoError(t, err, "Execute system tx failed in staking")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
-	assert.Equal(t, senderBalance, sender.Balance(),
-		"sender.Balance() should be turn back")
+	assert.Equal(t, types.StakingMinimum, sender.Balance(),
+		"sender.Balance() should not be changed while unbonding")
 	assert.Equal(t, big.NewInt(0), new(big.Int).SetBytes(staking.Amount), "check amount of staking")
 
 	//staking 0-1 = -1 (fail)
@@ -433,23 +460,23 @@ func TestRemainStakingMinimum(t *testing.T) {
 	assert.EqualError(t, err, types.ErrLessTimeHasPassed.Error(), "check error")
 
 	blockNo += 1
-	//balance 1+0.5 =1.5
+	//unbonding 0+0.5 =0.5
 	//staking 2-0.5 =1.5
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "could not execute system tx")
 	staked, err := getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
-	assert.Equal(t, balance1_5, sender.Balance(), "could not get staking")
+	assert.Equal(t, balance1, sender.Balance(), "unstaked aergo should be unbonding")
 	assert.Equal(t, balance1_5, staked.GetAmountBigInt(), "could not get staking")
 
 	blockNo += StakingDelay
-	//balance 1.5+0.5 =2
+	//unbonding 0.5+0.5 =1
 	//staking 1.5-0.5 =1
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "could not execute system tx")
 	staked, err = getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
-	assert.Equal(t, balance2, sender.Balance(), "could not get staking")
+	assert.Equal(t, balance1, sender.Balance(), "unstaked aergo should be unbonding")
 	assert.Equal(t, balance1, staked.GetAmountBigInt(), "could not get staking")
 
 	blockNo += StakingDelay
@@ -458,18 +485,18 @@ func TestRemainStakingMinimum(t *testing.T) {
 	assert.EqualError(t, err, types.ErrTooSmallAmount.Error(), "staked aergo remain 0.5")
 	staked, err = getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
-	assert.Equal(t, balance2, sender.Balance(), "could not get staking")
+	assert.Equal(t, balance1, sender.Balance(), "unstaked aergo should be unbonding")
 	assert.Equal(t, balance1, staked.GetAmountBigInt(), "could not get staking")
 
 	blockNo += StakingDelay
 	unStakingTx.Body.Amount = balance1.Bytes()
-	//balance 2+1 =3
+	//unbonding 1+1 =2
 	//staking 1-1 =0
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "could not execute system tx")
 	staked, err = getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
-	assert.Equal(t, balance3, sender.Balance(), "could not get staking")
+	assert.Equal(t, balance1, sender.Balance(), "unstaked aergo should be unbonding")
 	assert.Equal(t, big.NewInt(0), staked.GetAmountBigInt(), "could not get staking")
 
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
@@ -0,0 +1,104 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var paramKey = []byte("param")
+
+// Parameters which can be changed by a proposal
+const (
+	ParamBpCount      = "bpcount"
+	ParamMaxBlockSize = "maxblocksize"
+	ParamBaseTxFee    = "basetxfee"
+	ParamAerPerByte   = "aerperbyte"
+	ParamNamePrice    = "nameprice"
+	ParamMinStaking   = "minstaking"
+)
+
+// parameter is the allowed range of a parameter value. A nil bound means no limit.
+type parameter struct {
+	min, max *big.Int
+}
+
+var aergo = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+var parameters = map[string]*parameter{
+	ParamBpCount:      {min: big.NewInt(1), max: big.NewInt(100)},
+	ParamMaxBlockSize: {min: big.NewInt(1 << 16), max: big.NewInt(1 << 23)},
+	ParamBaseTxFee:    {min: big.NewInt(0), max: aergo},
+	ParamAerPerByte:   {min: big.NewInt(0), max: new(big.Int).Div(aergo, big.NewInt(1000))},
+	ParamNamePrice:    {min: big.NewInt(0)},
+	ParamMinStaking:   {min: aergo},
+}
+
+// ParamNames returns the names of parameters which can be changed by a proposal.
+func ParamNames() []string {
+	return []string{ParamBpCount, ParamMaxBlockSize, ParamBaseTxFee, ParamAerPerByte, ParamNamePrice,
+		ParamMinStaking}
+}
+
+func validateParam(name string, value *big.Int) error {
+	p, exist := parameters[name]
+	if !exist {
+		return types.ErrUnknownParameter
+	}
+	if (p.min != nil && value.Cmp(p.min) < 0) || (p.max != nil && value.Cmp(p.max) > 0) {
+		return types.ErrInvalidParameterValue
+	}
+	return nil
+}
+
+// GetParam returns the value of a parameter applied by a proposal, or nil if it has never been changed.
+func GetParam(scs *state.ContractState, name string) (*big.Int, error) {
+	data, err := scs.GetData(append(paramKey, name...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// GetParams returns the values of all parameters changed by proposals.
+func GetParams(scs *state.ContractState) (map[string]*big.Int, error) {
+	params := make(map[string]*big.Int)
+	for _, name := range ParamNames() {
+		value, err := GetParam(scs, name)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			params[name] = value
+		}
+	}
+	return params, nil
+}
+
+func setParam(scs *state.ContractState, name string, value *big.Int) error {
+	// keep the zero value distinguishable from an unchanged parameter
+	data := value.Bytes()
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+	return scs.SetData(append(paramKey, name...), data)
+}
+
+func getBpCount(scs *state.ContractState) (int, error) {
+	value, err := GetParam(scs, ParamBpCount)
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		return getDefaultBpCount(), nil
+	}
+	return int(value.Int64()), nil
+}
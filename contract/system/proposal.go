@@ -0,0 +1,347 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+var proposalKey = []byte("proposal")
+var proposalVoteKey = []byte("proposalvote")
+var proposalSerialKey = []byte("proposalserial")
+var pendingProposalKey = []byte("proposalpending")
+var proposalLockKey = []byte("proposallock")
+
+// ProposalVotingPeriod is the number of blocks in which a proposal can be voted. The staking of voter is locked
+// until the end of voting, so that it can't be unstaked and staked again by another account to vote twice for the
+// same proposal.
+const ProposalVotingPeriod = 60 * 60 * 24 * 3 //block interval
+//const ProposalVotingPeriod = 10
+
+// ProposalApplyDelay is the interval between the end of voting and the application of a passed proposal.
+const ProposalApplyDelay = 60 * 60 * 24 //block interval
+
+// ProposalQuorum is the percentage of total staking which must participate in voting for a proposal to pass
+const ProposalQuorum = 10
+
+// MaxPendingProposals is the maximum number of proposals which are not applied or rejected yet
+const MaxPendingProposals = 16
+
+func propose(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	p := context.Proposal
+	id, err := nextProposalID(scs)
+	if err != nil {
+		return nil, err
+	}
+	p.Id = id
+	p.Proposer = sender.ID()
+	p.Start = blockNo
+	p.End = blockNo + ProposalVotingPeriod
+	p.Apply = p.End + ProposalApplyDelay
+	p.Status = types.ProposalVoting
+	if err := setProposal(scs, p); err != nil {
+		return nil, err
+	}
+	if err := setPendingProposals(scs, append(context.Pending, id)); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "propose",
+		JsonArgs: `{"id":` + strconv.FormatUint(id, 10) +
+			`, "who":"` + types.EncodeAddress(sender.ID()) +
+			`", "param":"` + p.Param +
+			`", "value":"` + p.GetValueBigInt().String() +
+			`", "end":` + strconv.FormatUint(p.End, 10) +
+			`, "apply":` + strconv.FormatUint(p.Apply, 10) + `}`,
+	}, nil
+}
+
+func voteProposal(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	p, choice := context.Proposal, context.Args[1]
+	amount := context.Staked.GetAmountBigInt()
+	if choice == types.ProposalYes {
+		p.Yes = new(big.Int).Add(p.GetYesBigInt(), amount).Bytes()
+	} else {
+		p.No = new(big.Int).Add(p.GetNoBigInt(), amount).Bytes()
+	}
+	if err := setProposal(scs, p); err != nil {
+		return nil, err
+	}
+	if err := scs.SetData(proposalVoteKeyOf(p.Id, sender.ID()), []byte(choice)); err != nil {
+		return nil, err
+	}
+	if err := lockStaking(scs, sender.ID(), p.End); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "voteProposal",
+		JsonArgs: `{"id":` + strconv.FormatUint(p.Id, 10) +
+			`, "who":"` + types.EncodeAddress(sender.ID()) +
+			`", "choice":"` + choice +
+			`", "amount":"` + amount.String() + `"}`,
+	}, nil
+}
+
+func validateForPropose(account []byte, scs *state.ContractState, context *SystemContext) error {
+	name := context.Call.Args[0].(string)
+	value, _ := new(big.Int).SetString(context.Call.Args[1].(string), 10)
+	if err := validateParam(name, value); err != nil {
+		return err
+	}
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return err
+	}
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return types.ErrMustStakeBeforeVote
+	}
+	pending, err := getPendingProposals(scs)
+	if err != nil {
+		return err
+	}
+	if len(pending) >= MaxPendingProposals {
+		return types.ErrTooManyProposals
+	}
+	context.Staked = staked
+	context.Pending = pending
+	context.Proposal = &types.Proposal{Param: name, Value: value.Bytes()}
+	return nil
+}
+
+func validateForVoteProposal(account []byte, scs *state.ContractState, context *SystemContext) error {
+	id, _ := strconv.ParseUint(context.Call.Args[0].(string), 10, 64)
+	p, err := GetProposal(scs, id)
+	if err != nil {
+		return err
+	}
+	if p.Status != types.ProposalVoting || context.BlockNo >= p.End {
+		return types.ErrProposalClosed
+	}
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return err
+	}
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return types.ErrMustStakeBeforeVote
+	}
+	voted, err := scs.GetData(proposalVoteKeyOf(id, account))
+	if err != nil {
+		return err
+	}
+	if len(voted) != 0 {
+		return types.ErrAlreadyVoted
+	}
+	context.Staked = staked
+	context.Proposal = p
+	context.Args = []string{context.Call.Args[0].(string), context.Call.Args[1].(string)}
+	return nil
+}
+
+// ApplyProposals closes the voting of proposals which ended by blockNo, and applies the parameters of passed
+// proposals of which application block has come. It returns the applied proposals.
+func ApplyProposals(scs *state.ContractState, blockNo types.BlockNo) ([]*types.Proposal, error) {
+	pending, err := getPendingProposals(scs)
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+	var applied []*types.Proposal
+	remains := make([]uint64, 0, len(pending))
+	for _, id := range pending {
+		p, err := GetProposal(scs, id)
+		if err != nil {
+			return nil, err
+		}
+		status := p.Status
+		if status == types.ProposalVoting && blockNo >= p.End {
+			if status, err = tally(scs, p); err != nil {
+				return nil, err
+			}
+		}
+		if status == types.ProposalPassed && blockNo >= p.Apply {
+			if err := setParam(scs, p.Param, p.GetValueBigInt()); err != nil {
+				return nil, err
+			}
+			status = types.ProposalApplied
+			applied = append(applied, p)
+		}
+		if status != p.Status {
+			p.Status = status
+			if err := setProposal(scs, p); err != nil {
+				return nil, err
+			}
+		}
+		if status == types.ProposalVoting || status == types.ProposalPassed {
+			remains = append(remains, id)
+		}
+	}
+	if len(remains) != len(pending) {
+		if err := setPendingProposals(scs, remains); err != nil {
+			return nil, err
+		}
+	}
+	return applied, nil
+}
+
+// AppliedEvent returns the event of the application of p, which is emitted at the end of block.
+func AppliedEvent(p *types.Proposal, idx int32) *types.Event {
+	return &types.Event{
+		ContractAddress: []byte(types.AergoSystem),
+		EventIdx:        idx,
+		EventName:       "applyProposal",
+		JsonArgs: `{"id":` + strconv.FormatUint(p.Id, 10) +
+			`, "param":"` + p.Param +
+			`", "value":"` + p.GetValueBigInt().String() + `"}`,
+	}
+}
+
+// tally decides whether p is passed. It needs the participation of ProposalQuorum percent of the total staking
+// and more yes than no.
+func tally(scs *state.ContractState, p *types.Proposal) (string, error) {
+	total, err := GetStakingTotal(scs)
+	if err != nil {
+		return "", err
+	}
+	yes, no := p.GetYesBigInt(), p.GetNoBigInt()
+	participation := new(big.Int).Add(yes, no)
+	quorum := new(big.Int).Mul(total, big.NewInt(ProposalQuorum))
+	quorum.Div(quorum, big.NewInt(100))
+	if participation.Sign() > 0 && participation.Cmp(quorum) >= 0 && yes.Cmp(no) > 0 {
+		return types.ProposalPassed, nil
+	}
+	return types.ProposalRejected, nil
+}
+
+// GetProposal returns the proposal of id.
+func GetProposal(scs *state.ContractState, id uint64) (*types.Proposal, error) {
+	data, err := scs.GetData(proposalKeyOf(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, types.ErrProposalNotFound
+	}
+	var p types.Proposal
+	if err := proto.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListProposals returns at most size proposals skipping offset ones, in the order of id. The latest proposal
+// comes first unless asc is set.
+func ListProposals(scs *state.ContractState, size, offset uint32, asc bool) (*types.ProposalList, error) {
+	last, err := getProposalSerial(scs)
+	if err != nil {
+		return nil, err
+	}
+	list := &types.ProposalList{}
+	for i := uint64(offset); i < last && uint32(len(list.Proposals)) < size; i++ {
+		id := last - i
+		if asc {
+			id = i + 1
+		}
+		p, err := GetProposal(scs, id)
+		if err != nil {
+			return nil, err
+		}
+		list.Proposals = append(list.Proposals, p)
+	}
+	return list, nil
+}
+
+func setProposal(scs *state.ContractState, p *types.Proposal) error {
+	data, err := proto.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(proposalKeyOf(p.Id), data)
+}
+
+func nextProposalID(scs *state.ContractState) (uint64, error) {
+	last, err := getProposalSerial(scs)
+	if err != nil {
+		return 0, err
+	}
+	serial := make([]byte, 8)
+	binary.LittleEndian.PutUint64(serial, last+1)
+	return last + 1, scs.SetData(proposalSerialKey, serial)
+}
+
+func getProposalSerial(scs *state.ContractState) (uint64, error) {
+	data, err := scs.GetData(proposalSerialKey)
+	if err != nil || len(data) == 0 {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+func getPendingProposals(scs *state.ContractState) ([]uint64, error) {
+	data, err := scs.GetData(pendingProposalKey)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]uint64, 0, len(data)/8)
+	for i := 0; i+8 <= len(data); i += 8 {
+		pending = append(pending, binary.LittleEndian.Uint64(data[i:]))
+	}
+	return pending, nil
+}
+
+func setPendingProposals(scs *state.ContractState, pending []uint64) error {
+	if len(pending) == 0 {
+		return scs.DeleteData(pendingProposalKey)
+	}
+	data := make([]byte, 8*len(pending))
+	for i, id := range pending {
+		binary.LittleEndian.PutUint64(data[8*i:], id)
+	}
+	return scs.SetData(pendingProposalKey, data)
+}
+
+// lockStaking keeps the staking of voter from being unstaked until the block end.
+func lockStaking(scs *state.ContractState, voter []byte, end types.BlockNo) error {
+	locked, err := stakingLockedUntil(scs, voter)
+	if err != nil || locked >= end {
+		return err
+	}
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, end)
+	return scs.SetData(append(proposalLockKey, voter...), data)
+}
+
+// stakingLockedUntil returns the block until which the staking of who is locked by voting for proposals.
+func stakingLockedUntil(scs *state.ContractState, who []byte) (types.BlockNo, error) {
+	data, err := scs.GetData(append(proposalLockKey, who...))
+	if err != nil || len(data) == 0 {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+func proposalKeyOf(id uint64) []byte {
+	key := make([]byte, len(proposalKey)+8)
+	copy(key, proposalKey)
+	binary.LittleEndian.PutUint64(key[len(proposalKey):], id)
+	return key
+}
+
+func proposalVoteKeyOf(id uint64, voter []byte) []byte {
+	key := make([]byte, len(proposalVoteKey)+8, len(proposalVoteKey)+8+len(voter))
+	copy(key, proposalVoteKey)
+	binary.LittleEndian.PutUint64(key[len(proposalVoteKey):], id)
+	return append(key, voter...)
+}
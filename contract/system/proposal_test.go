@@ -0,0 +1,203 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposal(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	voter, err := sdb.GetAccountStateV([]byte("voter"))
+	assert.NoError(t, err)
+	other, err := sdb.GetAccountStateV([]byte("other"))
+	assert.NoError(t, err)
+	stake := types.StakingMinimum
+	for _, v := range []*state.V{sender, voter, other} {
+		v.AddBalance(stake)
+	}
+
+	execute := func(v *state.V, payload string, amount *big.Int, blockNo types.BlockNo) ([]*types.Event, error) {
+		tx := &types.TxBody{
+			Account:   v.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    amount.Bytes(),
+			Payload:   []byte(payload),
+		}
+		return ExecuteSystemTx(scs, tx, v, receiver, blockNo)
+	}
+	zero := big.NewInt(0)
+	price := `{"Name":"v1propose","Args":["nameprice","5"]}`
+
+	_, err = execute(sender, price, zero, 1)
+	assert.Equal(t, types.ErrMustStakeBeforeVote, err, "propose without staking")
+	for _, v := range []*state.V{sender, voter, other} {
+		_, err = execute(v, `{"Name":"v1stake"}`, stake, 1)
+		assert.NoError(t, err)
+	}
+
+	_, err = execute(sender, `{"Name":"v1propose","Args":["unknown","5"]}`, zero, 2)
+	assert.Equal(t, types.ErrUnknownParameter, err)
+	_, err = execute(sender, `{"Name":"v1propose","Args":["bpcount","0"]}`, zero, 2)
+	assert.Equal(t, types.ErrInvalidParameterValue, err)
+	_, err = execute(sender, `{"Name":"v1propose","Args":["bpcount","five"]}`, zero, 2)
+	assert.Equal(t, types.ErrTxInvalidPayload, err)
+
+	events, err := execute(sender, price, zero, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "propose", events[0].EventName)
+	_, err = execute(sender, `{"Name":"v1propose","Args":["bpcount","3"]}`, zero, 2)
+	assert.NoError(t, err)
+
+	p, err := GetProposal(scs, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, ParamNamePrice, p.GetParam())
+	assert.Equal(t, types.ProposalVoting, p.GetStatus())
+	assert.Equal(t, uint64(2+ProposalVotingPeriod), p.GetEnd())
+	assert.Equal(t, p.GetEnd()+ProposalApplyDelay, p.GetApply())
+	_, err = GetProposal(scs, 3)
+	assert.Equal(t, types.ErrProposalNotFound, err)
+
+	// the first proposal passes and the second one is rejected
+	_, err = execute(sender, `{"Name":"v1voteProposal","Args":["1","yes"]}`, zero, 3)
+	assert.NoError(t, err)
+	_, err = execute(sender, `{"Name":"v1voteProposal","Args":["1","no"]}`, zero, 3)
+	assert.Equal(t, types.ErrAlreadyVoted, err)
+	_, err = execute(voter, `{"Name":"v1voteProposal","Args":["1","yes"]}`, zero, 3)
+	assert.NoError(t, err)
+	_, err = execute(other, `{"Name":"v1voteProposal","Args":["1","no"]}`, zero, 3)
+	assert.NoError(t, err)
+	_, err = execute(sender, `{"Name":"v1voteProposal","Args":["2","no"]}`, zero, 3)
+	assert.NoError(t, err)
+	_, err = execute(voter, `{"Name":"v1voteProposal","Args":["2","maybe"]}`, zero, 3)
+	assert.Equal(t, types.ErrTxInvalidPayload, err)
+	_, err = execute(voter, `{"Name":"v1voteProposal","Args":["3","yes"]}`, zero, 3)
+	assert.Equal(t, types.ErrProposalNotFound, err)
+	_, err = execute(other, `{"Name":"v1voteProposal","Args":["2","yes"]}`, zero, p.GetEnd())
+	assert.Equal(t, types.ErrProposalClosed, err)
+
+	// the staking of voter can't be unstaked to vote again with another account until the end of voting
+	_, err = execute(voter, `{"Name":"v1unstake"}`, stake, p.GetEnd()-1)
+	assert.Equal(t, types.ErrStakingLocked, err)
+	_, err = execute(voter, `{"Name":"v1unstake"}`, stake, p.GetEnd())
+	assert.NoError(t, err)
+
+	applied, err := ApplyProposals(scs, p.GetEnd())
+	assert.NoError(t, err)
+	assert.Empty(t, applied, "not applied before the apply block")
+	p, err = GetProposal(scs, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ProposalPassed, p.GetStatus())
+	assert.Equal(t, new(big.Int).Mul(stake, big.NewInt(2)), p.GetYesBigInt())
+	assert.Equal(t, stake, p.GetNoBigInt())
+	rejected, err := GetProposal(scs, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ProposalRejected, rejected.GetStatus())
+	assert.Equal(t, types.NamePrice, GetNamePrice(scs))
+
+	applied, err = ApplyProposals(scs, p.GetApply())
+	assert.NoError(t, err)
+	assert.Len(t, applied, 1)
+	assert.Equal(t, big.NewInt(5), GetNamePrice(scs))
+	event := AppliedEvent(applied[0], 0)
+	assert.Equal(t, "applyProposal", event.EventName)
+	assert.Contains(t, event.JsonArgs, `"value":"5"`)
+	pending, err := getPendingProposals(scs)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+	bpCount, err := getBpCount(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, getDefaultBpCount(), bpCount, "rejected proposal is not applied")
+
+	list, err := ListProposals(scs, 10, 0, false)
+	assert.NoError(t, err)
+	assert.Len(t, list.GetProposals(), 2)
+	assert.Equal(t, uint64(2), list.GetProposals()[0].GetId())
+	list, err = ListProposals(scs, 1, 1, true)
+	assert.NoError(t, err)
+	assert.Len(t, list.GetProposals(), 1)
+	assert.Equal(t, uint64(2), list.GetProposals()[0].GetId())
+}
+
+func TestProposalQuorum(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	whale, err := sdb.GetAccountStateV([]byte("whale"))
+	assert.NoError(t, err)
+	big10 := big.NewInt(10)
+	whaleStake := new(big.Int).Mul(types.StakingMinimum, big10)
+	whale.AddBalance(whaleStake)
+	sender.AddBalance(types.StakingMinimum)
+
+	execute := func(v *state.V, payload string, amount *big.Int, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   v.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    amount.Bytes(),
+			Payload:   []byte(payload),
+		}
+		_, err := ExecuteSystemTx(scs, tx, v, receiver, blockNo)
+		return err
+	}
+	zero := big.NewInt(0)
+	assert.NoError(t, execute(sender, `{"Name":"v1stake"}`, types.StakingMinimum, 1))
+	assert.NoError(t, execute(whale, `{"Name":"v1stake"}`, whaleStake, 1))
+	assert.NoError(t, execute(sender, `{"Name":"v1propose","Args":["minstaking","20000000000000000000000"]}`, zero, 2))
+	// only 1/11 of the total staking participates
+	assert.NoError(t, execute(sender, `{"Name":"v1voteProposal","Args":["1","yes"]}`, zero, 3))
+
+	p, err := GetProposal(scs, 1)
+	assert.NoError(t, err)
+	_, err = ApplyProposals(scs, p.GetApply())
+	assert.NoError(t, err)
+	p, err = GetProposal(scs, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ProposalRejected, p.GetStatus())
+	assert.Equal(t, types.StakingMinimum, GetMinimumStaking(scs))
+}
+
+func TestProposalFork(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.InitForks(types.ForkSchedule{types.ForkUnbonding: 0, types.ForkGovernance: 10})
+
+	sender.AddBalance(types.StakingMinimum)
+	execute := func(payload string, amount *big.Int, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   sender.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    amount.Bytes(),
+			Payload:   []byte(payload),
+		}
+		_, err := ExecuteSystemTx(scs, tx, sender, receiver, blockNo)
+		return err
+	}
+	zero := big.NewInt(0)
+	assert.NoError(t, execute(`{"Name":"v1stake"}`, types.StakingMinimum, 1))
+	assert.Equal(t, types.ErrTxInvalidPayload, execute(`{"Name":"v1propose","Args":["bpcount","5"]}`, zero, 9))
+	assert.Equal(t, types.ErrTxInvalidPayload, execute(`{"Name":"v1voteProposal","Args":["1","yes"]}`, zero, 9))
+	assert.NoError(t, execute(`{"Name":"v1propose","Args":["bpcount","5"]}`, zero, 10))
+	assert.NoError(t, execute(`{"Name":"v1voteProposal","Args":["1","yes"]}`, zero, 10))
+}
+
+func TestValidateParam(t *testing.T) {
+	assert.NoError(t, validateParam(ParamBpCount, big.NewInt(23)))
+	assert.Equal(t, types.ErrInvalidParameterValue, validateParam(ParamBpCount, big.NewInt(101)))
+	assert.Equal(t, types.ErrInvalidParameterValue, validateParam(ParamMaxBlockSize, big.NewInt(1024)))
+	assert.NoError(t, validateParam(ParamNamePrice, big.NewInt(0)))
+	assert.Equal(t, types.ErrUnknownParameter, validateParam("gasprice", big.NewInt(1)))
+	for _, name := range ParamNames() {
+		_, exist := parameters[name]
+		assert.True(t, exist, name)
+	}
+}
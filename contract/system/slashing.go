@@ -0,0 +1,177 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var slashedKey = []byte("slashed")
+
+// SlashRate is the percentage of the stake burned from a bp which signed two different blocks
+const SlashRate = 10
+
+var chainID []byte
+
+// InitChainID sets the chain id of the genesis block. The evidences of double signing must be the blocks of the
+// same chain.
+//
+// Caution: This function must be called only once before all the aergosvr
+// services start.
+func InitChainID(id []byte) {
+	chainID = id
+}
+
+// Election is implemented by the consensus which elects the bps in turn. The double signing can be slashed only if
+// the members of consensus are an Election.
+type Election interface {
+	Members
+	// Slot returns the index of the slot in which the block of timestamp ts is produced.
+	Slot(ts int64) int64
+}
+
+// evidence of double signing. The self stake of a bp is the staking of the account of its block signing key.
+type evidence struct {
+	Slot    int64
+	BlockNo types.BlockNo
+	Bp      []byte
+}
+
+func slash(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	ev, staked := context.Evidence, context.Staked
+	stakedAmount := staked.GetAmountBigInt()
+	burned := slashAmount(stakedAmount)
+	staked.Amount = new(big.Int).Sub(stakedAmount, burned).Bytes()
+	if err := setStaking(scs, ev.Bp, staked); err != nil {
+		return nil, err
+	}
+	if err := refreshVotes(scs, ev.Bp, staked); err != nil {
+		return nil, err
+	}
+	if err := subTotal(scs, burned); err != nil {
+		return nil, err
+	}
+	// unbonding can't be used to avoid slashing
+	for _, u := range context.Unbonding {
+		cut := slashAmount(u.Amount)
+		u.Amount = new(big.Int).Sub(u.Amount, cut)
+		burned.Add(burned, cut)
+	}
+	if err := setUnbondingQueue(scs, ev.Bp, context.Unbonding); err != nil {
+		return nil, err
+	}
+	if err := scs.SetData(slashedEvidenceKey(ev), []byte(strconv.FormatUint(blockNo, 10))); err != nil {
+		return nil, err
+	}
+	receiver.SubBalance(burned)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "slash",
+		JsonArgs: `{"bp":"` + types.EncodeAddress(ev.Bp) +
+			`", "slot":` + strconv.FormatInt(ev.Slot, 10) +
+			`, "blockNo":` + strconv.FormatUint(ev.BlockNo, 10) +
+			`, "amount":"` + burned.String() + `"}`,
+	}, nil
+}
+
+func slashAmount(amount *big.Int) *big.Int {
+	burned := new(big.Int).Mul(amount, big.NewInt(SlashRate))
+	return burned.Div(burned, big.NewInt(100))
+}
+
+// validateForSlash checks that two block headers in the arguments of the same chain are signed in the same slot by
+// the bp in charge of them.
+func validateForSlash(scs *state.ContractState, context *SystemContext) error {
+	var blocks [2]*types.Block
+	for i, arg := range context.Call.Args {
+		encoded, ok := arg.(string)
+		if !ok || i >= len(blocks) {
+			return types.ErrTxInvalidPayload
+		}
+		header, err := types.DecodeBlockHeader(encoded)
+		if err != nil {
+			return types.ErrTxInvalidPayload
+		}
+		blocks[i] = &types.Block{Header: header}
+	}
+	b1, b2 := blocks[0], blocks[1]
+	if b1 == nil || b2 == nil {
+		return types.ErrTxInvalidPayload
+	}
+	election, ok := members.(Election)
+	if !ok {
+		return types.ErrInvalidEvidence
+	}
+	slot := election.Slot(b1.GetHeader().GetTimestamp())
+	if slot != election.Slot(b2.GetHeader().GetTimestamp()) ||
+		!bytes.Equal(b1.GetHeader().GetPubKey(), b2.GetHeader().GetPubKey()) ||
+		bytes.Equal(b1.BlockHash(), b2.BlockHash()) {
+		return types.ErrInvalidEvidence
+	}
+	id, err := b1.BPID()
+	if err != nil {
+		return types.ErrInvalidEvidence
+	}
+	for _, b := range blocks {
+		if !bytes.Equal(b.GetHeader().GetChainID(), chainID) {
+			return types.ErrInvalidEvidence
+		}
+		if valid, err := b.VerifySign(); err != nil || !valid {
+			return types.ErrInvalidEvidence
+		}
+		if b.BlockNo() >= context.BlockNo {
+			return types.ErrInvalidEvidence
+		}
+		if b.BlockNo()+UnbondingPeriod < context.BlockNo {
+			return types.ErrEvidenceExpired
+		}
+		isBP, err := isBlockProducer(scs, id, b.BlockNo())
+		if err != nil {
+			return err
+		}
+		if !isBP {
+			return types.ErrInvalidEvidence
+		}
+	}
+	addr, err := b1.BPAddress()
+	if err != nil {
+		return types.ErrInvalidEvidence
+	}
+	ev := &evidence{Slot: slot, BlockNo: b1.BlockNo(), Bp: addr}
+	slashed, err := scs.GetData(slashedEvidenceKey(ev))
+	if err != nil {
+		return err
+	}
+	if len(slashed) != 0 {
+		return types.ErrAlreadySlashed
+	}
+	staked, err := getStaking(scs, addr)
+	if err != nil {
+		return err
+	}
+	q, err := getUnbondingQueue(scs, addr)
+	if err != nil {
+		return err
+	}
+	context.Evidence = ev
+	context.Staked = staked
+	context.Unbonding = q
+	return nil
+}
+
+func slashedEvidenceKey(ev *evidence) []byte {
+	slot := make([]byte, 8)
+	binary.LittleEndian.PutUint64(slot, uint64(ev.Slot))
+	key := append(append([]byte{}, slashedKey...), ev.Bp...)
+	return append(key, slot...)
+}
@@ -0,0 +1,109 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+// testElection has the slots of 10ns and the same bps for all the blocks.
+type testElection struct {
+	bps []string
+}
+
+func (e *testElection) Slot(ts int64) int64 {
+	return ts / 10
+}
+
+func (e *testElection) BPs(blockNo types.BlockNo) ([]string, error) {
+	return e.bps, nil
+}
+
+func TestSlashing(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	e := &testElection{}
+	InitMembers(e)
+	defer InitMembers(nil)
+
+	priv, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	assert.NoError(t, err)
+	signedHeader := func(blockNo types.BlockNo, ts int64, chainID ...byte) string {
+		block := &types.Block{Header: &types.BlockHeader{BlockNo: blockNo, Timestamp: ts, ChainID: chainID}}
+		assert.NoError(t, block.Sign(priv))
+		encoded, err := types.EncodeBlockHeader(block.GetHeader())
+		assert.NoError(t, err)
+		return encoded
+	}
+	h1, h2 := signedHeader(10, 1), signedHeader(10, 2)
+
+	// the bp key is also the account of self stake
+	b := &types.Block{Header: &types.BlockHeader{}}
+	assert.NoError(t, b.Sign(priv))
+	addr, err := b.BPAddress()
+	assert.NoError(t, err)
+	bp, err := sdb.GetAccountStateV(addr)
+	assert.NoError(t, err)
+	stake := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	bp.AddBalance(stake)
+
+	execute := func(v *state.V, payload string, amount *big.Int, blockNo types.BlockNo) error {
+		tx := &types.TxBody{
+			Account:   v.ID(),
+			Recipient: []byte(types.AergoSystem),
+			Amount:    amount.Bytes(),
+			Payload:   []byte(payload),
+		}
+		_, err := ExecuteSystemTx(scs, tx, v, receiver, blockNo)
+		return err
+	}
+	assert.NoError(t, execute(bp, `{"Name":"v1stake"}`, stake, 1))
+	assert.NoError(t, execute(bp, `{"Name":"v1unstake"}`, types.StakingMinimum, StakingDelay+1))
+
+	slashPayload := func(h1, h2 string) string {
+		return `{"Name":"v1slash","Args":["` + h1 + `","` + h2 + `"]}`
+	}
+	zero := big.NewInt(0)
+	assert.Equal(t, types.ErrInvalidEvidence, execute(sender, slashPayload(h1, h2), zero, StakingDelay+2), "not a bp")
+
+	id, err := peer.IDFromPublicKey(priv.GetPublic())
+	assert.NoError(t, err)
+	e.bps = []string{enc.ToString([]byte(id))}
+
+	assert.Equal(t, types.ErrInvalidEvidence, execute(sender, slashPayload(h1, h1), zero, StakingDelay+2), "same block")
+	assert.Equal(t, types.ErrInvalidEvidence,
+		execute(sender, slashPayload(h1, signedHeader(10, 12)), zero, StakingDelay+2), "different slot")
+	assert.Equal(t, types.ErrInvalidEvidence,
+		execute(sender, slashPayload(h1, signedHeader(10, 2, 1)), zero, StakingDelay+2), "different chain")
+	assert.Equal(t, types.ErrEvidenceExpired,
+		execute(sender, slashPayload(h1, h2), zero, 10+UnbondingPeriod+1), "too old evidence")
+
+	balance := new(big.Int).Set(receiver.Balance())
+	assert.NoError(t, execute(sender, slashPayload(h1, h2), zero, StakingDelay+2))
+	assert.Equal(t, types.ErrAlreadySlashed, execute(sender, slashPayload(h2, h1), zero, StakingDelay+3))
+
+	// 10% of both staking and unbonding is burned
+	burned := new(big.Int).Div(types.StakingMinimum, big.NewInt(10))
+	remains := new(big.Int).Sub(types.StakingMinimum, burned)
+	staking, err := GetStaking(scs, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, remains, staking.GetAmountBigInt())
+	assert.Equal(t, remains, staking.GetUnbondingBigInt())
+	total, err := GetStakingTotal(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, remains, total)
+	assert.Equal(t, new(big.Int).Sub(balance, new(big.Int).Mul(burned, big.NewInt(2))), receiver.Balance())
+
+	assert.NoError(t, execute(bp, `{"Name":"v1withdraw"}`, zero, StakingDelay+1+UnbondingPeriod))
+	assert.Equal(t, remains, bp.Balance())
+}
@@ -69,18 +69,35 @@ func unstaking(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contr
 	if err := subTotal(scs, backToBalance); err != nil {
 		return nil, err
 	}
-	sender.AddBalance(backToBalance)
-	receiver.SubBalance(backToBalance)
+	//unstaked amount is withdrawable after the unbonding period
+	release, err := unbond(scs, sender, receiver, context.Unbonding, backToBalance, blockNo)
+	if err != nil {
+		return nil, err
+	}
 	return &types.Event{
 		ContractAddress: receiver.ID(),
 		EventIdx:        0,
 		EventName:       "unstake",
 		JsonArgs: `{"who":"` +
 			types.EncodeAddress(sender.ID()) +
-			`", "amount":"` + txBody.GetAmountBigInt().String() + `"}`,
+			`", "amount":"` + txBody.GetAmountBigInt().String() + `"` + releaseJson(release) + `}`,
 	}, nil
 }
 
+// InitGenesisStakes sets the stakings of genesis block, which are keyed by the
+// raw addresses. The staked amounts must be held by the system account.
+func InitGenesisStakes(scs *state.ContractState, stakes map[string]*big.Int) error {
+	for who, amount := range stakes {
+		if err := setStaking(scs, []byte(who), &types.Staking{Amount: amount.Bytes()}); err != nil {
+			return err
+		}
+		if err := addTotal(scs, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func setStaking(scs *state.ContractState, who []byte, staking *types.Staking) error {
 	key := append(stakingKey, who...)
 	return scs.SetData(key, serializeStaking(staking))
@@ -100,10 +117,22 @@ func getStaking(scs *state.ContractState, who []byte) (*types.Staking, error) {
 }
 
 func GetStaking(scs *state.ContractState, address []byte) (*types.Staking, error) {
-	if address != nil {
-		return getStaking(scs, address)
+	if address == nil {
+		return nil, errors.New("invalid argument: address should not be nil")
+	}
+	staking, err := getStaking(scs, address)
+	if err != nil {
+		return nil, err
+	}
+	q, err := getUnbondingQueue(scs, address)
+	if err != nil {
+		return nil, err
+	}
+	if len(q) != 0 {
+		staking.Unbonding = q.total().Bytes()
+		staking.Release = q[len(q)-1].Release
 	}
-	return nil, errors.New("invalid argument: address should not be nil")
+	return staking, nil
 }
 
 func GetTotal(ar AccountStateReader) (*big.Int, error) {
@@ -44,12 +44,71 @@ func TestBasicStakingUnstaking(t *testing.T) {
 	assert.NoError(t, err, "should be success")
 	_, err = unstaking(tx.Body, sender, receiver, scs, StakingDelay, ci)
 	assert.NoError(t, err, "should be success")
-	assert.Equal(t, sender.Balance(), minplusmin, "sender.Balance() cacluation failed")
+	assert.Equal(t, sender.Balance(), types.StakingMinimum, "sender.Balance() should not be changed while unbonding")
 	saved, err = getStaking(scs, tx.Body.Account)
 	assert.Equal(t, new(big.Int).SetUint64(0).Bytes(), saved.Amount, "saved staking value")
 	total, err = GetStakingTotal(scs)
 	assert.NoError(t, err, "should be success")
 	assert.Equal(t, new(big.Int).SetUint64(0), total, "total value")
+
+	tx.Body.Payload = []byte(`{"Name":"v1withdraw"}`)
+	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay+UnbondingPeriod-1)
+	assert.Equal(t, err, types.ErrNothingToWithdraw, "should be return ErrNothingToWithdraw")
+	ci, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay+UnbondingPeriod)
+	assert.NoError(t, err, "should be success")
+	_, err = withdraw(tx.Body, sender, receiver, scs, StakingDelay+UnbondingPeriod, ci)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, sender.Balance(), minplusmin, "sender.Balance() cacluation failed")
+}
+
+func TestUnstakingBeforeUnbondingFork(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	const fork = StakingDelay + 10
+	types.InitForks(types.ForkSchedule{types.ForkUnbonding: fork})
+
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account: sender.ID(),
+			Payload: []byte(`{"Name":"v1stake"}`),
+		},
+	}
+	minplusmin := new(big.Int).Add(types.StakingMinimum, types.StakingMinimum)
+	tx.Body.Amount = minplusmin.Bytes()
+	sender.AddBalance(minplusmin)
+	ci, err := ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, 0)
+	assert.NoError(t, err, "should be success")
+	_, err = staking(tx.Body, sender, receiver, scs, 0, ci)
+	assert.NoError(t, err, "staking failed")
+
+	// unstaked amount is returned at once before the fork
+	tx.Body.Amount = types.StakingMinimum.Bytes()
+	tx.Body.Payload = []byte(`{"Name":"v1unstake"}`)
+	ci, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay)
+	assert.NoError(t, err, "should be success")
+	event, err := unstaking(tx.Body, sender, receiver, scs, StakingDelay, ci)
+	assert.NoError(t, err, "should be success")
+	assert.NotContains(t, event.JsonArgs, "release")
+	balance := types.StakingMinimum
+	assert.Equal(t, balance, sender.Balance(), "unstaked amount should be returned")
+	staking, err := GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Nil(t, staking.Unbonding)
+
+	tx.Body.Payload = []byte(`{"Name":"v1withdraw"}`)
+	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, fork-1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "withdraw before fork")
+
+	// unstaked amount is unbonding after the fork
+	tx.Body.Payload = []byte(`{"Name":"v1unstake"}`)
+	ci, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, fork+StakingDelay)
+	assert.NoError(t, err, "should be success")
+	_, err = unstaking(tx.Body, sender, receiver, scs, fork+StakingDelay, ci)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, balance, sender.Balance(), "sender.Balance() should not be changed while unbonding")
+	staking, err = GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, tx.Body.GetAmountBigInt(), staking.GetUnbondingBigInt())
 }
 
 func TestStaking1Unstaking2(t *testing.T) {
@@ -0,0 +1,165 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var unbondingKey = []byte("unbonding")
+
+const UnbondingPeriod = 60 * 60 * 24 * 7 //block interval
+//const UnbondingPeriod = 10
+
+// MaxUnbondingEntries is the maximum number of unbonding requests of an account in progress
+const MaxUnbondingEntries = 16
+
+// unbonding is an amount of unstaked or undelegated aer, which can be withdrawn from Release.
+type unbonding struct {
+	Amount  *big.Int
+	Release types.BlockNo
+}
+
+// unbondingQueue is the list of unbonding of an account in order of Release.
+type unbondingQueue []*unbonding
+
+func (q unbondingQueue) total() *big.Int {
+	total := new(big.Int)
+	for _, u := range q {
+		total.Add(total, u.Amount)
+	}
+	return total
+}
+
+// split returns the sum of unbonding released until blockNo, and the rest of q.
+func (q unbondingQueue) split(blockNo types.BlockNo) (*big.Int, unbondingQueue) {
+	released := new(big.Int)
+	i := 0
+	for ; i < len(q) && q[i].Release <= blockNo; i++ {
+		released.Add(released, q[i].Amount)
+	}
+	return released, q[i:]
+}
+
+// addUnbonding puts amount to the unbonding queue of who instead of returning it to the balance.
+func addUnbonding(scs *state.ContractState, who []byte, q unbondingQueue, amount *big.Int,
+	blockNo types.BlockNo) (types.BlockNo, error) {
+	release := blockNo + UnbondingPeriod
+	q = append(q, &unbonding{Amount: new(big.Int).Set(amount), Release: release})
+	if err := setUnbondingQueue(scs, who, q); err != nil {
+		return 0, err
+	}
+	return release, nil
+}
+
+// unbond puts amount to the unbonding queue of sender and returns the block from which it can be withdrawn. Before
+// the fork of unbonding, amount is returned to the balance of sender at once and the returned block is 0.
+func unbond(scs *state.ContractState, sender, receiver *state.V, q unbondingQueue, amount *big.Int,
+	blockNo types.BlockNo) (types.BlockNo, error) {
+	if !types.IsForkActive(types.ForkUnbonding, blockNo) {
+		sender.AddBalance(amount)
+		receiver.SubBalance(amount)
+		return 0, nil
+	}
+	return addUnbonding(scs, sender.ID(), q, amount, blockNo)
+}
+
+// releaseJson returns the json field of the release block of unbonding, which is omitted before the fork.
+func releaseJson(release types.BlockNo) string {
+	if release == 0 {
+		return ""
+	}
+	return `, "release":` + strconv.FormatUint(release, 10)
+}
+
+func withdraw(txBody *types.TxBody, sender, receiver *state.V,
+	scs *state.ContractState, blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	released, remains := context.Unbonding.split(blockNo)
+	if err := setUnbondingQueue(scs, sender.ID(), remains); err != nil {
+		return nil, err
+	}
+	sender.AddBalance(released)
+	receiver.SubBalance(released)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "withdraw",
+		JsonArgs: `{"who":"` + types.EncodeAddress(sender.ID()) +
+			`", "amount":"` + released.String() + `"}`,
+	}, nil
+}
+
+// validateForUnbonding checks whether account can make one more unbonding request. There is no limit before the
+// fork of unbonding, because nothing is queued.
+func validateForUnbonding(account []byte, scs *state.ContractState, context *SystemContext) error {
+	if !types.IsForkActive(types.ForkUnbonding, context.BlockNo) {
+		return nil
+	}
+	q, err := getUnbondingQueue(scs, account)
+	if err != nil {
+		return err
+	}
+	if len(q) >= MaxUnbondingEntries {
+		return types.ErrTooManyUnbondings
+	}
+	context.Unbonding = q
+	return nil
+}
+
+func validateForWithdraw(account []byte, scs *state.ContractState, context *SystemContext) error {
+	q, err := getUnbondingQueue(scs, account)
+	if err != nil {
+		return err
+	}
+	if released, _ := q.split(context.BlockNo); released.Sign() == 0 {
+		return types.ErrNothingToWithdraw
+	}
+	context.Unbonding = q
+	return nil
+}
+
+func getUnbondingQueue(scs *state.ContractState, who []byte) (unbondingQueue, error) {
+	data, err := scs.GetData(append(unbondingKey, who...))
+	if err != nil {
+		return nil, err
+	}
+	return deserializeUnbondingQueue(data), nil
+}
+
+func setUnbondingQueue(scs *state.ContractState, who []byte, q unbondingQueue) error {
+	key := append(unbondingKey, who...)
+	if len(q) == 0 {
+		return scs.DeleteData(key)
+	}
+	return scs.SetData(key, serializeUnbondingQueue(q))
+}
+
+func serializeUnbondingQueue(q unbondingQueue) []byte {
+	var ret []byte
+	for _, u := range q {
+		release := make([]byte, 8)
+		binary.LittleEndian.PutUint64(release, u.Release)
+		ret = append(ret, release...)
+		ret = appendBytes(ret, u.Amount.Bytes())
+	}
+	return ret
+}
+
+func deserializeUnbondingQueue(data []byte) unbondingQueue {
+	var q unbondingQueue
+	for len(data) > 0 {
+		u := &unbonding{Release: binary.LittleEndian.Uint64(data)}
+		var amount []byte
+		amount, data = readBytes(data[8:])
+		u.Amount = new(big.Int).SetBytes(amount)
+		q = append(q, u)
+	}
+	return q
+}
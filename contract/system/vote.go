@@ -95,8 +95,11 @@ func voting(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contract
 
 func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 	context *SystemContext) error {
-	account := context.Sender.ID()
-	staked := context.Staked
+	return refreshVotes(scs, context.Sender.ID(), context.Staked)
+}
+
+// refreshVotes reduces the votes of account not to exceed its staked amount.
+func refreshVotes(scs *state.ContractState, account []byte, staked *types.Staking) error {
 	stakedAmount := new(big.Int).SetBytes(staked.Amount)
 	for _, keystr := range types.AllVotes {
 		key := []byte(keystr[2:])
@@ -207,9 +210,16 @@ func getDefaultBpCount() int {
 
 // GetRankers returns the IDs of the top n rankers.
 func GetRankers(ar AccountStateReader) ([]string, error) {
-	n := getDefaultBpCount()
+	scs, err := ar.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	n, err := getBpCount(scs)
+	if err != nil {
+		return nil, err
+	}
 
-	vl, err := GetVoteResult(ar, defaultVoteKey, n)
+	vl, err := getVoteResult(scs, defaultVoteKey, n)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +46,13 @@ func initTest(t *testing.T) (*state.ContractState, *state.V, *state.V) {
 	assert.NoError(t, err, "could not get test address state")
 	receiver, err := sdb.GetAccountStateV([]byte(types.AergoSystem))
 	assert.NoError(t, err, "could not get test address state")
+	types.InitForks(types.ForkSchedule{types.ForkUnbonding: 0, types.ForkDelegation: 0, types.ForkGovernance: 0,
+		types.ForkAllowlist: 0})
 	return scs, sender, receiver
 }
 
 func deinitTest() {
+	types.InitForks(nil)
 	cdb.Close()
 	os.RemoveAll("test")
 }
@@ -194,7 +194,7 @@ func (s *StateSet) usedFee() *big.Int {
 		return zeroFee
 	}
 	size := fee.PaymentDataSize(s.dbUpdateTotalSize)
-	return new(big.Int).Mul(big.NewInt(size), fee.AerPerByte)
+	return new(big.Int).Mul(big.NewInt(size), fee.AerPerByte())
 }
 
 func NewLState() *LState {
@@ -205,7 +205,7 @@ func LuaCallContract(L *LState, service *C.int, contractId *C.char, fname *C.cha
 		return -1, C.CString("[Contract.LuaCallContract] contract state not found")
 	}
 	contractAddress := C.GoString(contractId)
-	cid, err := getAddressNameResolved(contractAddress, stateSet.bs)
+	cid, err := getAddressNameResolved(contractAddress, stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaCallContract] invalid contractId: " + err.Error())
 	}
@@ -291,7 +291,7 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 	if stateSet == nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(contractIdStr, stateSet.bs)
+	cid, err := getAddressNameResolved(contractIdStr, stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid contractId: " + err.Error())
 	}
@@ -338,12 +338,13 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 	return ret, nil
 }
 
-func getAddressNameResolved(account string, bs *state.BlockState) ([]byte, error) {
+func getAddressNameResolved(account string, bs *state.BlockState, blockNo types.BlockNo) ([]byte, error) {
 	accountLen := len(account)
 	if accountLen == types.EncodedAddressLength {
 		return types.DecodeAddress(account)
-	} else if accountLen == types.NameLength {
-		cid := name.Resolve(bs, []byte(account))
+	} else if accountLen == types.NameLength ||
+		(types.IsForkActive(types.ForkSubName, blockNo) && types.IsNameAddress([]byte(account))) {
+		cid := name.Resolve(bs, []byte(account), blockNo)
 		if cid == nil {
 			return nil, errors.New("name not founded :" + account)
 		}
@@ -365,7 +366,7 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 	if stateSet.isQuery == true && amountBig.Cmp(zeroBig) > 0 {
 		return C.CString("[Contract.LuaSendAmount] send not permitted in query")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return C.CString("[Contract.LuaSendAmount] invalid contractId: " + err.Error())
 	}
@@ -548,7 +549,7 @@ func LuaGetBalance(L *LState, service *C.int, contractId *C.char) (*C.char, *C.c
 	if contractId == nil {
 		return C.CString(stateSet.curContract.callState.ctrState.GetBalanceBigInt().String()), nil
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return nil, C.CString("[Contract.LuaGetBalance] invalid contractId: " + err.Error())
 	}
@@ -845,7 +846,7 @@ func LuaDeployContract(
 	// get code
 	var code []byte
 
-	cid, err := getAddressNameResolved(contractStr, bs)
+	cid, err := getAddressNameResolved(contractStr, bs, stateSet.blockHeight)
 	if err == nil {
 		aid := types.ToAccountID(cid)
 		contractState, err := getOnlyContractState(stateSet, aid)
@@ -1013,7 +1014,7 @@ func LuaIsContract(L *LState, service *C.int, contractId *C.char) (C.int, *C.cha
 	if stateSet == nil {
 		return -1, C.CString("[Contract.LuaIsContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaIsContract] invalid contractId: " + err.Error())
 	}
@@ -1044,10 +1045,13 @@ func LuaGovernance(L *LState, service *C.int, gType C.char, arg *C.char) *C.char
 		if stateSet.isQuery == true && amountBig.Cmp(zeroBig) > 0 {
 			return C.CString("[Contract.LuaGovernance] governance not permitted in query")
 		}
-		if gType == 'S' {
+		switch gType {
+		case 'S':
 			payload = []byte(fmt.Sprintf(`{"Name":"%s"}`, types.Stake))
-		} else {
+		case 'U':
 			payload = []byte(fmt.Sprintf(`{"Name":"%s"}`, types.Unstake))
+		default:
+			payload = []byte(fmt.Sprintf(`{"Name":"%s"}`, types.Withdraw))
 		}
 	} else {
 		amountBig = zeroBig
@@ -1079,6 +1083,7 @@ func LuaGovernance(L *LState, service *C.int, gType C.char, arg *C.char) *C.char
 			return C.CString("[Contract.LuaGovernance] database error: " + err.Error())
 		}
 	}
+	balance := sender.Balance()
 	evs, err := system.ExecuteSystemTx(scsState.ctrState, &txBody, sender, receiver, stateSet.blockHeight)
 	if err != nil {
 		return C.CString("[Contract.LuaGovernance] error: " + err.Error())
@@ -1089,8 +1094,13 @@ func LuaGovernance(L *LState, service *C.int, gType C.char, arg *C.char) *C.char
 	if stateSet.lastRecoveryEntry != nil {
 		if gType == 'S' {
 			_ = setRecoveryPoint(aid, stateSet, senderState, scsState, amountBig, true)
-		} else if gType == 'U' {
+		} else if gType == 'U' && !types.IsForkActive(types.ForkUnbonding, stateSet.blockHeight) {
+			// unstaked amount is returned to the balance at once before the fork of unbonding
 			_ = setRecoveryPoint(aid, stateSet, scsState.curState, stateSet.curContract.callState, amountBig, true)
+		} else if gType == 'W' {
+			// unstaked amount is moved to the balance only by withdrawal after the unbonding period
+			withdrawn := new(big.Int).Sub(sender.Balance(), balance)
+			_ = setRecoveryPoint(aid, stateSet, scsState.curState, stateSet.curContract.callState, withdrawn, true)
 		}
 	}
 	return nil
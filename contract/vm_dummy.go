@@ -479,7 +479,7 @@ func (bc *DummyChain) ConnectBlock(txs ...luaTx) error {
 			return err
 		}
 	}
-	err := SaveRecoveryPoint(blockState)
+	err := SaveRecoveryPoint(blockState, bc.cBlock.Header.BlockNo)
 	if err != nil {
 		return err
 	}
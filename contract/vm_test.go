@@ -738,6 +738,61 @@ abi.register(createAndInsert, insertRollbackData, query, count, all)`
 	}
 }
 
+func TestSqlDigest(t *testing.T) {
+	types.InitForks(types.ForkSchedule{types.ForkSQLDigest: 2})
+	defer types.InitForks(nil)
+
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+
+	definition := `
+function insert(v)
+    db.exec("create table if not exists dual(dummy text)")
+    db.exec("insert into dual values ('" .. v .. "')")
+end
+
+abi.register(insert)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "digest", 0, definition),
+		NewLuaTxCall("ktlee", "digest", 0, `{"Name": "insert", "Args":["X"]}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	st, err := bc.GetAccountState("digest")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(st.SqlDigest) != 0 {
+		t.Errorf("digest before fork: %v", st.SqlDigest)
+	}
+
+	var digests [][]byte
+	for _, v := range []string{"Y", "Z"} {
+		err = bc.ConnectBlock(
+			NewLuaTxCall("ktlee", "digest", 0, `{"Name": "insert", "Args":["`+v+`"]}`),
+		)
+		if err != nil {
+			t.Error(err)
+		}
+		st, err = bc.GetAccountState("digest")
+		if err != nil {
+			t.Error(err)
+		}
+		if len(st.SqlDigest) != 32 {
+			t.Fatalf("invalid digest: %v", st.SqlDigest)
+		}
+		digests = append(digests, st.SqlDigest)
+	}
+	if bytes.Equal(digests[0], digests[1]) {
+		t.Error("digest is not changed by insert")
+	}
+}
+
 func TestSqlVmFail(t *testing.T) {
 	bc, err := LoadDummyChain()
 	if err != nil {
@@ -2,6 +2,7 @@ package fee
 
 import (
 	"math/big"
+	"sync/atomic"
 )
 
 const (
@@ -13,19 +14,50 @@ const (
 )
 
 var (
+	zeroFee bool
+	zero    *big.Int
+	// rates holds *feeRates, which can be changed by governance while transactions are validated
+	rates atomic.Value
+)
+
+type feeRates struct {
 	baseTxAergo   *big.Int
-	zeroFee       bool
+	aerPerByte    *big.Int
 	stateDbMaxFee *big.Int
-	zero          *big.Int
-	AerPerByte    *big.Int
-)
+}
 
 func init() {
-	baseTxAergo, _ = new(big.Int).SetString(baseTxFee, 10)
 	zeroFee = false
-	AerPerByte = big.NewInt(aerPerByte)
-	stateDbMaxFee = new(big.Int).Mul(AerPerByte, big.NewInt(StateDbMaxUpdateSize-freeByteSize))
 	zero = big.NewInt(0)
+	SetRates(nil, nil)
+}
+
+// SetRates changes the base fee of a transaction and the fee per byte of payload and state update. A nil rate
+// sets the default.
+func SetRates(baseTxFeeAergo, feePerByte *big.Int) {
+	r := &feeRates{baseTxAergo: baseTxFeeAergo, aerPerByte: feePerByte}
+	if r.baseTxAergo == nil {
+		r.baseTxAergo, _ = new(big.Int).SetString(baseTxFee, 10)
+	}
+	if r.aerPerByte == nil {
+		r.aerPerByte = big.NewInt(aerPerByte)
+	}
+	r.stateDbMaxFee = new(big.Int).Mul(r.aerPerByte, big.NewInt(StateDbMaxUpdateSize-freeByteSize))
+	rates.Store(r)
+}
+
+func currentRates() *feeRates {
+	return rates.Load().(*feeRates)
+}
+
+// BaseTxFee returns the current base fee of a transaction.
+func BaseTxFee() *big.Int {
+	return new(big.Int).Set(currentRates().baseTxAergo)
+}
+
+// AerPerByte returns the current fee per byte of payload and state update.
+func AerPerByte() *big.Int {
+	return new(big.Int).Set(currentRates().aerPerByte)
 }
 
 func EnableZeroFee() {
@@ -44,10 +76,11 @@ func PayloadTxFee(payloadSize int) *big.Int {
 	if size > payloadMaxSize {
 		size = payloadMaxSize
 	}
+	r := currentRates()
 	return new(big.Int).Add(
-		baseTxAergo,
+		r.baseTxAergo,
 		new(big.Int).Mul(
-			AerPerByte,
+			r.aerPerByte,
 			big.NewInt(size),
 		),
 	)
@@ -57,10 +90,11 @@ func MaxPayloadTxFee(payloadSize int) *big.Int {
 	if IsZeroFee() {
 		return zero
 	}
+	r := currentRates()
 	if payloadSize == 0 {
-		return baseTxAergo
+		return r.baseTxAergo
 	}
-	return new(big.Int).Add(PayloadTxFee(payloadSize), stateDbMaxFee)
+	return new(big.Int).Add(PayloadTxFee(payloadSize), r.stateDbMaxFee)
 }
 
 func PaymentDataSize(dataSize int64) int64 {
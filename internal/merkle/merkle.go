@@ -1,8 +1,10 @@
 package merkle
 
 import (
-	"github.com/minio/sha256-simd"
+	"bytes"
 	"hash"
+
+	"github.com/minio/sha256-simd"
 )
 
 type MerkleEntry interface {
@@ -88,3 +90,60 @@ func CalculateMerkleTree(entries []MerkleEntry) [][]byte {
 
 	return merkles
 }
+
+// CalculateMerkleProof returns the audit path of the entry at index, from the sibling of the leaf to the
+// child of root. It returns nil if index is out of range.
+func CalculateMerkleProof(entries []MerkleEntry, index int) [][]byte {
+	if index < 0 || index >= len(entries) {
+		return nil
+	}
+	merkles := CalculateMerkleTree(entries)
+
+	path := make([][]byte, 0)
+	levelStart := 0
+	levelSize := (len(merkles) + 1) / 2
+	for levelSize > 1 {
+		path = append(path, merkles[levelStart+(index^1)])
+		levelStart += levelSize
+		levelSize /= 2
+		index /= 2
+	}
+	return path
+}
+
+// VerifyMerkleProof checks that the leaf hash at index is included in the merkle tree of count leaves whose root is
+// root, by the audit path made by CalculateMerkleProof. The last node of a level with odd number of nodes is paired
+// with its copy, so the copy must be the sibling of such a node and must not be the sibling of any other node.
+// Otherwise, a leaf could be proven at the index of its copy in the padding, or with a larger count.
+func VerifyMerkleProof(root []byte, leaf []byte, index int, count int, path [][]byte) bool {
+	if index < 0 || index >= count {
+		return false
+	}
+	height := 0
+	for 1<<uint(height) < count {
+		height++
+	}
+	if len(path) != height {
+		return false
+	}
+	hasher := sha256.New()
+	cur := leaf
+	for _, sibling := range path {
+		padded := index%2 == 0 && index == count-1
+		if padded != bytes.Equal(cur, sibling) {
+			return false
+		}
+		hasher.Reset()
+		if index%2 == 0 {
+			hasher.Write(cur)
+			hasher.Write(sibling)
+		} else {
+			hasher.Write(sibling)
+			hasher.Write(cur)
+		}
+		cur = hasher.Sum(nil)
+		index /= 2
+		count = (count + 1) / 2
+	}
+	return bytes.Equal(cur, root)
+}
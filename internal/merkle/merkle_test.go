@@ -52,7 +52,7 @@ func genMerkleEntry(h hash.Hash, i int) MerkleEntry {
 	tm := testME{}
 
 	h.Reset()
-	binary.Write(h, binary.LittleEndian, i)
+	binary.Write(h, binary.LittleEndian, int64(i))
 	tm.hash = h.Sum(nil)
 
 	return &tm
@@ -178,3 +178,38 @@ func BenchmarkMerkle10000Tx(b *testing.B) {
 		CalculateMerkleTree(tms)
 	}
 }
+
+func TestMerkleProof(t *testing.T) {
+	for _, count := range []int{1, 2, 3, 5, 10, 32} {
+		beforeTest(count)
+		root := CalculateMerkleRoot(tms)
+
+		for i, tm := range tms {
+			path := CalculateMerkleProof(tms, i)
+			assert.True(t, VerifyMerkleProof(root, tm.GetHash(), i, count, path), "count=%d, idx=%d", count, i)
+
+			// wrong position, wrong leaf or wrong height must fail
+			if count > 1 {
+				assert.False(t, VerifyMerkleProof(root, tm.GetHash(), (i+1)%count, count, path), "count=%d, idx=%d", count, i)
+				assert.False(t, VerifyMerkleProof(root, tms[(i+1)%count].GetHash(), i, count, path), "count=%d, idx=%d", count, i)
+			}
+			assert.False(t, VerifyMerkleProof(root, tm.GetHash(), i, count*2+1, path), "count=%d, idx=%d", count, i)
+		}
+		assert.Nil(t, CalculateMerkleProof(tms, count))
+	}
+}
+
+func TestMerkleProofPaddedIndex(t *testing.T) {
+	for _, count := range []int{3, 5, 10} {
+		beforeTest(count)
+		root := CalculateMerkleRoot(tms)
+
+		// the last leaf is copied to the padding at count, and has the same audit path there
+		last := tms[count-1].GetHash()
+		path := CalculateMerkleProof(tms, count-1)
+		assert.True(t, VerifyMerkleProof(root, last, count-1, count, path), "count=%d", count)
+		assert.False(t, VerifyMerkleProof(root, last, count, count, path), "count=%d", count)
+		assert.False(t, VerifyMerkleProof(root, last, count, count+1, path), "count=%d", count)
+		assert.False(t, VerifyMerkleProof(root, last, count, 1<<uint(len(path)), path), "count=%d", count)
+	}
+}
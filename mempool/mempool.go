@@ -502,6 +502,9 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 			}
 		}
 	case types.TxType_GOVERNANCE:
+		if err := types.ValidateForkTx(tx.GetBody(), mp.bestBlockNo+1); err != nil {
+			return err
+		}
 		aergoState, err := mp.getAccountState(tx.GetBody().GetRecipient())
 		if err != nil {
 			return err
@@ -530,7 +533,7 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 			if err != nil {
 				return err
 			}
-			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs); err != nil {
+			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs, mp.bestBlockNo+1); err != nil {
 				return err
 			}
 		}
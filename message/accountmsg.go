@@ -77,9 +77,31 @@ type ImportAccountRsp struct {
 type ExportAccount struct {
 	Account *types.Account
 	Pass    string
+	// AsKeystore requests json keystore file instead of the legacy export format
+	AsKeystore bool
 }
 
 type ExportAccountRsp struct {
 	Wif []byte
 	Err error
 }
+
+// CreateHDAccounts creates hd wallet if it does not exist, and derives next Count accounts from it
+type CreateHDAccounts struct {
+	Passphrase string
+	Count      int
+}
+
+// RecoverHDAccounts restores hd wallet from mnemonic and derives first Count accounts from it
+type RecoverHDAccounts struct {
+	Mnemonic   string
+	Passphrase string
+	Count      int
+}
+
+type HDAccountsRsp struct {
+	// Mnemonic is set only when new hd wallet is created
+	Mnemonic string
+	Accounts []*types.Account
+	Err      error
+}
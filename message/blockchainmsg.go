@@ -71,6 +71,15 @@ type GetTxRsp struct {
 	Err   error
 }
 
+// GetTxProof requests merkle proof of tx in the main chain. The actor returns GetTxProofRsp
+type GetTxProof struct {
+	TxHash []byte
+}
+type GetTxProofRsp struct {
+	Proof *types.TxProof
+	Err   error
+}
+
 type GetReceipt struct {
 	TxHash []byte
 }
@@ -79,6 +88,15 @@ type GetReceiptRsp struct {
 	Err     error
 }
 
+// GetReceiptProof requests merkle proof of receipt in the main chain. The actor returns GetReceiptProofRsp
+type GetReceiptProof struct {
+	TxHash []byte
+}
+type GetReceiptProofRsp struct {
+	Proof *types.ReceiptProof
+	Err   error
+}
+
 type GetABI struct {
 	Contract []byte
 }
@@ -143,6 +161,15 @@ type GetStakingRsp struct {
 	Err     error
 }
 
+type GetDelegation struct {
+	Addr []byte
+}
+
+type GetDelegationRsp struct {
+	Delegation *types.Delegation
+	Err        error
+}
+
 type GetNameInfo struct {
 	Name string
 }
@@ -152,6 +179,31 @@ type GetNameInfoRsp struct {
 	Err   error
 }
 
+// GetNameByAddress requests the primary name of an address. The response is GetNameInfoRsp.
+type GetNameByAddress struct {
+	Addr []byte
+}
+
+// ListProposals requests the governance proposals of the system contract.
+type ListProposals struct {
+	Size   uint32
+	Offset uint32
+	Asc    bool
+}
+
+type ListProposalsRsp struct {
+	Proposals *types.ProposalList
+	Err       error
+}
+
+// GetAllowedPeers requests the peers allowed to join the permissioned network.
+type GetAllowedPeers struct{}
+
+type GetAllowedPeersRsp struct {
+	Peers []peer.ID
+	Err   error
+}
+
 type GetAnchors struct {
 	Seq uint64
 }
@@ -184,3 +236,73 @@ type ListEventsRsp struct {
 	Events []*types.Event
 	Err    error
 }
+
+// GetSnapshotChunk reads a chunk of state trie to serve snapshot sync of other peer
+type GetSnapshotChunk struct {
+	Root     []byte
+	Start    []byte
+	End      []byte
+	Accounts bool
+}
+
+type GetSnapshotChunkRsp struct {
+	Entries []*types.StateChunkEntry
+	HasNext bool
+	Err     error
+}
+
+// GetSnapshotSQL reads a part of sql database of contract to serve snapshot sync of other peer. The database is
+// copied between blocks at the first request whose version is zero, unless it was copied recently, and the version
+// of copy is returned.
+type GetSnapshotSQL struct {
+	Name    string
+	Version uint64
+	Offset  uint64
+}
+
+type GetSnapshotSQLRsp struct {
+	Version uint64
+	Data    []byte
+	Size    uint64
+	Err     error
+}
+
+// PutSnapshotChunk verifies and writes a chunk of state trie downloaded by snapshot sync
+type PutSnapshotChunk struct {
+	Root    []byte
+	Entries []*types.StateChunkEntry
+}
+
+// PutSnapshotSQL writes a part of sql database of contract downloaded by snapshot sync. The parts must be put in
+// order of offset.
+type PutSnapshotSQL struct {
+	Name   string
+	Offset uint64
+	Data   []byte
+}
+
+// ApplySnapshot sets the downloaded state as the state of block, and the block as the best block. The storage
+// tries and sql databases of contracts must be downloaded too.
+type ApplySnapshot struct {
+	Block        *types.Block
+	StorageRoots [][]byte
+	SQLs         map[string]*SnapshotSQL // by name of database
+}
+
+// SnapshotSQL is the recovery point and the digest of sql database in the verified account state of contract
+type SnapshotSQL struct {
+	RecoveryPoint uint64
+	Digest        []byte
+}
+
+// PutSnapshotRsp is a response of PutSnapshotChunk, PutSnapshotSQL and ApplySnapshot
+type PutSnapshotRsp struct {
+	Err error
+}
+
+type GetDBStats struct{}
+
+// GetDBStatsRsp contains the statistics by name of store
+type GetDBStatsRsp struct {
+	Stats map[string]map[string]string
+}
@@ -47,6 +47,12 @@ type NotifyNewBlock struct {
 	Block    *types.Block
 }
 
+// NotifyAllowedPeers is sent by chain service when the allowlist of peers is changed by a committed block. The
+// network is permissionless if Peers is empty.
+type NotifyAllowedPeers struct {
+	Peers []peer.ID
+}
+
 type BlockHash []byte
 type TXHash []byte
 
@@ -177,9 +183,51 @@ type GetHashByNo struct {
 
 type GetHashByNoRsp struct {
 	Seq       uint64
+	ToWhom    peer.ID
 	BlockHash BlockHash
 	Err       error
 }
 
+// GetStateChunk is sent from Syncer, send types.GetStateChunkRequest to dest peer.
+type GetStateChunk struct {
+	Seq      uint64
+	ToWhom   peer.ID
+	Root     []byte
+	Start    []byte
+	End      []byte
+	Accounts bool
+}
+
+// GetStateChunkRsp is data from other peer, as a response of types.GetStateChunkRequest
+type GetStateChunkRsp struct {
+	Seq     uint64
+	ToWhom  peer.ID
+	Entries []*types.StateChunkEntry
+	HasNext bool
+	Err     error
+}
+
+// GetStateSQL is sent from Syncer, send types.GetStateSQLRequest to dest peer.
+type GetStateSQL struct {
+	Seq     uint64
+	ToWhom  peer.ID
+	Name    string
+	Version uint64
+	Offset  uint64
+}
+
+// GetStateSQLRsp is data from other peer, as a response of types.GetStateSQLRequest. Name and Offset are of the
+// request.
+type GetStateSQLRsp struct {
+	Seq     uint64
+	ToWhom  peer.ID
+	Name    string
+	Offset  uint64
+	Version uint64
+	Data    []byte
+	Size    uint64
+	Err     error
+}
+
 type GetSelf struct {
 }
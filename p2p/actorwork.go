@@ -114,13 +114,39 @@ func (p2ps *P2P) GetBlockHashByNo(context actor.Context, msg *message.GetHashByN
 	remotePeer, exists := p2ps.pm.GetPeer(peerID)
 	if !exists {
 		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, subproto.GetHashByNoRequest.String()).Msg("Invalid peerID")
-		context.Respond(&message.GetHashByNoRsp{Seq:msg.Seq, Err: message.PeerNotFoundError})
+		context.Respond(&message.GetHashByNoRsp{Seq:msg.Seq, ToWhom: peerID, Err: message.PeerNotFoundError})
 		return
 	}
 	receiver := NewBlockHashByNoReceiver(p2ps, remotePeer, msg.Seq, msg.BlockNo, fetchTimeOut)
 	receiver.StartGet()
 }
 
+// GetStateChunk send request message to peer and make response message for a chunk of state trie
+func (p2ps *P2P) GetStateChunk(context actor.Context, msg *message.GetStateChunk) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, subproto.GetStateChunkRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetStateChunkRsp{Seq: msg.Seq, ToWhom: peerID, Err: message.PeerNotFoundError})
+		return
+	}
+	receiver := NewStateChunkReceiver(p2ps, remotePeer, msg.Seq, msg, fetchTimeOut)
+	receiver.StartGet()
+}
+
+// GetStateSQL send request message to peer and make response message for a part of sql database of contract
+func (p2ps *P2P) GetStateSQL(context actor.Context, msg *message.GetStateSQL) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, subproto.GetStateSQLRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetStateSQLRsp{Seq: msg.Seq, ToWhom: peerID, Err: message.PeerNotFoundError})
+		return
+	}
+	receiver := NewStateSQLReceiver(p2ps, remotePeer, msg.Seq, msg, fetchTimeOut)
+	receiver.StartGet()
+}
+
 // NotifyNewBlock send notice message of new block to a peer
 func (p2ps *P2P) NotifyNewBlock(newBlock message.NotifyNewBlock) bool {
 	req := &types.NewBlockNotice{
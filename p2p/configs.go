@@ -33,6 +33,7 @@ const (
 
 	MaxAddrListSizePolaris = 200
 	MaxAddrListSizePeer    = 50
+	MaxAddrListSizeDHT     = 100
 )
 
 // constants for peer internal operations
@@ -0,0 +1,156 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	discovery "github.com/libp2p/go-libp2p-discovery"
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	dhtopts "github.com/libp2p/go-libp2p-kad-dht/opts"
+	pstore "github.com/libp2p/go-libp2p-peerstore"
+	protocol "github.com/libp2p/go-libp2p-protocol"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	dhtProtocolPrefix  = "/aergo/kad/"
+	dhtProtocolSuffix  = "/1.0.0"
+	dhtNamespacePrefix = "aergo/"
+
+	// dhtFindTimeout is the max time to wait for a single peer lookup in DHT
+	dhtFindTimeout = time.Second * 30
+)
+
+// dhtDiscoverer finds peers of the same chain through Kademlia DHT. Both the DHT protocol id and
+// the rendezvous namespace are derived from chain id, so that nodes of different chains never
+// share routing table nor advertisement. Peers connected by polaris, designated peers or address
+// exchange are used as the bootstrap nodes of DHT.
+type dhtDiscoverer struct {
+	logger *log.Logger
+	nt     p2pcommon.NetworkTransport
+
+	protocolID protocol.ID
+	namespace  string
+
+	kad *dht.IpfsDHT
+	rd  *discovery.RoutingDiscovery
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+var _ p2pcommon.PeerDiscoverer = (*dhtDiscoverer)(nil)
+
+// NewDHTDiscoverer create dht based peer discoverer for the chain.
+func NewDHTDiscoverer(logger *log.Logger, nt p2pcommon.NetworkTransport, chainID *types.ChainID) (*dhtDiscoverer, error) {
+	chainBytes, err := chainID.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	chainKey := sha256.Sum256(chainBytes)
+	chainStr := hex.EncodeToString(chainKey[:8])
+	return &dhtDiscoverer{logger: logger, nt: nt,
+		protocolID: protocol.ID(dhtProtocolPrefix + chainStr + dhtProtocolSuffix),
+		namespace:  dhtNamespacePrefix + chainStr,
+	}, nil
+}
+
+// Start must be called after network transport is started.
+func (d *dhtDiscoverer) Start() error {
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	kad, err := dht.New(d.ctx, d.nt, dhtopts.Protocols(d.protocolID))
+	if err != nil {
+		d.cancel()
+		return err
+	}
+	if err = kad.Bootstrap(d.ctx); err != nil {
+		d.cancel()
+		kad.Close()
+		return err
+	}
+	d.kad = kad
+	d.rd = discovery.NewRoutingDiscovery(kad)
+	// advertise self repeatedly till the context is canceled. hidden node only finds others.
+	if !d.nt.SelfMeta().Hidden {
+		discovery.Advertise(d.ctx, d.rd, d.namespace)
+	}
+	d.logger.Info().Str(p2putil.LogProtoID, string(d.protocolID)).Str("namespace", d.namespace).Msg("Started DHT discovery")
+	return nil
+}
+
+func (d *dhtDiscoverer) Stop() {
+	if d.kad == nil {
+		return
+	}
+	d.cancel()
+	if err := d.kad.Close(); err != nil {
+		d.logger.Debug().Err(err).Msg("error while closing DHT")
+	}
+	d.kad = nil
+}
+
+func (d *dhtDiscoverer) FindPeers(limit int) []p2pcommon.PeerMeta {
+	if d.kad == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(d.ctx, dhtFindTimeout)
+	defer cancel()
+
+	peerC, err := d.rd.FindPeers(ctx, d.namespace, discovery.Limit(limit))
+	if err != nil {
+		d.logger.Debug().Err(err).Msg("failed to find peers in DHT")
+		return nil
+	}
+	selfID := d.nt.SelfMeta().ID
+	metas := make([]p2pcommon.PeerMeta, 0, limit)
+	for pi := range peerC {
+		if pi.ID == selfID || len(pi.Addrs) == 0 {
+			continue
+		}
+		meta, err := toPeerMeta(pi)
+		if err != nil {
+			d.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(pi.ID)).Msg("skipping peer with unusable address")
+			continue
+		}
+		metas = append(metas, meta)
+	}
+	d.logger.Debug().Int("peer_cnt", len(metas)).Msg("Found peers in DHT")
+	return metas
+}
+
+// toPeerMeta choose external address of peer if exists, or first valid address.
+func toPeerMeta(pi pstore.PeerInfo) (p2pcommon.PeerMeta, error) {
+	idAddr, err := ma.NewMultiaddr("/p2p/" + pi.ID.Pretty())
+	if err != nil {
+		return p2pcommon.PeerMeta{}, err
+	}
+	var candidate *p2pcommon.PeerMeta
+	for _, addr := range pi.Addrs {
+		meta, err := p2putil.FromMultiAddr(addr.Encapsulate(idAddr))
+		if err != nil {
+			continue
+		}
+		if p2putil.IsExternalAddr(meta.IPAddress) {
+			return meta, nil
+		}
+		if candidate == nil {
+			candidate = &meta
+		}
+	}
+	if candidate == nil {
+		return p2pcommon.PeerMeta{}, fmt.Errorf("no valid address in %v", pi.Addrs)
+	}
+	return *candidate, nil
+}
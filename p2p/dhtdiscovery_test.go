@@ -0,0 +1,67 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDHTDiscoverer(t *testing.T) {
+	chain1 := &types.ChainID{Magic: "chain1.aergo.io", Consensus: "dpos", PublicNet: true}
+	chain1Copy := &types.ChainID{Magic: "chain1.aergo.io", Consensus: "dpos", PublicNet: true}
+	chain2 := &types.ChainID{Magic: "chain2.aergo.io", Consensus: "dpos", PublicNet: true}
+
+	d1, err := NewDHTDiscoverer(logger, nil, chain1)
+	assert.Nil(t, err)
+	d1c, err := NewDHTDiscoverer(logger, nil, chain1Copy)
+	assert.Nil(t, err)
+	d2, err := NewDHTDiscoverer(logger, nil, chain2)
+	assert.Nil(t, err)
+
+	assert.Equal(t, d1.protocolID, d1c.protocolID)
+	assert.Equal(t, d1.namespace, d1c.namespace)
+	assert.NotEqual(t, d1.protocolID, d2.protocolID)
+	assert.NotEqual(t, d1.namespace, d2.namespace)
+}
+
+func Test_toPeerMeta(t *testing.T) {
+	pid, _ := peer.IDB58Decode("16Uiu2HAmHuBgtnisgPLbujFvxPNZw3Qvpk3VLUwTzh5C67LAZSFh")
+	loopback, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/7846")
+	private, _ := ma.NewMultiaddr("/ip4/192.168.0.3/tcp/7846")
+	public, _ := ma.NewMultiaddr("/ip4/211.34.56.78/tcp/7846")
+	noPort, _ := ma.NewMultiaddr("/ip4/211.34.56.78")
+
+	tests := []struct {
+		name    string
+		addrs   []ma.Multiaddr
+		wantIP  string
+		wantErr bool
+	}{
+		{"TPublic", []ma.Multiaddr{public}, "211.34.56.78", false},
+		{"TPreferPublic", []ma.Multiaddr{loopback, private, public}, "211.34.56.78", false},
+		{"TFirstValid", []ma.Multiaddr{private, loopback}, "192.168.0.3", false},
+		{"TNoValid", []ma.Multiaddr{noPort}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toPeerMeta(pstore.PeerInfo{ID: pid, Addrs: tt.addrs})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toPeerMeta() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, pid, got.ID)
+				assert.Equal(t, tt.wantIP, got.IPAddress)
+				assert.Equal(t, uint32(7846), got.Port)
+			}
+		})
+	}
+}
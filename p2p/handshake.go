@@ -49,6 +49,10 @@ type PeerHandshaker struct {
 	peerID    peer.ID
 	// check if is it adhoc
 	localChainID *types.ChainID
+	// compressions is the payload compression algorithms of local node in order of preference
+	compressions []string
+	// forks is nil if fork ids are not exchanged
+	forks *forkFilter
 
 	remoteStatus *types.Status
 }
@@ -170,12 +174,37 @@ func (h *PeerHandshaker) selectProtocolVersion(head HSHeader, r *bufio.Reader, w
 	switch head.Version {
 	case p2pcommon.P2PVersion030:
 		v030 := newV030StateHS(h.pm, h.actorServ, h.logger, h.localChainID, h.peerID, r, w)
+		v030.compressions = h.compressions
+		v030.forks = h.forks
 		return v030, nil
 	default:
 		return nil, fmt.Errorf("not supported version")
 	}
 }
 
+// forkFilter tells apart the peers whose fork schedules are incompatible with local node
+type forkFilter struct {
+	schedule    types.ForkSchedule
+	genesisHash []byte
+}
+
+func newForkFilter(schedule types.ForkSchedule, genesisHash []byte) *forkFilter {
+	return &forkFilter{schedule: schedule, genesisHash: genesisHash}
+}
+
+// setForkID sets the fork id of local node to status
+func (f *forkFilter) setForkID(status *types.Status) {
+	if f == nil {
+		return
+	}
+	id := f.schedule.ForkID(f.genesisHash, status.GetBestHeight())
+	status.ForkHash, status.ForkNext = id.Hash, id.Next
+}
+
+func (f *forkFilter) check(bestNo types.BlockNo, remote types.ForkID) error {
+	return f.schedule.CheckForkID(f.genesisHash, bestNo, remote)
+}
+
 func (h *PeerHandshaker) checkProtocolVersion(versionStr string) error {
 	// TODO modify interface and put check code here
 	return nil
@@ -152,3 +152,25 @@ func TestHSHeader_Marshal(t *testing.T) {
 		})
 	}
 }
+
+func TestForkFilter(t *testing.T) {
+	genesisHash := []byte("genesis")
+	local := newForkFilter(types.ForkSchedule{"v2": 100}, genesisHash)
+
+	status := &types.Status{BestHeight: 50}
+	local.setForkID(status)
+	assert.NotZero(t, status.ForkHash)
+	assert.Equal(t, uint64(100), status.ForkNext)
+	assert.NoError(t, local.check(50, types.ForkID{Hash: status.ForkHash, Next: status.ForkNext}))
+
+	// the peer which doesn't know v2 can't connect after local node passes it
+	remote := &types.Status{BestHeight: 150}
+	newForkFilter(types.ForkSchedule{}, genesisHash).setForkID(remote)
+	assert.Error(t, local.check(150, types.ForkID{Hash: remote.ForkHash, Next: remote.ForkNext}))
+
+	// nil filter doesn't set fork id
+	var none *forkFilter
+	status = &types.Status{BestHeight: 50}
+	none.setForkID(status)
+	assert.Zero(t, status.ForkHash)
+}
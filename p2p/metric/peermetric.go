@@ -17,6 +17,9 @@ type PeerMetric struct {
 	Since    time.Time
 	totalIn  int64
 	totalOut int64
+	// savedIn and savedOut are bytes saved by payload compression
+	savedIn  int64
+	savedOut int64
 
 	InMetric DataMetric
 	OutMetric DataMetric
@@ -36,4 +39,22 @@ func (m *PeerMetric) InputAdded(added int) {
 
 func (m *PeerMetric) OutputAdded(added int) {
 	atomic.AddInt64(&m.totalOut, int64(added))
+}
+
+func (m *PeerMetric) SavedIn() int64 {
+	return atomic.LoadInt64(&m.savedIn)
+}
+
+func (m *PeerMetric) SavedOut() int64 {
+	return atomic.LoadInt64(&m.savedOut)
+}
+
+// InputCompressed is called when a compressed payload is received.
+func (m *PeerMetric) InputCompressed(compressed, raw int) {
+	atomic.AddInt64(&m.savedIn, int64(raw-compressed))
+}
+
+// OutputCompressed is called when a payload is compressed to send.
+func (m *PeerMetric) OutputCompressed(compressed, raw int) {
+	atomic.AddInt64(&m.savedOut, int64(raw-compressed))
 }
\ No newline at end of file
@@ -41,6 +41,9 @@ type P2P struct {
 	signer  p2pcommon.MsgSigner
 	ca      types.ChainAccessor
 
+	compressions []string
+	forks        *forkFilter
+
 	mutex sync.Mutex
 }
 
@@ -125,13 +128,33 @@ func (p2ps *P2P) initP2P(cfg *config.Config, chainsvc *chain.ChainService) {
 
 	useRaft := genesis.ConsensusType() == consensus.ConsensusName[consensus.ConsensusRAFT]
 
+	if err = ValidateCompressions(cfg.P2P.NPCompressions); err != nil {
+		panic("invalid npcompressions: " + err.Error())
+	}
+	p2ps.compressions = cfg.P2P.NPCompressions
+	p2ps.forks = newForkFilter(types.GetForks(), genesis.Block().BlockHash())
+
 	netTransport := transport.NewNetworkTransport(cfg.P2P, p2ps.Logger)
 	signer := newDefaultMsgSigner(p2pkey.NodePrivKey(), p2pkey.NodePubKey(), p2pkey.NodeID())
 
 	mf := &v030MOFactory{}
 	//reconMan := newReconnectManager(p2ps.Logger)
 	metricMan := metric.NewMetricManager(10)
-	peerMan := NewPeerManager(p2ps, p2ps, p2ps, cfg, signer, netTransport, metricMan, p2ps.Logger, mf, useRaft)
+	var discoverer p2pcommon.PeerDiscoverer
+	if cfg.P2P.NPDiscoverPeers && cfg.P2P.NPUseDHT {
+		dhtDisc, err := NewDHTDiscoverer(p2ps.Logger, netTransport, chainID)
+		if err != nil {
+			panic("failed to init dht discovery: " + err.Error())
+		}
+		discoverer = dhtDisc
+	}
+	peerMan := NewPeerManager(p2ps, p2ps, p2ps, cfg, signer, netTransport, metricMan, p2ps.Logger, mf, useRaft, discoverer)
+	// later changes are notified by chain service
+	allowedPeers, err := chainsvc.AllowedPeers()
+	if err != nil {
+		panic("failed to get allowlist of peers: " + err.Error())
+	}
+	peerMan.UpdateAllowedPeers(allowedPeers)
 	syncMan := newSyncManager(p2ps, peerMan, p2ps.Logger)
 
 	// connect managers each other
@@ -166,6 +189,10 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		p2ps.GetBlockHashes(context, msg)
 	case *message.GetHashByNo:
 		p2ps.GetBlockHashByNo(context, msg)
+	case *message.GetStateChunk:
+		p2ps.GetStateChunk(context, msg)
+	case *message.GetStateSQL:
+		p2ps.GetStateSQL(context, msg)
 	case *message.NotifyNewBlock:
 		if msg.Produced {
 			p2ps.NotifyBlockProduced(*msg)
@@ -186,6 +213,8 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		context.Respond(&message.GetPeersRsp{Peers: peers})
 	case *message.GetSyncAncestor:
 		p2ps.GetSyncAncestor(context, msg)
+	case *message.NotifyAllowedPeers:
+		p2ps.pm.UpdateAllowedPeers(msg.Peers)
 	case *message.MapQueryMsg:
 		bestBlock, err := p2ps.GetChainAccessor().GetBestBlock()
 		if err == nil {
@@ -291,10 +320,18 @@ func (p2ps *P2P) InsertHandlers(peer p2pcommon.RemotePeer) {
 	// BP protocol handlers
 	peer.AddMessageHandler(subproto.BlockProducedNotice, subproto.NewBlockProducedNoticeHandler(p2ps.pm, peer, logger, p2ps, p2ps.sm))
 
+	// snapshot sync handlers
+	peer.AddMessageHandler(subproto.GetStateChunkRequest, subproto.NewGetStateChunkReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(subproto.GetStateChunkResponse, subproto.NewGetStateChunkRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(subproto.GetStateSQLRequest, subproto.NewGetStateSQLReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(subproto.GetStateSQLResponse, subproto.NewGetStateSQLRespHandler(p2ps.pm, peer, logger, p2ps))
+
 }
 
 func (p2ps *P2P) CreateHSHandler(outbound bool, pm p2pcommon.PeerManager, actor p2pcommon.ActorService, log *log.Logger, pid peer.ID) p2pcommon.HSHandler {
 	handshakeHandler := newHandshaker(pm, actor, log, p2ps.chainID, pid)
+	handshakeHandler.compressions = p2ps.compressions
+	handshakeHandler.forks = p2ps.forks
 	if outbound {
 		return &OutboundHSHandler{PeerHandshaker: handshakeHandler}
 	} else {
@@ -68,6 +68,11 @@ type PeerManager interface {
 	GetPeerAddresses(noHidden bool, showSelf bool) []*message.PeerInfo
 
 	GetPeerBlockInfos() []types.PeerBlockInfo
+
+	// UpdateAllowedPeers sets the peers allowed to join the permissioned network. Empty ids means permissionless.
+	UpdateAllowedPeers(ids []peer.ID)
+	// IsAllowed reports whether the peer can join the network.
+	IsAllowed(id peer.ID) bool
 }
 type SyncManager interface {
 	// handle notice from bp
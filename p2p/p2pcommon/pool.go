@@ -16,6 +16,7 @@ const (
 	WaitingPeerManagerInterval = time.Minute
 
 	PolarisQueryInterval   = time.Minute * 10
+	DHTQueryInterval       = time.Minute * 5
 	PeerQueryInterval      = time.Hour
 	PeerFirstInterval      = time.Second * 4
 
@@ -43,6 +44,16 @@ type PeerFinder interface {
 	CheckAndFill()
 }
 
+// PeerDiscoverer finds peer candidates by itself, without help of polaris or connected peers.
+type PeerDiscoverer interface {
+	Start() error
+	Stop()
+
+	// FindPeers returns at most limit peers. It can block for a while, so caller should not call it in
+	// peer manager goroutine.
+	FindPeers(limit int) []PeerMeta
+}
+
 // WaitingPeerManager manage wait peer pool.
 type WaitingPeerManager interface {
 	PeerEventListener
@@ -196,3 +196,29 @@ func (mr *MockPeerManagerMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockPeerManager)(nil).Stop))
 }
+
+// UpdateAllowedPeers mocks base method
+func (m *MockPeerManager) UpdateAllowedPeers(arg0 []go_libp2p_peer.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateAllowedPeers", arg0)
+}
+
+// UpdateAllowedPeers indicates an expected call of UpdateAllowedPeers
+func (mr *MockPeerManagerMockRecorder) UpdateAllowedPeers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAllowedPeers", reflect.TypeOf((*MockPeerManager)(nil).UpdateAllowedPeers), arg0)
+}
+
+// IsAllowed mocks base method
+func (m *MockPeerManager) IsAllowed(arg0 go_libp2p_peer.ID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowed", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAllowed indicates an expected call of IsAllowed
+func (mr *MockPeerManagerMockRecorder) IsAllowed(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowed", reflect.TypeOf((*MockPeerManager)(nil).IsAllowed), arg0)
+}
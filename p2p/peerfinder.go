@@ -20,12 +20,13 @@ const (
 	firstReconnectColltime = time.Minute
 )
 
-func NewPeerFinder(logger *log.Logger, pm *peerManager, actorService p2pcommon.ActorService, maxCap int, useDiscover, usePolaris bool) p2pcommon.PeerFinder {
+// NewPeerFinder creates peer finder. discoverer can be nil if dht discovery is not used.
+func NewPeerFinder(logger *log.Logger, pm *peerManager, actorService p2pcommon.ActorService, maxCap int, useDiscover, usePolaris bool, discoverer p2pcommon.PeerDiscoverer) p2pcommon.PeerFinder {
 	var pf p2pcommon.PeerFinder
 	if !useDiscover {
 		pf = &staticPeerFinder{pm:pm, logger:logger}
 	} else {
-		dp := &dynamicPeerFinder{logger: logger, pm: pm, actorService: actorService, maxCap: maxCap, usePolaris:usePolaris}
+		dp := &dynamicPeerFinder{logger: logger, pm: pm, actorService: actorService, maxCap: maxCap, usePolaris:usePolaris, discoverer:discoverer}
 		dp.qStats = make(map[peer.ID]*queryStat)
 		pf = dp
 	}
@@ -65,6 +66,10 @@ type dynamicPeerFinder struct {
 	maxCap int
 
 	polarisTurn time.Time
+
+	// discoverer is nil if dht discovery is disabled
+	discoverer p2pcommon.PeerDiscoverer
+	dhtTurn    time.Time
 }
 
 var _ p2pcommon.PeerFinder = (*dynamicPeerFinder)(nil)
@@ -95,6 +100,16 @@ func (dp *dynamicPeerFinder) CheckAndFill() {
 		dp.logger.Debug().Time("next_turn", dp.polarisTurn).Msg("quering to polaris")
 		dp.actorService.SendRequest(message.P2PSvc, &message.MapQueryMsg{Count: MaxAddrListSizePolaris})
 	}
+	// query to dht. lookup in dht takes long time, so found peers are notified to peer manager asynchronously.
+	if dp.discoverer != nil && now.After(dp.dhtTurn) {
+		dp.dhtTurn = now.Add(p2pcommon.DHTQueryInterval)
+		dp.logger.Debug().Time("next_turn", dp.dhtTurn).Msg("quering to dht")
+		go func(discoverer p2pcommon.PeerDiscoverer) {
+			if metas := discoverer.FindPeers(MaxAddrListSizeDHT); len(metas) > 0 {
+				dp.pm.NotifyPeerAddressReceived(metas)
+			}
+		}(dp.discoverer)
+	}
 	// query to peers
 	queried := 0
 	for _, stat := range dp.qStats {
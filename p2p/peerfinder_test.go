@@ -75,7 +75,7 @@ func TestNewPeerFinder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			dummyPM := createDummyPM()
 			mockActor := p2pmock.NewMockActorService(ctrl)
-			got := NewPeerFinder(logger, dummyPM, mockActor, 10, tt.args.useDiscover, tt.args.usePolaris)
+			got := NewPeerFinder(logger, dummyPM, mockActor, 10, tt.args.useDiscover, tt.args.usePolaris, nil)
 			if reflect.TypeOf(got) != reflect.TypeOf(tt.want) {
 				t.Errorf("NewPeerFinder() = %v, want %v", reflect.TypeOf(got), reflect.TypeOf(tt.want))
 			}
@@ -107,7 +107,7 @@ func Test_dynamicPeerFinder_OnPeerDisconnect(t *testing.T) {
 			mockPeer.EXPECT().Meta().Return(tt.args.inMeta).AnyTimes()
 			mockPeer.EXPECT().Name().Return(p2putil.ShortMetaForm(tt.args.inMeta)).AnyTimes()
 
-			dp := NewPeerFinder(logger, dummyPM, mockActor, 10, true, false).(*dynamicPeerFinder)
+			dp := NewPeerFinder(logger, dummyPM, mockActor, 10, true, false, nil).(*dynamicPeerFinder)
 			for _, id := range tt.args.preConnected {
 				dummyPM.remotePeers[id] = &remotePeerImpl{}
 				dp.OnPeerConnect(id)
@@ -146,7 +146,7 @@ func Test_dynamicPeerFinder_OnPeerConnect(t *testing.T) {
 			mockPeer.EXPECT().Meta().Return(tt.args.inMeta).AnyTimes()
 			mockPeer.EXPECT().Name().Return(p2putil.ShortMetaForm(tt.args.inMeta)).AnyTimes()
 
-			dp := NewPeerFinder(logger, dummyPM, mockActor, 10, true, false).(*dynamicPeerFinder)
+			dp := NewPeerFinder(logger, dummyPM, mockActor, 10, true, false, nil).(*dynamicPeerFinder)
 
 			dp.OnPeerConnect(tt.args.inMeta.ID)
 
@@ -45,8 +45,12 @@ type peerManager struct {
 
 	peerFinder p2pcommon.PeerFinder
 	wpManager  p2pcommon.WaitingPeerManager
+	discoverer p2pcommon.PeerDiscoverer
 	// designatedPeers and hiddenPeerSet is set in construction time once and will not be changed
 	hiddenPeerSet map[peer.ID]bool
+	// allowedPeers holds map[peer.ID]bool of the on-chain allowlist. The network is permissionless if it is empty.
+	allowedPeers     atomic.Value
+	allowlistChanged chan struct{}
 
 	mutex        *sync.Mutex
 	manageNumber uint32
@@ -91,8 +95,8 @@ type PeerEventListener interface {
 	OnRemovePeer(peerID peer.ID)
 }
 
-// NewPeerManager creates a peer manager object.
-func NewPeerManager(handlerFactory p2pcommon.HandlerFactory, hsFactory p2pcommon.HSHandlerFactory, iServ p2pcommon.ActorService, cfg *cfg.Config, signer p2pcommon.MsgSigner, nt p2pcommon.NetworkTransport, mm metric.MetricsManager, logger *log.Logger, mf p2pcommon.MoFactory, skipHandshakeSync bool) p2pcommon.PeerManager {
+// NewPeerManager creates a peer manager object. discoverer can be nil if dht discovery is not used.
+func NewPeerManager(handlerFactory p2pcommon.HandlerFactory, hsFactory p2pcommon.HSHandlerFactory, iServ p2pcommon.ActorService, cfg *cfg.Config, signer p2pcommon.MsgSigner, nt p2pcommon.NetworkTransport, mm metric.MetricsManager, logger *log.Logger, mf p2pcommon.MoFactory, skipHandshakeSync bool, discoverer p2pcommon.PeerDiscoverer) p2pcommon.PeerManager {
 	p2pConf := cfg.P2P
 	//logger.SetLevel("debug")
 	pm := &peerManager{
@@ -107,6 +111,7 @@ func NewPeerManager(handlerFactory p2pcommon.HandlerFactory, hsFactory p2pcommon
 		logger:         logger,
 		mutex:          &sync.Mutex{},
 		skipHandshakeSync: skipHandshakeSync,
+		discoverer:        discoverer,
 
 		status:          initial,
 		designatedPeers: make(map[peer.ID]p2pcommon.PeerMeta, len(cfg.P2P.NPAddPeers)),
@@ -126,6 +131,7 @@ func NewPeerManager(handlerFactory p2pcommon.HandlerFactory, hsFactory p2pcommon
 		workDoneChannel:   make(chan p2pcommon.ConnWorkResult),
 		eventListeners:    make([]PeerEventListener, 0, 4),
 		finishChannel:     make(chan struct{}),
+		allowlistChanged:  make(chan struct{}, 1),
 	}
 
 	// additional initializations
@@ -153,7 +159,7 @@ func (pm *peerManager) init() {
 		pm.hiddenPeerSet[pid] = true
 	}
 
-	pm.peerFinder = NewPeerFinder(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris)
+	pm.peerFinder = NewPeerFinder(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris, pm.discoverer)
 	pm.wpManager = NewWaitingPeerManager(pm.logger, pm, pm.actorService, pm.conf.NPPeerPool, pm.conf.NPDiscoverPeers, pm.conf.NPUsePolaris)
 	// add designated peers to waiting pool at initial time.
 	for _, meta := range pm.designatedPeers {
@@ -165,6 +171,12 @@ func (pm *peerManager) init() {
 }
 
 func (pm *peerManager) Start() error {
+	if pm.discoverer != nil {
+		if err := pm.discoverer.Start(); err != nil {
+			pm.logger.Warn().Err(err).Msg("failed to start DHT discovery. peers will be found without it")
+			pm.discoverer = nil
+		}
+	}
 	go pm.runManagePeers()
 
 	return nil
@@ -225,6 +237,10 @@ MANLOOP:
 				pm.wpManager.OnPeerConnect(peer.ID())
 
 				pm.checkSync(peer)
+				// allowlist can be changed during handshake
+				if !pm.IsAllowed(peer.ID()) {
+					go peer.Stop()
+				}
 			}
 		case peer := <-pm.removePeerChannel:
 			if pm.removePeer(peer) {
@@ -263,12 +279,17 @@ MANLOOP:
 				}
 				connManTimer.Reset(instantStart)
 			}
+		case <-pm.allowlistChanged:
+			pm.dropNotAllowedPeers()
 		case <-pm.finishChannel:
 			finderTimer.Stop()
 			connManTimer.Stop()
 			break MANLOOP
 		}
 	}
+	if pm.discoverer != nil {
+		pm.discoverer.Stop()
+	}
 	// guarrenty no new peer connection will be made
 	pm.nt.RemoveStreamHandler(p2pcommon.AergoP2PSub)
 	pm.logger.Info().Msg("Finishing peerManager")
@@ -457,3 +478,44 @@ func (pm *peerManager) checkSync(peer p2pcommon.RemotePeer) {
 	pm.logger.Debug().Uint64("target", peer.LastStatus().BlockNumber).Msg("request new syncer")
 	pm.actorService.SendRequest(message.SyncerSvc, &message.SyncStart{PeerID: peer.ID(), TargetNo: peer.LastStatus().BlockNumber})
 }
+
+// UpdateAllowedPeers sets the allowlist of permissioned network. The connected or waiting peers which are not in
+// the allowlist are dropped. The network is permissionless if ids is empty.
+func (pm *peerManager) UpdateAllowedPeers(ids []peer.ID) {
+	allowed := make(map[peer.ID]bool, len(ids))
+	for _, id := range ids {
+		allowed[id] = true
+	}
+	pm.allowedPeers.Store(allowed)
+	if len(allowed) > 0 && !allowed[pm.SelfNodeID()] {
+		pm.logger.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(pm.SelfNodeID())).Msg("This node is not in allowlist, so other peers will refuse connections")
+	}
+	pm.logger.Info().Int("peers", len(allowed)).Bool("permissioned", len(allowed) > 0).Msg("allowlist of peers is updated")
+	select {
+	case pm.allowlistChanged <- struct{}{}:
+	default:
+		// dropping is already pending
+	}
+}
+
+// IsAllowed reports whether the peer can join the network.
+func (pm *peerManager) IsAllowed(id peer.ID) bool {
+	allowed, _ := pm.allowedPeers.Load().(map[peer.ID]bool)
+	return len(allowed) == 0 || allowed[id]
+}
+
+// dropNotAllowedPeers disconnects the peers not in the allowlist and removes them from waiting pool.
+// It must be called in peermanager goroutine
+func (pm *peerManager) dropNotAllowedPeers() {
+	for id, rPeer := range pm.remotePeers {
+		if !pm.IsAllowed(id) {
+			pm.logger.Info().Str(p2putil.LogPeerName, rPeer.Name()).Msg("Disconnect peer which is not in allowlist")
+			go rPeer.Stop()
+		}
+	}
+	for id := range pm.waitingPeers {
+		if !pm.IsAllowed(id) {
+			delete(pm.waitingPeers, id)
+		}
+	}
+}
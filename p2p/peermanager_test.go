@@ -34,7 +34,7 @@ func FailTestGetPeers(t *testing.T) {
 	target := NewPeerManager(nil, nil, mockActorServ,
 		cfg.NewServerContext("", "").GetDefaultConfig().(*cfg.Config),
 		nil, nil, nil,
-		log.NewLogger("test.p2p"), mockMF, false).(*peerManager)
+		log.NewLogger("test.p2p"), mockMF, false, nil).(*peerManager)
 
 	iterSize := 500
 	wg := sync.WaitGroup{}
@@ -75,7 +75,7 @@ func TestPeerManager_GetPeers(t *testing.T) {
 	target := NewPeerManager(nil, nil, mockActorServ,
 		tConfig,
 		nil, nil, nil,
-		tLogger, mockMF, false).(*peerManager)
+		tLogger, mockMF, false, nil).(*peerManager)
 
 	iterSize := 500
 	wg := &sync.WaitGroup{}
@@ -415,3 +415,24 @@ func toMStatusName(status int32) string {
 		return "(invalid)" + strconv.Itoa(int(status))
 	}
 }
+
+func Test_peerManager_UpdateAllowedPeers(t *testing.T) {
+	tConfig := cfg.NewServerContext("", "").GetDefaultConfig().(*cfg.Config)
+	p2pkey.InitNodeInfo(&tConfig.BaseConfig, tConfig.P2P, "1.0.0-test", logger)
+	pm := &peerManager{logger: logger, allowlistChanged: make(chan struct{}, 1),
+		remotePeers: make(map[peer.ID]p2pcommon.RemotePeer), waitingPeers: make(map[peer.ID]*p2pcommon.WaitingPeer)}
+	assert.True(t, pm.IsAllowed(dummyPeerID), "all peers are allowed before allowlist is set")
+
+	pm.waitingPeers[dummyPeerID2] = &p2pcommon.WaitingPeer{}
+	pm.UpdateAllowedPeers([]peer.ID{dummyPeerID})
+	pm.UpdateAllowedPeers([]peer.ID{dummyPeerID})
+	assert.Equal(t, 1, len(pm.allowlistChanged), "signal is not blocked by pending one")
+	assert.True(t, pm.IsAllowed(dummyPeerID))
+	assert.False(t, pm.IsAllowed(dummyPeerID2))
+
+	pm.dropNotAllowedPeers()
+	assert.Equal(t, 0, len(pm.waitingPeers))
+
+	pm.UpdateAllowedPeers(nil)
+	assert.True(t, pm.IsAllowed(dummyPeerID2))
+}
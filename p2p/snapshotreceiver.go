@@ -0,0 +1,113 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/subproto"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// StateChunkReceiver send p2p getStateChunkRequest to target peer and receive a chunk of state trie for snapshot sync.
+// It will send response actor message if the chunk is received or failed to receive, but not send response if
+// timeout expired.
+type StateChunkReceiver struct {
+	syncerSeq uint64
+	requestID p2pcommon.MsgID
+
+	peer  p2pcommon.RemotePeer
+	actor p2pcommon.ActorService
+
+	req      *message.GetStateChunk
+	timeout  time.Time
+	finished bool
+}
+
+func NewStateChunkReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, seq uint64, req *message.GetStateChunk, ttl time.Duration) *StateChunkReceiver {
+	timeout := time.Now().Add(ttl)
+	return &StateChunkReceiver{syncerSeq: seq, actor: actor, peer: peer, req: req, timeout: timeout}
+}
+
+func (br *StateChunkReceiver) StartGet() {
+	// create message data
+	req := &types.GetStateChunkRequest{Root: br.req.Root, Start: br.req.Start, End: br.req.End, Accounts: br.req.Accounts}
+	mo := br.peer.MF().NewMsgBlockRequestOrder(br.ReceiveResp, subproto.GetStateChunkRequest, req)
+	br.requestID = mo.GetMsgID()
+	br.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (br *StateChunkReceiver) ReceiveResp(msg p2pcommon.Message, msgBody proto.Message) (ret bool) {
+	ret = true
+	defer func() {
+		br.finished = true
+		br.peer.ConsumeRequest(br.requestID)
+	}()
+	// timeout
+	if br.finished || br.timeout.Before(time.Now()) {
+		// silently ignore already finished job
+		return
+	}
+	// remote peer response failure
+	body := msgBody.(*types.GetStateChunkResponse)
+	if body.Status != types.ResultStatus_OK {
+		br.actor.TellRequest(message.SyncerSvc, &message.GetStateChunkRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Err: message.RemotePeerFailError})
+		return
+	}
+	br.actor.TellRequest(message.SyncerSvc, &message.GetStateChunkRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Entries: body.Entries, HasNext: body.HasNext})
+	return
+}
+
+// StateSQLReceiver send p2p getStateSQLRequest to target peer and receive a part of sql database of contract for
+// snapshot sync.
+type StateSQLReceiver struct {
+	syncerSeq uint64
+	requestID p2pcommon.MsgID
+
+	peer  p2pcommon.RemotePeer
+	actor p2pcommon.ActorService
+
+	req      *message.GetStateSQL
+	timeout  time.Time
+	finished bool
+}
+
+func NewStateSQLReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, seq uint64, req *message.GetStateSQL, ttl time.Duration) *StateSQLReceiver {
+	timeout := time.Now().Add(ttl)
+	return &StateSQLReceiver{syncerSeq: seq, actor: actor, peer: peer, req: req, timeout: timeout}
+}
+
+func (br *StateSQLReceiver) StartGet() {
+	req := &types.GetStateSQLRequest{Name: br.req.Name, Version: br.req.Version, Offset: br.req.Offset}
+	mo := br.peer.MF().NewMsgBlockRequestOrder(br.ReceiveResp, subproto.GetStateSQLRequest, req)
+	br.requestID = mo.GetMsgID()
+	br.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (br *StateSQLReceiver) ReceiveResp(msg p2pcommon.Message, msgBody proto.Message) (ret bool) {
+	ret = true
+	defer func() {
+		br.finished = true
+		br.peer.ConsumeRequest(br.requestID)
+	}()
+	if br.finished || br.timeout.Before(time.Now()) {
+		return
+	}
+	body := msgBody.(*types.GetStateSQLResponse)
+	if body.Status != types.ResultStatus_OK {
+		br.actor.TellRequest(message.SyncerSvc, &message.GetStateSQLRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(),
+			Name: br.req.Name, Offset: br.req.Offset, Err: message.RemotePeerFailError})
+		return
+	}
+	br.actor.TellRequest(message.SyncerSvc, &message.GetStateSQLRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(),
+		Name: br.req.Name, Offset: br.req.Offset, Version: body.Version, Data: body.Data, Size: body.Size})
+	return
+}
@@ -78,6 +78,12 @@ func (bh *blockRequestHandler) Handle(msg p2pcommon.Message, msgBody proto.Messa
 			break
 
 		}
+		if foundBlock.Body == nil {
+			// the body of block is pruned by the retention policy of this node
+			bh.logger.Debug().Str(p2putil.LogBlkHash, enc.ToString(hash)).Str(p2putil.LogOrgReqID, requestID.String()).Msg("requested block is pruned")
+			status = types.ResultStatus_NOT_FOUND
+			break
+		}
 		blockSize = proto.Size(foundBlock)
 		fieldSize = blockSize + p2putil.CalculateFieldDescSize(blockSize)
 		if len(blockInfos) >= sliceCap || (payloadSize+fieldSize) > p2pcommon.MaxPayloadLength {
@@ -30,7 +30,8 @@ func TestBlockRequestHandler_handle(t *testing.T) {
 
 	bigHash := make([]byte, 2*1024*1024)
 	//validSmallBlockRsp := &message.GetBlockRsp{Block:&types.Block{Hash:make([]byte,40)},Err:nil}
-	validBlock := &types.Block{Hash: bigHash}
+	validBlock := &types.Block{Hash: bigHash, Body: &types.BlockBody{}}
+	prunedBlock := &types.Block{Hash: bigHash}
 	//validBigBlockRsp := message.GetBlockRsp{Block:validBlock,Err:nil}
 	//notExistBlockRsp := message.GetBlockRsp{Block:nil,Err:nil}
 	//dummyMO := p2pmock.NewMockMsgOrder(ctrl)
@@ -40,12 +41,15 @@ func TestBlockRequestHandler_handle(t *testing.T) {
 		validCallCount    int
 		expectedSendCount int
 		succResult        bool
+		pruned            bool
 	}{
-		{"TSingle", 1, 1, 1, true},
+		{"TSingle", 1, 1, 1, true, false},
 		// not found return err result (ResultStatus_NOT_FOUND)
-		{"TNotFounds", 10, 0, 1, false},
-		{"TFound10", 100, 10, 4, false},
-		{"TFoundAll", 20, 100, 7, true},
+		{"TNotFounds", 10, 0, 1, false, false},
+		{"TFound10", 100, 10, 4, false, false},
+		{"TFoundAll", 20, 100, 7, true, false},
+		// the block of which body is pruned is not served
+		{"TPruned", 10, 10, 1, false, true},
 		// TODO: test cases
 	}
 	for _, test := range tests {
@@ -66,6 +70,9 @@ func TestBlockRequestHandler_handle(t *testing.T) {
 			mockCA.EXPECT().GetBlock(gomock.Any()).DoAndReturn(func(blockHash []byte) (*types.Block, error) {
 				callReqCount++
 				if callReqCount <= test.validCallCount {
+					if test.pruned {
+						return prunedBlock, nil
+					}
 					return validBlock, nil
 				}
 				return nil, nil
@@ -0,0 +1,137 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// snapshotTimeout is longer than default, since reading a chunk of state with merkle proofs or copying a sql
+// database takes time
+const snapshotTimeout = time.Second * 30
+
+type getStateChunkRequestHandler struct {
+	BaseMsgHandler
+}
+
+type getStateChunkResponseHandler struct {
+	BaseMsgHandler
+}
+
+// NewGetStateChunkReqHandler creates handler for GetStateChunkRequest
+func NewGetStateChunkReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateChunkRequestHandler {
+	bh := &getStateChunkRequestHandler{BaseMsgHandler: BaseMsgHandler{protocol: GetStateChunkRequest, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateChunkRequestHandler) ParsePayload(rawbytes []byte) (proto.Message, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateChunkRequest{})
+}
+
+func (bh *getStateChunkRequestHandler) Handle(msg p2pcommon.Message, msgBody proto.Message) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetStateChunkRequest)
+	p2putil.DebugLogReceiveMsg(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+
+	// reading chunk takes time, so don't block the read loop of peer
+	go func() {
+		resp := &types.GetStateChunkResponse{Status: types.ResultStatus_OK}
+		rawResponse, err := bh.actor.CallRequest(message.ChainSvc,
+			&message.GetSnapshotChunk{Root: data.Root, Start: data.Start, End: data.End, Accounts: data.Accounts}, snapshotTimeout)
+		if err != nil {
+			resp.Status = types.ResultStatus_ABORTED
+		} else if result := rawResponse.(*message.GetSnapshotChunkRsp); result.Err != nil {
+			bh.logger.Debug().Err(result.Err).Str(p2putil.LogPeerName, remotePeer.Name()).Msg("failed to read state chunk")
+			resp.Status = types.ResultStatus_NOT_FOUND
+		} else {
+			resp.Entries, resp.HasNext = result.Entries, result.HasNext
+		}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), GetStateChunkResponse, resp))
+	}()
+}
+
+// NewGetStateChunkRespHandler creates handler for GetStateChunkResponse
+func NewGetStateChunkRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateChunkResponseHandler {
+	bh := &getStateChunkResponseHandler{BaseMsgHandler: BaseMsgHandler{protocol: GetStateChunkResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateChunkResponseHandler) ParsePayload(rawbytes []byte) (proto.Message, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateChunkResponse{})
+}
+
+func (bh *getStateChunkResponseHandler) Handle(msg p2pcommon.Message, msgBody proto.Message) {
+	data := msgBody.(*types.GetStateChunkResponse)
+	p2putil.DebugLogReceiveResponseMsg(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, fmt.Sprintf("status=%d,entries=%d,hasNext=%t", data.Status, len(data.Entries), data.HasNext))
+
+	// locate request data and remove it if found
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
+
+type getStateSQLRequestHandler struct {
+	BaseMsgHandler
+}
+
+type getStateSQLResponseHandler struct {
+	BaseMsgHandler
+}
+
+// NewGetStateSQLReqHandler creates handler for GetStateSQLRequest
+func NewGetStateSQLReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateSQLRequestHandler {
+	bh := &getStateSQLRequestHandler{BaseMsgHandler: BaseMsgHandler{protocol: GetStateSQLRequest, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateSQLRequestHandler) ParsePayload(rawbytes []byte) (proto.Message, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateSQLRequest{})
+}
+
+func (bh *getStateSQLRequestHandler) Handle(msg p2pcommon.Message, msgBody proto.Message) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetStateSQLRequest)
+	p2putil.DebugLogReceiveMsg(bh.logger, bh.protocol, msg.ID().String(), remotePeer, fmt.Sprintf("name=%s,version=%d,offset=%d", data.Name, data.Version, data.Offset))
+
+	go func() {
+		resp := &types.GetStateSQLResponse{Status: types.ResultStatus_OK}
+		rawResponse, err := bh.actor.CallRequest(message.ChainSvc,
+			&message.GetSnapshotSQL{Name: data.Name, Version: data.Version, Offset: data.Offset}, snapshotTimeout)
+		if err != nil {
+			resp.Status = types.ResultStatus_ABORTED
+		} else if result := rawResponse.(*message.GetSnapshotSQLRsp); result.Err != nil {
+			bh.logger.Debug().Err(result.Err).Str(p2putil.LogPeerName, remotePeer.Name()).Msg("failed to read sql database")
+			resp.Status = types.ResultStatus_NOT_FOUND
+		} else {
+			resp.Version, resp.Data, resp.Size = result.Version, result.Data, result.Size
+		}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), GetStateSQLResponse, resp))
+	}()
+}
+
+// NewGetStateSQLRespHandler creates handler for GetStateSQLResponse
+func NewGetStateSQLRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateSQLResponseHandler {
+	bh := &getStateSQLResponseHandler{BaseMsgHandler: BaseMsgHandler{protocol: GetStateSQLResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateSQLResponseHandler) ParsePayload(rawbytes []byte) (proto.Message, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateSQLResponse{})
+}
+
+func (bh *getStateSQLResponseHandler) Handle(msg p2pcommon.Message, msgBody proto.Message) {
+	data := msgBody.(*types.GetStateSQLResponse)
+	p2putil.DebugLogReceiveResponseMsg(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, fmt.Sprintf("status=%d,version=%d,len=%d", data.Status, data.Version, len(data.Data)))
+
+	// locate request data and remove it if found
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
@@ -39,4 +39,12 @@ const (
 	BlockProducedNotice p2pcommon.SubProtocol = 0x030 + iota
 )
 
+// subprotocols for snapshot sync, which downloads state of a block instead of executing all blocks
+const (
+	GetStateChunkRequest p2pcommon.SubProtocol = 0x040 + iota
+	GetStateChunkResponse
+	GetStateSQLRequest
+	GetStateSQLResponse
+)
+
 //go:generate stringer -type=SubProtocol
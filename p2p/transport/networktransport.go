@@ -215,7 +215,28 @@ func (sl *networkTransport) startListener() {
 
 	peerStore := pstore.NewPeerstore(pstoremem.NewKeyBook(), pstoremem.NewAddrBook(), pstoremem.NewPeerMetadata())
 
-	newHost, err := libp2p.New(context.Background(), libp2p.Identity(sl.privateKey), libp2p.Peerstore(peerStore), libp2p.ListenAddrs(listens...))
+	opts := []libp2p.Option{libp2p.Identity(sl.privateKey), libp2p.Peerstore(peerStore), libp2p.ListenAddrs(listens...)}
+	if sl.conf.NPAdvertiseOnly {
+		// the peers must know only the advertised address, not the bound one
+		advertised, err := p2putil.PeerMetaToMultiAddr(sl.selfMeta)
+		if err != nil {
+			panic("Can't estabilish advertised address: " + err.Error())
+		}
+		opts = append(opts, libp2p.AddrsFactory(func([]ma.Multiaddr) []ma.Multiaddr {
+			return []ma.Multiaddr{advertised}
+		}))
+	}
+	if sl.conf.NPEnableTLS {
+		tlsTransport, err := newTLSTransport(sl.conf, sl.privateKey, sl.logger)
+		if err != nil {
+			sl.logger.Fatal().Err(err).Msg("Invalid tls configuration of N2N network")
+			panic(err.Error())
+		}
+		// only peers using tls can connect
+		opts = append(opts, libp2p.Security(TLSProtocolID, tlsTransport))
+		sl.logger.Info().Bool("ca_required", tlsTransport.caPool != nil).Msg("TLS is enabled on N2N network")
+	}
+	newHost, err := libp2p.New(context.Background(), opts...)
 	if err != nil {
 		sl.logger.Fatal().Err(err).Str("addr", listen.String()).Msg("Couldn't listen from")
 		panic(err.Error())
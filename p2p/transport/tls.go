@@ -0,0 +1,323 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	connsec "github.com/libp2p/go-conn-security"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// TLSProtocolID is the security protocol negotiated instead of secio when nptls is enabled.
+const TLSProtocolID = "/aergo-tls/1.0.0"
+
+const identityProofPrefix = "aergo-tls-identity:"
+const maxIdentityProofSize = 1 << 12
+
+var (
+	ErrNoCertificate     = errors.New("peer presented no certificate")
+	ErrCertIDMismatch    = errors.New("peer id in certificate is different from the expected one")
+	ErrInvalidIDProof    = errors.New("invalid proof of peer id")
+	ErrCACertNotProvided = errors.New("npcacert requires npcert and npcertkey signed by the ca")
+)
+
+// tlsTransport is the libp2p security transport based on TLS 1.2+. The certificate of each node pins its peer id
+// in the subject common name, and after the TLS handshake each side proves that it owns the private key of the
+// peer id by signing its own certificate. If a ca certificate is configured, a certificate which is not signed by
+// the ca is rejected before any aergo protocol runs.
+type tlsTransport struct {
+	localID peer.ID
+	privKey crypto.PrivKey
+
+	cert   tls.Certificate
+	caPool *x509.CertPool
+	logger *log.Logger
+}
+
+var _ connsec.Transport = (*tlsTransport)(nil)
+
+func newTLSTransport(conf *cfg.P2PConfig, privKey crypto.PrivKey, logger *log.Logger) (*tlsTransport, error) {
+	id, err := peer.IDFromPrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	t := &tlsTransport{localID: id, privKey: privKey, logger: logger}
+	if len(conf.NPCACert) > 0 {
+		if len(conf.NPCert) == 0 || len(conf.NPCertKey) == 0 {
+			return nil, ErrCACertNotProvided
+		}
+		pem, err := ioutil.ReadFile(conf.NPCACert)
+		if err != nil {
+			return nil, err
+		}
+		t.caPool = x509.NewCertPool()
+		if !t.caPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in npcacert %s", conf.NPCACert)
+		}
+	}
+	if len(conf.NPCert) > 0 {
+		if t.cert, err = tls.LoadX509KeyPair(conf.NPCert, conf.NPCertKey); err != nil {
+			return nil, err
+		}
+		if t.cert.Leaf, err = x509.ParseCertificate(t.cert.Certificate[0]); err != nil {
+			return nil, err
+		}
+		if t.cert.Leaf.Subject.CommonName != id.Pretty() {
+			return nil, fmt.Errorf("common name of npcert must be the peer id %s", id.Pretty())
+		}
+	} else {
+		if t.cert, err = selfSignedCert(id); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// selfSignedCert generates a temporary certificate for a node which doesn't configure npcert.
+func selfSignedCert(id peer.ID) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: id.Pretty()},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour * 24 * 365),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, nil
+}
+
+// config returns tls config which verifies the certificate of remote peer. expected is empty for inbound
+// connections.
+func (t *tlsTransport) config(expected peer.ID) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		MinVersion:   tls.VersionTLS12,
+		ClientAuth:   tls.RequireAnyClientCert,
+		// the chain and the pinned peer id are verified by verifyCertificate instead of host name.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, err := t.verifyCertificate(rawCerts, expected)
+			return err
+		},
+	}
+}
+
+// verifyCertificate checks that the certificate is signed by the ca if configured, and returns the peer id pinned
+// in it.
+func (t *tlsTransport) verifyCertificate(rawCerts [][]byte, expected peer.ID) (peer.ID, error) {
+	if len(rawCerts) == 0 {
+		return "", ErrNoCertificate
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return "", err
+		}
+		certs[i] = cert
+	}
+	leaf := certs[0]
+	if t.caPool != nil {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		opts := x509.VerifyOptions{
+			Roots:         t.caPool,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}
+		if _, err := leaf.Verify(opts); err != nil {
+			return "", err
+		}
+	} else if now := time.Now(); now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return "", errors.New("certificate is expired or not yet valid")
+	}
+	id, err := peer.IDB58Decode(leaf.Subject.CommonName)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer id in certificate: %s", err.Error())
+	}
+	if len(expected) > 0 && id != expected {
+		return "", ErrCertIDMismatch
+	}
+	return id, nil
+}
+
+func (t *tlsTransport) SecureInbound(ctx context.Context, insecure net.Conn) (connsec.Conn, error) {
+	conn := tls.Server(insecure, t.config(""))
+	return t.handshake(ctx, insecure, conn, "")
+}
+
+func (t *tlsTransport) SecureOutbound(ctx context.Context, insecure net.Conn, p peer.ID) (connsec.Conn, error) {
+	conn := tls.Client(insecure, t.config(p))
+	return t.handshake(ctx, insecure, conn, p)
+}
+
+func (t *tlsTransport) handshake(ctx context.Context, insecure net.Conn, conn *tls.Conn, expected peer.ID) (connsec.Conn, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		insecure.SetDeadline(deadline)
+		defer insecure.SetDeadline(time.Time{})
+	}
+	if err := conn.Handshake(); err != nil {
+		t.logger.Info().Err(err).Str("addr", insecure.RemoteAddr().String()).Msg("Failed tls handshake")
+		conn.Close()
+		return nil, err
+	}
+	remoteID, remotePub, err := t.exchangeIdentity(conn, expected)
+	if err != nil {
+		t.logger.Info().Err(err).Str("addr", insecure.RemoteAddr().String()).Msg("Failed to authenticate peer by tls")
+		conn.Close()
+		return nil, err
+	}
+	leaf := conn.ConnectionState().PeerCertificates[0]
+	t.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(remoteID)).Str("issuer", leaf.Issuer.String()).
+		Str("serial", leaf.SerialNumber.String()).Msg("Peer authenticated by tls")
+	return &tlsConn{Conn: conn, localID: t.localID, privKey: t.privKey, remoteID: remoteID, remotePub: remotePub}, nil
+}
+
+// exchangeIdentity sends the public key of node and its signature of own certificate, and verifies those of
+// remote peer in the same way.
+func (t *tlsTransport) exchangeIdentity(conn *tls.Conn, expected peer.ID) (peer.ID, crypto.PubKey, error) {
+	pubBytes, err := crypto.MarshalPublicKey(t.privKey.GetPublic())
+	if err != nil {
+		return "", nil, err
+	}
+	sig, err := t.privKey.Sign(identityProofData(t.cert.Certificate[0]))
+	if err != nil {
+		return "", nil, err
+	}
+	// write in another goroutine, since both sides write first
+	writeErr := make(chan error, 1)
+	go func() {
+		writeErr <- writeChunks(conn, pubBytes, sig)
+	}()
+	chunks, err := readChunks(conn, 2)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := <-writeErr; err != nil {
+		return "", nil, err
+	}
+
+	rawCerts := conn.ConnectionState().PeerCertificates
+	if len(rawCerts) == 0 {
+		return "", nil, ErrNoCertificate
+	}
+	certID, err := peer.IDB58Decode(rawCerts[0].Subject.CommonName)
+	if err != nil {
+		return "", nil, ErrInvalidIDProof
+	}
+	remotePub, err := crypto.UnmarshalPublicKey(chunks[0])
+	if err != nil {
+		return "", nil, ErrInvalidIDProof
+	}
+	if !certID.MatchesPublicKey(remotePub) || (len(expected) > 0 && certID != expected) {
+		return "", nil, ErrCertIDMismatch
+	}
+	valid, err := remotePub.Verify(identityProofData(rawCerts[0].Raw), chunks[1])
+	if err != nil || !valid {
+		return "", nil, ErrInvalidIDProof
+	}
+	return certID, remotePub, nil
+}
+
+func identityProofData(certDER []byte) []byte {
+	hash := sha256.Sum256(certDER)
+	return append([]byte(identityProofPrefix), hash[:]...)
+}
+
+func writeChunks(w io.Writer, chunks ...[]byte) error {
+	for _, chunk := range chunks {
+		length := make([]byte, 2)
+		binary.BigEndian.PutUint16(length, uint16(len(chunk)))
+		if _, err := w.Write(append(length, chunk...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readChunks(r io.Reader, count int) ([][]byte, error) {
+	chunks := make([][]byte, count)
+	length := make([]byte, 2)
+	for i := range chunks {
+		if _, err := io.ReadFull(r, length); err != nil {
+			return nil, err
+		}
+		size := binary.BigEndian.Uint16(length)
+		if size > maxIdentityProofSize {
+			return nil, ErrInvalidIDProof
+		}
+		chunks[i] = make([]byte, size)
+		if _, err := io.ReadFull(r, chunks[i]); err != nil {
+			return nil, err
+		}
+	}
+	return chunks, nil
+}
+
+// tlsConn is the connection secured by tlsTransport
+type tlsConn struct {
+	*tls.Conn
+	localID   peer.ID
+	privKey   crypto.PrivKey
+	remoteID  peer.ID
+	remotePub crypto.PubKey
+}
+
+var _ connsec.Conn = (*tlsConn)(nil)
+
+func (c *tlsConn) LocalPeer() peer.ID {
+	return c.localID
+}
+
+func (c *tlsConn) LocalPrivateKey() crypto.PrivKey {
+	return c.privKey
+}
+
+func (c *tlsConn) RemotePeer() peer.ID {
+	return c.remoteID
+}
+
+func (c *tlsConn) RemotePublicKey() crypto.PubKey {
+	return c.remotePub
+}
@@ -0,0 +1,194 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	cfg "github.com/aergoio/aergo/config"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T, dir, name string) *testCA {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, _ := x509.ParseCertificate(der)
+	file := filepath.Join(dir, name+".crt")
+	writePEM(t, file, "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue writes the certificate of id signed by ca and its key, and returns the p2p config using them.
+func (ca *testCA) issue(t *testing.T, dir string, id peer.ID) *cfg.P2PConfig {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: id.Pretty()},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	conf := &cfg.P2PConfig{
+		NPEnableTLS: true,
+		NPCert:      filepath.Join(dir, id.Pretty()+".crt"),
+		NPCertKey:   filepath.Join(dir, id.Pretty()+".key"),
+	}
+	writePEM(t, conf.NPCert, "CERTIFICATE", der)
+	writePEM(t, conf.NPCertKey, "EC PRIVATE KEY", keyDer)
+	return conf
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	err := ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	assert.NoError(t, err)
+}
+
+func newTestKey() (crypto.PrivKey, peer.ID) {
+	priv, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	id, _ := peer.IDFromPrivateKey(priv)
+	return priv, id
+}
+
+// connect runs the handshake between the server and the client, which expects the server to be expected.
+func connect(server, client *tlsTransport, expected peer.ID) (serverErr, clientErr error) {
+	sConn, cConn := net.Pipe()
+	defer sConn.Close()
+	defer cConn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		conn, err := server.SecureInbound(ctx, sConn)
+		if err == nil && conn.RemotePeer() != client.localID {
+			err = ErrCertIDMismatch
+		}
+		if err != nil {
+			// unblock the client waiting for the handshake
+			sConn.Close()
+		}
+		done <- err
+	}()
+	conn, clientErr := client.SecureOutbound(ctx, cConn, expected)
+	if clientErr == nil && conn.RemotePeer() != expected {
+		clientErr = ErrCertIDMismatch
+	}
+	if clientErr != nil {
+		cConn.Close()
+	}
+	return <-done, clientErr
+}
+
+func TestTLSTransportSelfSigned(t *testing.T) {
+	logger := log.NewLogger("test.p2p")
+	sPriv, sID := newTestKey()
+	cPriv, _ := newTestKey()
+	_, otherID := newTestKey()
+	conf := &cfg.P2PConfig{NPEnableTLS: true}
+
+	server, err := newTLSTransport(conf, sPriv, logger)
+	assert.NoError(t, err)
+	client, err := newTLSTransport(conf, cPriv, logger)
+	assert.NoError(t, err)
+
+	sErr, cErr := connect(server, client, sID)
+	assert.NoError(t, sErr)
+	assert.NoError(t, cErr)
+
+	// the certificate is pinned to the peer id
+	_, cErr = connect(server, client, otherID)
+	assert.Error(t, cErr)
+
+	// a node can't pretend to be another peer by copying its certificate
+	impostor, err := newTLSTransport(conf, cPriv, logger)
+	assert.NoError(t, err)
+	impostor.cert = server.cert
+	sErr, _ = connect(server, impostor, sID)
+	assert.Error(t, sErr)
+}
+
+func TestTLSTransportRequireCA(t *testing.T) {
+	logger := log.NewLogger("test.p2p")
+	dir, err := ioutil.TempDir("", "tlstest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t, dir, "ca")
+	otherCA := newTestCA(t, dir, "otherca")
+	sPriv, sID := newTestKey()
+	cPriv, cID := newTestKey()
+
+	sConf := ca.issue(t, dir, sID)
+	sConf.NPCACert = ca.file
+	server, err := newTLSTransport(sConf, sPriv, logger)
+	assert.NoError(t, err)
+
+	// npcacert without the certificate of node
+	_, err = newTLSTransport(&cfg.P2PConfig{NPEnableTLS: true, NPCACert: ca.file}, cPriv, logger)
+	assert.Equal(t, ErrCACertNotProvided, err)
+	// the common name of certificate must be the peer id of node
+	_, err = newTLSTransport(sConf, cPriv, logger)
+	assert.Error(t, err)
+
+	// self-signed certificate is rejected by the server
+	selfSigned, err := newTLSTransport(&cfg.P2PConfig{NPEnableTLS: true}, cPriv, logger)
+	assert.NoError(t, err)
+	sErr, _ := connect(server, selfSigned, sID)
+	assert.Error(t, sErr)
+
+	cConf := ca.issue(t, dir, cID)
+	cConf.NPCACert = ca.file
+	client, err := newTLSTransport(cConf, cPriv, logger)
+	assert.NoError(t, err)
+	sErr, cErr := connect(server, client, sID)
+	assert.NoError(t, sErr)
+	assert.NoError(t, cErr)
+
+	// certificate signed by other ca
+	otherDir := filepath.Join(dir, "other")
+	assert.NoError(t, os.Mkdir(otherDir, 0700))
+	otherConf := otherCA.issue(t, otherDir, cID)
+	other, err := newTLSTransport(otherConf, cPriv, logger)
+	assert.NoError(t, err)
+	sErr, _ = connect(server, other, sID)
+	assert.Error(t, sErr)
+}
@@ -0,0 +1,124 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
+)
+
+// names of payload compression algorithms, which are exchanged in status message of handshake
+const (
+	CompressionZstd   = "zstd"
+	CompressionSnappy = "snappy"
+)
+
+// ids of compression algorithm, written in the flag byte of message header. zero means not compressed.
+const (
+	compressNone byte = iota
+	compressSnappy
+	compressZstd
+)
+
+// payload smaller than this is sent as is, since compression of small messages such as ping or a notice
+// of few hashes saves little or even makes it bigger.
+const minCompressSize = 1 << 10
+
+var ErrDecompressedTooBig = errors.New("decompressed payload exceeds the limit")
+
+type compressor struct {
+	id         byte
+	name       string
+	compress   func(src []byte) ([]byte, error)
+	decompress func(src []byte, limit int) ([]byte, error)
+}
+
+var compressors = []*compressor{
+	{id: compressSnappy, name: CompressionSnappy, compress: snappyCompress, decompress: snappyDecompress},
+	{id: compressZstd, name: CompressionZstd, compress: zstdCompress, decompress: zstdDecompress},
+}
+
+func compressorByName(name string) *compressor {
+	for _, c := range compressors {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func compressorByID(id byte) *compressor {
+	for _, c := range compressors {
+		if c.id == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// ValidateCompressions checks that all names in the configuration are supported.
+func ValidateCompressions(names []string) error {
+	for _, name := range names {
+		if compressorByName(name) == nil {
+			return fmt.Errorf("not supported compression %s", name)
+		}
+	}
+	return nil
+}
+
+// selectCompression returns the first algorithm in the preference of local node which remote peer can read, or
+// nil if there is none. Both sides may select different algorithms, since the header of each message tells which
+// one is used.
+func selectCompression(local, remote []string) *compressor {
+	for _, name := range local {
+		for _, rName := range remote {
+			if name == rName {
+				return compressorByName(name)
+			}
+		}
+	}
+	return nil
+}
+
+func snappyCompress(src []byte) ([]byte, error) {
+	return snappy.Encode(nil, src), nil
+}
+
+func snappyDecompress(src []byte, limit int) ([]byte, error) {
+	// snappy block format has the decoded length in front, so check it before allocating
+	size, err := snappy.DecodedLen(src)
+	if err != nil {
+		return nil, err
+	}
+	if size > limit {
+		return nil, ErrDecompressedTooBig
+	}
+	return snappy.Decode(nil, src)
+}
+
+func zstdCompress(src []byte) ([]byte, error) {
+	return zstd.Compress(nil, src)
+}
+
+func zstdDecompress(src []byte, limit int) ([]byte, error) {
+	// the content size in zstd frame header can be forged, so read by stream and stop at the limit
+	rd := zstd.NewReader(bytes.NewReader(src))
+	defer rd.Close()
+	decompressed, err := ioutil.ReadAll(io.LimitReader(rd, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > limit {
+		return nil, ErrDecompressedTooBig
+	}
+	return decompressed, nil
+}
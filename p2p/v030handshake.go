@@ -26,6 +26,10 @@ type V030Handshaker struct {
 	logger    *log.Logger
 	peerID    peer.ID
 	chainID   *types.ChainID
+	// compressions is sent to remote peer and the first one which remote peer also supports is used
+	compressions []string
+	// forks checks the fork id of remote peer
+	forks *forkFilter
 
 	rd    *bufio.Reader
 	wr    *bufio.Writer
@@ -63,6 +67,8 @@ func (h *V030Handshaker) doForOutbound(ctx context.Context) (*types.Status, erro
 	if err != nil {
 		return nil, err
 	}
+	statusMsg.Compressions = h.compressions
+	h.forks.setForkID(statusMsg)
 	moFactory := &v030MOFactory{}
 	container := moFactory.newHandshakeMessage(subproto.StatusRequest, statusMsg)
 	if container == nil {
@@ -114,12 +120,16 @@ func (h *V030Handshaker) doForOutbound(ctx context.Context) (*types.Status, erro
 	if !h.chainID.Equals(remoteChainID) {
 		return nil, fmt.Errorf("different chainID : %s", remoteChainID.ToJSON())
 	}
+	if err = h.checkForkID(remotePeerStatus); err != nil {
+		return nil, err
+	}
 
 	peerAddress := remotePeerStatus.Sender
 	if peerAddress == nil || p2putil.CheckAdddressType(peerAddress.Address) == p2putil.AddressTypeError {
 		return nil, fmt.Errorf("invalid peer address : %s", peerAddress)
 	}
 
+	h.setupCompression(remotePeerStatus)
 	// check status message
 	return remotePeerStatus, nil
 }
@@ -169,6 +179,9 @@ func (h *V030Handshaker) doForInbound(ctx context.Context) (*types.Status, error
 	if !h.chainID.Equals(remoteChainID) {
 		return nil, fmt.Errorf("different chainID : %s", remoteChainID.ToJSON())
 	}
+	if err = h.checkForkID(statusMsg); err != nil {
+		return nil, err
+	}
 
 	peerAddress := statusMsg.Sender
 	if peerAddress == nil || p2putil.CheckAdddressType(peerAddress.Address) == p2putil.AddressTypeError {
@@ -181,6 +194,8 @@ func (h *V030Handshaker) doForInbound(ctx context.Context) (*types.Status, error
 		h.logger.Warn().Err(err).Msg("Failed to create status message.")
 		return nil, err
 	}
+	statusResp.Compressions = h.compressions
+	h.forks.setForkID(statusResp)
 	moFactory := &v030MOFactory{}
 	container := moFactory.newHandshakeMessage(subproto.StatusRequest, statusResp)
 	if container == nil {
@@ -197,10 +212,44 @@ func (h *V030Handshaker) doForInbound(ctx context.Context) (*types.Status, error
 	default:
 		// go on
 	}
+	// the status messages of both sides are not compressed
+	h.setupCompression(statusMsg)
 	return statusMsg, nil
 
 }
 
+// setupCompression enables payload compression of messages after handshake, if remote peer supports any of
+// compressions of local node.
+func (h *V030Handshaker) setupCompression(remoteStatus *types.Status) {
+	rw, ok := h.msgRW.(*V030ReadWriter)
+	if !ok || len(h.compressions) == 0 {
+		return
+	}
+	c := selectCompression(h.compressions, remoteStatus.GetCompressions())
+	rw.enableCompression(c, h.compressions)
+	if c != nil {
+		h.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(h.peerID)).Str("compression", c.name).Msg("Payload compression is negotiated")
+	}
+}
+
+// checkForkID checks whether the fork schedule of remote peer is compatible with local node. The peer which doesn't
+// send fork id is accepted, since it is released before the fork schedule.
+func (h *V030Handshaker) checkForkID(remoteStatus *types.Status) error {
+	if h.forks == nil || remoteStatus.GetForkHash() == 0 {
+		return nil
+	}
+	bestBlock, err := h.actorServ.GetChainAccessor().GetBestBlock()
+	if err != nil {
+		return err
+	}
+	remote := types.ForkID{Hash: remoteStatus.GetForkHash(), Next: remoteStatus.GetForkNext()}
+	if err = h.forks.check(bestBlock.BlockNo(), remote); err != nil {
+		h.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(h.peerID)).Err(err).Msg("Remote peer has incompatible forks")
+		return err
+	}
+	return nil
+}
+
 func (h *V030Handshaker) handleGoAway(peerID peer.ID, data p2pcommon.Message) (*types.Status, error) {
 	goAway := &types.GoAwayNotice{}
 	if err := p2putil.UnmarshalMessage(data.Payload(), goAway); err != nil {
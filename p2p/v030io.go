@@ -15,6 +15,16 @@ import (
 
 const msgHeaderLength int = 48
 
+// the highest byte of subprotocol field in message header is the flag for compression of payload, and the length
+// field is the size of payload on the wire.
+const subProtocolMask uint32 = 0x00ffffff
+
+// compressionListener is notified the sizes of compressed payload and original one
+type compressionListener interface {
+	InputCompressed(compressed, raw int)
+	OutputCompressed(compressed, raw int)
+}
+
 type V030ReadWriter struct {
 	r *V030Reader
 	w *V030Writer
@@ -35,6 +45,19 @@ func (rw *V030ReadWriter) WriteMsg(msg p2pcommon.Message) error {
 	return rw.w.WriteMsg(msg)
 }
 
+// enableCompression sets the algorithm to compress messages to write, and the algorithms allowed in messages to
+// read. It must be called before the readwriter is used by other goroutines.
+func (rw *V030ReadWriter) enableCompression(c *compressor, accepted []string) {
+	rw.w.compressor = c
+	rw.r.accepted = accepted
+}
+
+// setCompressionListener sets listener to report the savings by compression.
+func (rw *V030ReadWriter) setCompressionListener(listener compressionListener) {
+	rw.w.listener = listener
+	rw.r.listener = listener
+}
+
 func NewV030Reader(rd *bufio.Reader) *V030Reader {
 	return &V030Reader{rd: rd}
 }
@@ -46,6 +69,10 @@ func NewV030Writer(wr *bufio.Writer) *V030Writer {
 type V030Reader struct {
 	rd      *bufio.Reader
 	headBuf [msgHeaderLength]byte
+
+	// accepted is names of compression algorithms which this reader allows
+	accepted []string
+	listener compressionListener
 }
 
 // ReadMsg() must be used in single thread
@@ -72,10 +99,41 @@ func (r *V030Reader) ReadMsg() (p2pcommon.Message, error) {
 		return nil, fmt.Errorf("failed to read paylod of msg %s %s : payload length mismatch", msg.subProtocol.String(), msg.id)
 	}
 
+	if flag := r.headBuf[0]; flag != compressNone {
+		if payload, err = r.decompress(flag, payload); err != nil {
+			return nil, fmt.Errorf("failed to decompress payload of msg %s %s : %s", msg.subProtocol.String(), msg.id, err.Error())
+		}
+		msg.length = uint32(len(payload))
+	}
+
 	msg.payload = payload
 	return msg, nil
 }
 
+func (r *V030Reader) decompress(flag byte, payload []byte) ([]byte, error) {
+	c := compressorByID(flag)
+	if c == nil || !containsString(r.accepted, c.name) {
+		return nil, fmt.Errorf("not negotiated compression %d", flag)
+	}
+	decompressed, err := c.decompress(payload, p2pcommon.MaxPayloadLength)
+	if err != nil {
+		return nil, err
+	}
+	if r.listener != nil {
+		r.listener.InputCompressed(len(payload), len(decompressed))
+	}
+	return decompressed, nil
+}
+
+func containsString(arr []string, str string) bool {
+	for _, s := range arr {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *V030Reader) readToLen(bf []byte, max int) (int, error) {
 	remain := max
 	offset := 0
@@ -93,6 +151,10 @@ func (r *V030Reader) readToLen(bf []byte, max int) (int, error) {
 type V030Writer struct {
 	wr      *bufio.Writer
 	headBuf [msgHeaderLength]byte
+
+	// compressor is nil if remote peer can't read compressed payload
+	compressor *compressor
+	listener   compressionListener
 }
 
 // WriteMsg() must be used in single thread
@@ -104,7 +166,8 @@ func (w *V030Writer) WriteMsg(msg p2pcommon.Message) error {
 		return fmt.Errorf("too big payload")
 	}
 
-	w.marshalHeader(msg)
+	payload, flag := w.compress(msg.Payload())
+	w.marshalHeader(msg, flag, uint32(len(payload)))
 	written, err := w.wr.Write(w.headBuf[:])
 	if err != nil {
 		return err
@@ -112,20 +175,36 @@ func (w *V030Writer) WriteMsg(msg p2pcommon.Message) error {
 	if written != msgHeaderLength {
 		return fmt.Errorf("header is not written")
 	}
-	written, err = w.wr.Write(msg.Payload())
+	written, err = w.wr.Write(payload)
 	if err != nil {
 		return err
 	}
-	if written != int(msg.Length()) {
+	if written != len(payload) {
 		return fmt.Errorf("wrong write")
 	}
 	w.wr.Flush()
 	return nil
 }
 
+// compress returns the payload to write and the compression flag of it. The payload is sent as is if it is small,
+// or compression fails or doesn't reduce the size.
+func (w *V030Writer) compress(payload []byte) ([]byte, byte) {
+	if w.compressor == nil || len(payload) < minCompressSize {
+		return payload, compressNone
+	}
+	compressed, err := w.compressor.compress(payload)
+	if err != nil || len(compressed) >= len(payload) {
+		return payload, compressNone
+	}
+	if w.listener != nil {
+		w.listener.OutputCompressed(len(compressed), len(payload))
+	}
+	return compressed, w.compressor.id
+}
+
 func parseHeader(buf [msgHeaderLength]byte) *V030Message {
 	m := &V030Message{}
-	m.subProtocol = p2pcommon.SubProtocol(binary.BigEndian.Uint32(buf[0:4]))
+	m.subProtocol = p2pcommon.SubProtocol(binary.BigEndian.Uint32(buf[0:4]) & subProtocolMask)
 	m.length = binary.BigEndian.Uint32(buf[4:8])
 	m.timestamp = int64(binary.BigEndian.Uint64(buf[8:16]))
 	copy(m.id[:], buf[16:32])
@@ -133,9 +212,10 @@ func parseHeader(buf [msgHeaderLength]byte) *V030Message {
 	return m
 }
 
-func (w *V030Writer) marshalHeader(m p2pcommon.Message) {
+func (w *V030Writer) marshalHeader(m p2pcommon.Message, flag byte, length uint32) {
 	binary.BigEndian.PutUint32(w.headBuf[0:4], m.Subprotocol().Uint32())
-	binary.BigEndian.PutUint32(w.headBuf[4:8], m.Length())
+	w.headBuf[0] = flag
+	binary.BigEndian.PutUint32(w.headBuf[4:8], length)
 	binary.BigEndian.PutUint64(w.headBuf[8:16], uint64(m.Timestamp()))
 
 	msgID := m.ID()
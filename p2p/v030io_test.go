@@ -70,6 +70,91 @@ func Test_ReadWrite(t *testing.T) {
 	}
 }
 
+type dummyCompListener struct {
+	savedIn, savedOut int
+}
+
+func (l *dummyCompListener) InputCompressed(compressed, raw int)  { l.savedIn += raw - compressed }
+func (l *dummyCompListener) OutputCompressed(compressed, raw int) { l.savedOut += raw - compressed }
+
+func Test_ReadWriteCompressed(t *testing.T) {
+	var sampleID p2pcommon.MsgID
+	sampleUUID, _ := uuid.NewV4()
+	copy(sampleID[:], sampleUUID[:])
+	hashes := make([][]byte, 0, len(sampleTxs)*100)
+	for i := 0; i < 100; i++ {
+		hashes = append(hashes, sampleTxs...)
+	}
+	bigPayload, _ := proto.Marshal(&types.NewTransactionsNotice{TxHashes: hashes})
+	smallPayload, _ := proto.Marshal(&types.NewTransactionsNotice{TxHashes: sampleTxs[:1]})
+
+	tests := []struct {
+		name     string
+		comp     string
+		accepted []string
+		payload  []byte
+
+		wantCompressed bool
+		wantErr        bool
+	}{
+		{"TZstd", CompressionZstd, []string{CompressionZstd}, bigPayload, true, false},
+		{"TSnappy", CompressionSnappy, []string{CompressionZstd, CompressionSnappy}, bigPayload, true, false},
+		{"TSmall", CompressionZstd, []string{CompressionZstd}, smallPayload, false, false},
+		{"TNotNegotiated", CompressionSnappy, []string{CompressionZstd}, bigPayload, true, true},
+		{"TNoCompression", CompressionSnappy, nil, bigPayload, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample := &V030Message{subProtocol: subproto.NewTxNotice, id: sampleID, timestamp: time.Now().UnixNano(), length: uint32(len(tt.payload)), payload: tt.payload}
+			buf := bytes.NewBuffer(nil)
+			listener := &dummyCompListener{}
+			rw := NewV030ReadWriter(bufio.NewReader(buf), bufio.NewWriter(buf))
+			rw.enableCompression(compressorByName(tt.comp), tt.accepted)
+			rw.setCompressionListener(listener)
+
+			assert.NoError(t, rw.WriteMsg(sample))
+			if tt.wantCompressed {
+				assert.True(t, buf.Len() < len(tt.payload)+msgHeaderLength)
+				assert.Equal(t, len(tt.payload)+msgHeaderLength-buf.Len(), listener.savedOut)
+			} else {
+				assert.Equal(t, len(tt.payload)+msgHeaderLength, buf.Len())
+			}
+
+			readMsg, err := rw.ReadMsg()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, sample, readMsg)
+			assert.Equal(t, listener.savedOut, listener.savedIn)
+		})
+	}
+}
+
+func Test_DecompressLimit(t *testing.T) {
+	bomb := make([]byte, p2pcommon.MaxPayloadLength+1)
+	for _, c := range compressors {
+		compressed, err := c.compress(bomb)
+		assert.NoError(t, err)
+		assert.True(t, len(compressed) < p2pcommon.MaxPayloadLength)
+		_, err = c.decompress(compressed, p2pcommon.MaxPayloadLength)
+		assert.Equal(t, ErrDecompressedTooBig, err, c.name)
+		decompressed, err := c.decompress(compressed, len(bomb))
+		assert.NoError(t, err, c.name)
+		assert.Equal(t, len(bomb), len(decompressed))
+	}
+}
+
+func Test_selectCompression(t *testing.T) {
+	both := []string{CompressionZstd, CompressionSnappy}
+	assert.Equal(t, CompressionZstd, selectCompression(both, []string{CompressionSnappy, CompressionZstd}).name)
+	assert.Equal(t, CompressionSnappy, selectCompression(both, []string{CompressionSnappy}).name)
+	assert.Nil(t, selectCompression(both, nil), "old version peer")
+	assert.Nil(t, selectCompression(nil, both))
+	assert.Error(t, ValidateCompressions([]string{"gzip"}))
+}
+
 func TestV030Writer_WriteError(t *testing.T) {
 	//var sampleID MsgID
 	//sampleUUID, _ := uuid.NewRandom()
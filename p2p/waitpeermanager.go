@@ -164,6 +164,11 @@ func (dpm *basePeerManager) tryAddPeer(outbound bool, meta p2pcommon.PeerMeta, s
 		dpm.sendGoAway(rw, "Inconsistent peerID")
 		return meta, false
 	}
+	if !dpm.pm.IsAllowed(peerID) {
+		dpm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Msg("Refuse peer which is not in allowlist")
+		dpm.sendGoAway(rw, "not allowed peer")
+		return meta, false
+	}
 	// override options by configurations of nodd
 	_, receivedMeta.Designated = dpm.pm.designatedPeers[peerID]
 	// hidden is set by either remote peer's asking or local node's config
@@ -172,6 +177,12 @@ func (dpm *basePeerManager) tryAddPeer(outbound bool, meta p2pcommon.PeerMeta, s
 	}
 
 	newPeer := newRemotePeer(receivedMeta, dpm.pm.GetNextManageNum(), dpm.pm, dpm.pm.actorService, dpm.logger, dpm.pm.mf, dpm.pm.signer, s, rw)
+	if dpm.pm.mm != nil {
+		newPeer.metric = dpm.pm.mm.Add(peerID, rd, wt)
+		if v030rw, ok := rw.(*V030ReadWriter); ok {
+			v030rw.setCompressionListener(newPeer.metric)
+		}
+	}
 	newPeer.UpdateBlkCache(remoteStatus.GetBestBlockHash(), remoteStatus.GetBestHeight())
 
 	// insert Handlers
@@ -227,7 +238,7 @@ func (spm *staticWPManager) OnPeerConnect(pid peer.ID) {
 
 func (spm *staticWPManager) OnPeerDisconnect(peer p2pcommon.RemotePeer) {
 	// if peer is designated peer , try reconnect by add peermeta to waiting peer
-	if _, ok := spm.pm.designatedPeers[peer.ID()]; ok {
+	if _, ok := spm.pm.designatedPeers[peer.ID()]; ok && spm.pm.IsAllowed(peer.ID()) {
 		spm.logger.Debug().Str(p2putil.LogPeerID, peer.Name()).Msg("server will try to reconnect designated peer after cooltime")
 		// These peers must have cool time.
 		spm.pm.waitingPeers[peer.ID()] = &p2pcommon.WaitingPeer{Meta: peer.Meta(), NextTrial: time.Now().Add(firstReconnectColltime)}
@@ -254,7 +265,7 @@ func (dpm *dynamicWPManager) OnPeerConnect(pid peer.ID) {
 func (dpm *dynamicWPManager) OnPeerDisconnect(peer p2pcommon.RemotePeer) {
 	// if peer is designated peer or trusted enough , try reconnect by add peermeta to waiting peer
 	// TODO check by trust level is not implemented yet.
-	if _, ok := dpm.pm.designatedPeers[peer.ID()]; ok {
+	if _, ok := dpm.pm.designatedPeers[peer.ID()]; ok && dpm.pm.IsAllowed(peer.ID()) {
 		dpm.logger.Debug().Str(p2putil.LogPeerID, peer.Name()).Msg("server will try to reconnect designated peer after cooltime")
 		// These peers must have cool time.
 		dpm.pm.waitingPeers[peer.ID()] = &p2pcommon.WaitingPeer{Meta: peer.Meta(), NextTrial: time.Now().Add(firstReconnectColltime)}
@@ -271,8 +282,10 @@ func (dpm *dynamicWPManager) OnDiscoveredPeers(metas []p2pcommon.PeerMeta) int {
 		} else if _, ok := dpm.pm.waitingPeers[meta.ID]; ok {
 			// skip already waiting peer
 			continue
+		} else if !dpm.pm.IsAllowed(meta.ID) {
+			// skip peer not in allowlist of permissioned network
+			continue
 		}
-		// TODO check blacklist later.
 		dpm.pm.waitingPeers[meta.ID] = &p2pcommon.WaitingPeer{Meta: meta, NextTrial: time.Now()}
 		addedWP++
 	}
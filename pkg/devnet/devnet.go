@@ -0,0 +1,346 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package devnet launches a local network of aergosvr nodes on loopback, for testing consensus and sync. The nodes
+// run as child processes, since the services of a node keep their state in package variables. The nodes connect to
+// each other through links owned by devnet, so that they can be partitioned.
+package devnet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-peer"
+)
+
+const (
+	ConsensusDPOS = "dpos"
+	ConsensusSBP  = "sbp"
+	ConsensusRaft = "raft"
+)
+
+var (
+	ErrInvalidConsensus = errors.New("consensus of devnet must be dpos, sbp or raft")
+	ErrInvalidNode      = errors.New("invalid node index")
+)
+
+// Config is the configuration of devnet
+type Config struct {
+	// Bin is the path of aergosvr binary
+	Bin string
+	// Dir is the directory of nodes, which must not exist
+	Dir string
+	// Nodes is the number of nodes
+	Nodes int
+	// Consensus is the consensus type of chain. All the nodes are BPs in dpos and raft, and the first node is in sbp.
+	Consensus string
+	// BlockInterval is the block interval in seconds
+	BlockInterval int64
+	// Balance is the initial balances of genesis
+	Balance map[string]string
+}
+
+// Devnet is a local network of aergosvr nodes
+type Devnet struct {
+	Nodes       []*Node
+	Genesis     *types.Genesis
+	GenesisHash []byte
+
+	bin       string
+	p2pLinks  map[[2]int]*link
+	raftLinks map[[2]int]*link
+	sinks     []*link
+}
+
+// New generates the keys, the genesis and the configs of nodes in cfg.Dir, and creates their genesis blocks. The
+// nodes are not started.
+func New(cfg Config) (*Devnet, error) {
+	if cfg.Nodes < 1 {
+		return nil, fmt.Errorf("invalid number of nodes %d", cfg.Nodes)
+	}
+	if cfg.Consensus != ConsensusDPOS && cfg.Consensus != ConsensusSBP && cfg.Consensus != ConsensusRaft {
+		return nil, ErrInvalidConsensus
+	}
+	if cfg.BlockInterval <= 0 {
+		cfg.BlockInterval = 1
+	}
+	if _, err := os.Stat(cfg.Dir); !os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s already exists", cfg.Dir)
+	}
+	dir, err := filepath.Abs(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Devnet{bin: cfg.Bin, p2pLinks: make(map[[2]int]*link), raftLinks: make(map[[2]int]*link)}
+	if err := d.createNodes(dir, cfg.Nodes); err != nil {
+		d.Close()
+		return nil, err
+	}
+	if err := d.createGenesis(dir, cfg); err != nil {
+		d.Close()
+		return nil, err
+	}
+	if err := d.createLinks(); err != nil {
+		d.Close()
+		return nil, err
+	}
+	genesisFile := filepath.Join(dir, "genesis.json")
+	for i, n := range d.Nodes {
+		if err := n.writeConfig(d.nodeConfig(i, cfg)); err != nil {
+			d.Close()
+			return nil, err
+		}
+		if err := n.initGenesis(d.bin, genesisFile); err != nil {
+			d.Close()
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func (d *Devnet) createNodes(dir string, count int) error {
+	for i := 0; i < count; i++ {
+		n := &Node{Name: "node" + strconv.Itoa(i), Dir: filepath.Join(dir, "node"+strconv.Itoa(i))}
+		_, pub, err := p2putil.GenerateKeyFile(n.Dir, "node")
+		if err != nil {
+			return err
+		}
+		id, err := peer.IDFromPublicKey(pub)
+		if err != nil {
+			return err
+		}
+		n.PeerID = peer.IDB58Encode(id)
+
+		for _, port := range []*int{&n.rpcPort, &n.p2pPort, &n.raftPort} {
+			if *port, err = freePort(); err != nil {
+				return err
+			}
+		}
+		n.RPCAddr = fmt.Sprintf("127.0.0.1:%d", n.rpcPort)
+		d.Nodes = append(d.Nodes, n)
+	}
+	return nil
+}
+
+func (d *Devnet) createGenesis(dir string, cfg Config) error {
+	genesis := &types.Genesis{
+		ID:        types.ChainID{Magic: "devnet", Consensus: cfg.Consensus},
+		Timestamp: time.Now().UnixNano(),
+		Balance:   cfg.Balance,
+	}
+	if cfg.Consensus == ConsensusDPOS {
+		for _, n := range d.Nodes {
+			genesis.BPs = append(genesis.BPs, n.PeerID)
+		}
+	}
+	block, err := chain.CheckGenesis(genesis)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(genesis, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "genesis.json"), b, 0644); err != nil {
+		return err
+	}
+	d.Genesis, d.GenesisHash = genesis, block.BlockHash()
+	return nil
+}
+
+// createLinks creates the links of p2p and raft from each node to the others, and the sinks advertised by nodes
+func (d *Devnet) createLinks() error {
+	for i := range d.Nodes {
+		sink, err := newSink()
+		if err != nil {
+			return err
+		}
+		d.sinks = append(d.sinks, sink)
+		for j, target := range d.Nodes {
+			if i == j {
+				continue
+			}
+			p2pLink, err := newLink(fmt.Sprintf("127.0.0.1:%d", target.p2pPort))
+			if err != nil {
+				return err
+			}
+			d.p2pLinks[[2]int{i, j}] = p2pLink
+			raftLink, err := newLink(fmt.Sprintf("127.0.0.1:%d", target.raftPort))
+			if err != nil {
+				return err
+			}
+			d.raftLinks[[2]int{i, j}] = raftLink
+		}
+	}
+	return nil
+}
+
+func (d *Devnet) allLinks() map[*link][2]int {
+	all := make(map[*link][2]int, len(d.p2pLinks)+len(d.raftLinks))
+	for key, l := range d.p2pLinks {
+		all[l] = key
+	}
+	for key, l := range d.raftLinks {
+		all[l] = key
+	}
+	return all
+}
+
+// nodeConfig returns the config of ith node, whose peers are reached through the links
+func (d *Devnet) nodeConfig(i int, cfg Config) *nodeConfig {
+	n := d.Nodes[i]
+	c := &nodeConfig{
+		Dir:           n.Dir,
+		RPCPort:       n.rpcPort,
+		P2PPort:       d.sinks[i].port(),
+		P2PBindPort:   n.p2pPort,
+		EnableBP:      cfg.Consensus != ConsensusSBP || i == 0,
+		BlockInterval: cfg.BlockInterval,
+		Raft:          cfg.Consensus == ConsensusRaft,
+		Name:          n.Name,
+		RaftURL:       fmt.Sprintf("http://127.0.0.1:%d", n.raftPort),
+	}
+	for j, target := range d.Nodes {
+		url := c.RaftURL
+		if j != i {
+			c.Peers = append(c.Peers, fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", d.p2pLinks[[2]int{i, j}].port(), target.PeerID))
+			url = fmt.Sprintf("http://127.0.0.1:%d", d.raftLinks[[2]int{i, j}].port())
+		}
+		c.RaftBPs = append(c.RaftBPs, config.RaftBPConfig{Name: target.Name, Url: url, P2pID: target.PeerID})
+	}
+	return c
+}
+
+func (d *Devnet) node(i int) (*Node, error) {
+	if i < 0 || i >= len(d.Nodes) {
+		return nil, ErrInvalidNode
+	}
+	return d.Nodes[i], nil
+}
+
+// Start starts all the nodes which are not running
+func (d *Devnet) Start() error {
+	for i, n := range d.Nodes {
+		if n.Running() {
+			continue
+		}
+		if err := d.StartNode(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StartNode starts ith node
+func (d *Devnet) StartNode(i int) error {
+	n, err := d.node(i)
+	if err != nil {
+		return err
+	}
+	return n.start(d.bin)
+}
+
+// StopNode interrupts ith node and waits for it to exit
+func (d *Devnet) StopNode(i int) error {
+	n, err := d.node(i)
+	if err != nil {
+		return err
+	}
+	return n.stop()
+}
+
+// KillNode kills ith node without shutdown
+func (d *Devnet) KillNode(i int) error {
+	n, err := d.node(i)
+	if err != nil {
+		return err
+	}
+	return n.kill()
+}
+
+// RestartNode stops ith node if it is running, and starts it again
+func (d *Devnet) RestartNode(i int) error {
+	n, err := d.node(i)
+	if err != nil {
+		return err
+	}
+	if n.Running() {
+		if err := n.stop(); err != nil {
+			return err
+		}
+	}
+	return n.start(d.bin)
+}
+
+// Partition cuts the links between the nodes of different groups. The nodes not in any group are isolated from all
+// the others. It replaces the previous partition.
+func (d *Devnet) Partition(groups ...[]int) error {
+	group := make(map[int]int)
+	for g, members := range groups {
+		for _, i := range members {
+			if _, err := d.node(i); err != nil {
+				return err
+			}
+			group[i] = g
+		}
+	}
+	groupOf := func(i int) int {
+		if g, exists := group[i]; exists {
+			return g
+		}
+		return -i - 1
+	}
+	for l, key := range d.allLinks() {
+		l.setCut(groupOf(key[0]) != groupOf(key[1]))
+	}
+	return nil
+}
+
+// Heal restores all the links between the nodes
+func (d *Devnet) Heal() {
+	for l := range d.allLinks() {
+		l.setCut(false)
+	}
+}
+
+// WaitHeight waits until all the running nodes reach height
+func (d *Devnet) WaitHeight(height types.BlockNo, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for _, n := range d.Nodes {
+		if !n.Running() {
+			continue
+		}
+		if err := n.WaitHeight(height, time.Until(deadline)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops all the nodes and the links. The directory of nodes is kept.
+func (d *Devnet) Close() {
+	for _, n := range d.Nodes {
+		if n.Running() {
+			n.stop()
+		}
+	}
+	for l := range d.allLinks() {
+		l.close()
+	}
+	for _, sink := range d.sinks {
+		sink.close()
+	}
+}
@@ -0,0 +1,120 @@
+package devnet
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// echoServer echoes the lines of each connection
+func echoServer(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					conn.Write([]byte(line))
+				}
+			}()
+		}
+	}()
+	return l
+}
+
+func echo(l *link, msg string) error {
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", l.port()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Second))
+	if _, err := conn.Write([]byte(msg + "\n")); err != nil {
+		return err
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if line != msg+"\n" {
+		return fmt.Errorf("unexpected echo %s", line)
+	}
+	return nil
+}
+
+func TestLink(t *testing.T) {
+	server := echoServer(t)
+	defer server.Close()
+
+	l, err := newLink(server.Addr().String())
+	assert.NoError(t, err)
+	defer l.close()
+	assert.NoError(t, echo(l, "hello"))
+
+	// the connection through the cut link is closed
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", l.port()))
+	assert.NoError(t, err)
+	defer conn.Close()
+	time.Sleep(100 * time.Millisecond)
+	l.setCut(true)
+	conn.SetDeadline(time.Now().Add(time.Second))
+	_, err = bufio.NewReader(conn).ReadString('\n')
+	assert.Error(t, err)
+	assert.Error(t, echo(l, "hello"))
+
+	l.setCut(false)
+	assert.NoError(t, echo(l, "hello"))
+}
+
+func TestSink(t *testing.T) {
+	sink, err := newSink()
+	assert.NoError(t, err)
+	defer sink.close()
+	assert.Error(t, echo(sink, "hello"), "sink closes all the connections")
+}
+
+func TestPartition(t *testing.T) {
+	d := &Devnet{p2pLinks: make(map[[2]int]*link), raftLinks: make(map[[2]int]*link)}
+	for i := 0; i < 4; i++ {
+		d.Nodes = append(d.Nodes, &Node{Name: fmt.Sprintf("node%d", i)})
+	}
+	for i := range d.Nodes {
+		for j := range d.Nodes {
+			if i != j {
+				d.p2pLinks[[2]int{i, j}] = &link{conns: make(map[net.Conn]bool)}
+				d.raftLinks[[2]int{i, j}] = &link{conns: make(map[net.Conn]bool)}
+			}
+		}
+	}
+
+	// node3 is not in any group, so it is isolated
+	assert.NoError(t, d.Partition([]int{0, 1}, []int{2}))
+	for l, key := range d.allLinks() {
+		connected := (key[0] == 0 || key[0] == 1) && (key[1] == 0 || key[1] == 1)
+		assert.Equal(t, !connected, l.cut, "link %v", key)
+	}
+	assert.Equal(t, ErrInvalidNode, d.Partition([]int{0, 4}))
+
+	d.sinks = []*link{{cut: true, conns: make(map[net.Conn]bool)}}
+	d.Heal()
+	for l := range d.allLinks() {
+		assert.False(t, l.cut)
+	}
+	assert.True(t, d.sinks[0].cut, "sink is never healed")
+}
@@ -0,0 +1,116 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package devnet
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// link forwards the connections of a node to another node on loopback. The nodes are partitioned by cutting the
+// links between them, which closes the forwarded connections and refuses the new ones until the link is healed.
+type link struct {
+	listener net.Listener
+	target   string
+
+	lock  sync.Mutex
+	cut   bool
+	conns map[net.Conn]bool
+}
+
+func newLink(target string) (*link, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	l := &link{listener: listener, target: target, conns: make(map[net.Conn]bool)}
+	go l.serve()
+	return l, nil
+}
+
+// newSink returns a link which closes all the connections. A node advertises the port of sink instead of its own
+// port, so that the other nodes can't bypass the links by the address learned from the node.
+func newSink() (*link, error) {
+	l, err := newLink("")
+	if err != nil {
+		return nil, err
+	}
+	l.setCut(true)
+	return l, nil
+}
+
+// port returns the port which the node connects to instead of the target
+func (l *link) port() int {
+	return l.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (l *link) serve() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+		go l.forward(conn)
+	}
+}
+
+func (l *link) forward(in net.Conn) {
+	if !l.add(in) {
+		return
+	}
+	defer l.remove(in)
+	out, err := net.Dial("tcp", l.target)
+	if err != nil {
+		return
+	}
+	if !l.add(out) {
+		return
+	}
+	defer l.remove(out)
+
+	go func() {
+		io.Copy(out, in)
+		out.Close()
+	}()
+	io.Copy(in, out)
+}
+
+// add tracks conn to close it on cut. If the link is cut, conn is closed at once.
+func (l *link) add(conn net.Conn) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.cut {
+		conn.Close()
+		return false
+	}
+	l.conns[conn] = true
+	return true
+}
+
+func (l *link) remove(conn net.Conn) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	conn.Close()
+	delete(l.conns, conn)
+}
+
+// setCut cuts or heals the link
+func (l *link) setCut(cut bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.cut = cut
+	if cut {
+		for conn := range l.conns {
+			conn.Close()
+		}
+	}
+}
+
+func (l *link) close() {
+	l.listener.Close()
+	l.setCut(true)
+}
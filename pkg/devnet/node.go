@@ -0,0 +1,221 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package devnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"google.golang.org/grpc"
+)
+
+var (
+	ErrNodeRunning    = errors.New("node is already running")
+	ErrNodeNotRunning = errors.New("node is not running")
+)
+
+// time to wait for the node to stop gracefully before it is killed
+const stopTimeout = 10 * time.Second
+
+// Node is an aergosvr child process of devnet
+type Node struct {
+	Name    string
+	Dir     string
+	PeerID  string
+	RPCAddr string
+
+	rpcPort  int
+	p2pPort  int
+	raftPort int
+
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// nodeConfig is the fields of config.toml of node. The other fields are the defaults of aergosvr.
+type nodeConfig struct {
+	Dir           string
+	RPCPort       int
+	P2PPort       int
+	P2PBindPort   int
+	Peers         []string
+	EnableBP      bool
+	BlockInterval int64
+	Raft          bool
+	Name          string
+	RaftURL       string
+	RaftBPs       []config.RaftBPConfig
+}
+
+// the nodes connect to the designated peers only, which are the links to the other nodes. The advertised port is
+// a sink, and the node listens on the bind port, which only the links know. The bind port isn't told to peers by
+// npadvertiseonly.
+var configTemplate = template.Must(template.New("config").Parse(`# aergo TOML Configuration File generated by devnet
+datadir = "{{.Dir}}/data"
+authdir = "{{.Dir}}/auth"
+
+[rpc]
+netserviceaddr = "127.0.0.1"
+netserviceport = {{.RPCPort}}
+
+[p2p]
+netprotocoladdr = "127.0.0.1"
+netprotocolport = {{.P2PPort}}
+npbindaddr = "127.0.0.1"
+npbindport = {{.P2PBindPort}}
+npadvertiseonly = true
+npkey = "{{.Dir}}/node.key"
+npaddpeers = [{{range .Peers}}
+"{{.}}", {{end}}
+]
+npdiscoverpeers = false
+npexposeself = false
+npusepolaris = false
+
+[consensus]
+enablebp = {{.EnableBP}}
+blockinterval = {{.BlockInterval}}
+{{if .Raft}}
+[consensus.raft]
+name = "{{.Name}}"
+listenurl = "{{.RaftURL}}"
+{{range .RaftBPs}}
+[[consensus.raft.bps]]
+name = "{{.Name}}"
+url = "{{.Url}}"
+p2pid = "{{.P2pID}}"
+{{end}}{{end}}`))
+
+func (n *Node) writeConfig(c *nodeConfig) error {
+	f, err := os.Create(filepath.Join(n.Dir, "config.toml"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return configTemplate.Execute(f, c)
+}
+
+// initGenesis creates the genesis block in the data directory of node
+func (n *Node) initGenesis(bin, genesisFile string) error {
+	out, err := exec.Command(bin, "--home", n.Dir, "init", "--genesis", genesisFile).CombinedOutput()
+	if err != nil || !strings.Contains(string(out), "is created") {
+		return fmt.Errorf("failed to init genesis of %s: %s", n.Name, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// Running reports whether the process of node is running
+func (n *Node) Running() bool {
+	if n.cmd == nil {
+		return false
+	}
+	select {
+	case <-n.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+func (n *Node) start(bin string) error {
+	if n.Running() {
+		return ErrNodeRunning
+	}
+	logFile, err := os.OpenFile(filepath.Join(n.Dir, "aergosvr.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(bin, "--home", n.Dir)
+	cmd.Dir = n.Dir
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		logFile.Close()
+		return err
+	}
+
+	exited := make(chan struct{})
+	n.cmd, n.exited = cmd, exited
+	go func() {
+		cmd.Wait()
+		logFile.Close()
+		close(exited)
+	}()
+	return nil
+}
+
+// stop interrupts the node, and kills it if it doesn't exit in stopTimeout
+func (n *Node) stop() error {
+	if !n.Running() {
+		return ErrNodeNotRunning
+	}
+	n.cmd.Process.Signal(os.Interrupt)
+	select {
+	case <-n.exited:
+		return nil
+	case <-time.After(stopTimeout):
+		return n.kill()
+	}
+}
+
+func (n *Node) kill() error {
+	if !n.Running() {
+		return ErrNodeNotRunning
+	}
+	n.cmd.Process.Kill()
+	<-n.exited
+	return nil
+}
+
+// Height returns the best block number of node
+func (n *Node) Height() (types.BlockNo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, n.RPCAddr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	status, err := types.NewAergoRPCServiceClient(conn).Blockchain(ctx, &types.Empty{})
+	if err != nil {
+		return 0, err
+	}
+	return status.GetBestHeight(), nil
+}
+
+// WaitHeight waits until the best block number of node reaches height
+func (n *Node) WaitHeight(height types.BlockNo, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if best, err := n.Height(); err == nil && best >= height {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s didn't reach height %d in %s", n.Name, height, timeout)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// freePort returns a port of loopback which is not in use now
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
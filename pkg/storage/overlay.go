@@ -0,0 +1,215 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package storage
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/aergoio/aergo-lib/db"
+)
+
+// Overlay returns the store which reads keys from top and then base, and writes them to top only. The keys deleted in
+// the overlay are kept in memory. It is used as a scratch copy of base, which is not modified. Closing the overlay
+// closes top only.
+func Overlay(top, base db.DB) db.DB {
+	return &overlayDB{top: top, base: base, deleted: make(map[string]bool)}
+}
+
+type overlayDB struct {
+	top  db.DB
+	base db.DB
+
+	lock    sync.RWMutex
+	deleted map[string]bool
+}
+
+func (o *overlayDB) isDeleted(key []byte) bool {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+	return o.deleted[string(key)]
+}
+
+func (o *overlayDB) setDeleted(key []byte, deleted bool) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if deleted {
+		o.deleted[string(key)] = true
+	} else {
+		delete(o.deleted, string(key))
+	}
+}
+
+func (o *overlayDB) Type() string {
+	return o.top.Type()
+}
+
+func (o *overlayDB) Set(key, value []byte) {
+	o.top.Set(key, value)
+	o.setDeleted(key, false)
+}
+
+func (o *overlayDB) Delete(key []byte) {
+	o.top.Delete(key)
+	o.setDeleted(key, true)
+}
+
+func (o *overlayDB) Get(key []byte) []byte {
+	if o.isDeleted(key) {
+		return []byte{}
+	}
+	if o.top.Exist(key) {
+		return o.top.Get(key)
+	}
+	return o.base.Get(key)
+}
+
+func (o *overlayDB) Exist(key []byte) bool {
+	if o.isDeleted(key) {
+		return false
+	}
+	return o.top.Exist(key) || o.base.Exist(key)
+}
+
+func (o *overlayDB) Close() {
+	o.top.Close()
+}
+
+func (o *overlayDB) NewTx() db.Transaction {
+	return &overlayTx{tx: o.top.NewTx(), o: o, deleted: make(map[string]bool)}
+}
+
+func (o *overlayDB) NewBulk() db.Bulk {
+	return &overlayBulk{bulk: o.top.NewBulk(), o: o, deleted: make(map[string]bool)}
+}
+
+// Iterator merges the iterators of top and base. The key of top hides the same key of base.
+func (o *overlayDB) Iterator(start, end []byte) db.Iterator {
+	it := &overlayIterator{
+		o:       o,
+		top:     o.top.Iterator(start, end),
+		base:    o.base.Iterator(start, end),
+		reverse: start != nil && end != nil && bytes.Compare(start, end) > 0,
+	}
+	it.skip()
+	return it
+}
+
+// overlayTx applies the deleted keys to the overlay on commit
+type overlayTx struct {
+	tx      db.Transaction
+	o       *overlayDB
+	deleted map[string]bool
+}
+
+func (t *overlayTx) Set(key, value []byte) {
+	t.tx.Set(key, value)
+	t.deleted[string(key)] = false
+}
+
+func (t *overlayTx) Delete(key []byte) {
+	t.tx.Delete(key)
+	t.deleted[string(key)] = true
+}
+
+func (t *overlayTx) Commit() {
+	t.tx.Commit()
+	for key, deleted := range t.deleted {
+		t.o.setDeleted([]byte(key), deleted)
+	}
+}
+
+func (t *overlayTx) Discard() {
+	t.tx.Discard()
+}
+
+type overlayBulk struct {
+	bulk    db.Bulk
+	o       *overlayDB
+	deleted map[string]bool
+}
+
+func (b *overlayBulk) Set(key, value []byte) {
+	b.bulk.Set(key, value)
+	b.deleted[string(key)] = false
+}
+
+func (b *overlayBulk) Delete(key []byte) {
+	b.bulk.Delete(key)
+	b.deleted[string(key)] = true
+}
+
+func (b *overlayBulk) Flush() {
+	b.bulk.Flush()
+	for key, deleted := range b.deleted {
+		b.o.setDeleted([]byte(key), deleted)
+	}
+}
+
+func (b *overlayBulk) DiscardLast() {
+	b.bulk.DiscardLast()
+	b.deleted = make(map[string]bool)
+}
+
+type overlayIterator struct {
+	o         *overlayDB
+	top, base db.Iterator
+	reverse   bool
+}
+
+// before reports whether the key of a comes before the key of b in the order of iteration
+func (i *overlayIterator) before(a, b []byte) bool {
+	if i.reverse {
+		return bytes.Compare(a, b) > 0
+	}
+	return bytes.Compare(a, b) < 0
+}
+
+// current returns the iterator which has the current key
+func (i *overlayIterator) current() db.Iterator {
+	if !i.base.Valid() {
+		return i.top
+	}
+	if !i.top.Valid() {
+		return i.base
+	}
+	if i.before(i.base.Key(), i.top.Key()) {
+		return i.base
+	}
+	return i.top
+}
+
+// skip moves to the next key which is not hidden by top nor deleted
+func (i *overlayIterator) skip() {
+	for {
+		if i.top.Valid() && i.base.Valid() && bytes.Equal(i.top.Key(), i.base.Key()) {
+			i.base.Next()
+			continue
+		}
+		if cur := i.current(); cur.Valid() && i.o.isDeleted(cur.Key()) {
+			cur.Next()
+			continue
+		}
+		return
+	}
+}
+
+func (i *overlayIterator) Next() {
+	i.current().Next()
+	i.skip()
+}
+
+func (i *overlayIterator) Valid() bool {
+	return i.top.Valid() || i.base.Valid()
+}
+
+func (i *overlayIterator) Key() []byte {
+	return i.current().Key()
+}
+
+func (i *overlayIterator) Value() []byte {
+	return i.current().Value()
+}
@@ -0,0 +1,208 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package storage
+
+import (
+	"bytes"
+
+	"github.com/aergoio/aergo-lib/db"
+)
+
+// Family is a kind of data stored in the chain store. In the prefixed layout, the keys of family are stored under the
+// prefix of family, so that they are placed together. Trie nodes have their own store.
+type Family byte
+
+const (
+	// FamilyMeta is the rest of keys. It has no prefix.
+	FamilyMeta Family = iota
+	FamilyBlock
+	FamilyReceipt
+	FamilyTxIndex
+)
+
+var familyNames = map[Family]string{
+	FamilyMeta:    "meta",
+	FamilyBlock:   "blocks",
+	FamilyReceipt: "receipts",
+	FamilyTxIndex: "txindex",
+}
+
+func (f Family) String() string {
+	return familyNames[f]
+}
+
+const layoutPrefixed = "prefixed"
+
+// layoutKey is not in any prefix of family
+var layoutKey = []byte("storage.layout")
+
+// Layout decides the keys of families in a store. nil is the legacy layout without prefixes.
+type Layout struct {
+	Prefixed bool
+}
+
+// OpenLayout returns the layout of store. The layout is decided when the store is created, and the config of
+// prefixed layout is ignored for existing stores.
+func OpenLayout(store db.DB, prefixed bool) *Layout {
+	if string(store.Get(layoutKey)) == layoutPrefixed {
+		return &Layout{Prefixed: true}
+	}
+	if prefixed && isEmpty(store) {
+		store.Set(layoutKey, []byte(layoutPrefixed))
+		return &Layout{Prefixed: true}
+	}
+	return &Layout{}
+}
+
+// Key returns the key stored in db for the key of family
+func (l *Layout) Key(f Family, key []byte) []byte {
+	if l == nil || !l.Prefixed || f == FamilyMeta {
+		return key
+	}
+	return append([]byte{byte(f)}, key...)
+}
+
+// View returns the store of family, which stores all keys under the prefix of family
+func (l *Layout) View(store db.DB, f Family) db.DB {
+	if l == nil || !l.Prefixed || f == FamilyMeta {
+		return store
+	}
+	return &prefixDB{store: store, prefix: []byte{byte(f)}}
+}
+
+func isEmpty(store db.DB) bool {
+	return !store.Iterator(nil, nil).Valid()
+}
+
+// prefixDB is the view of store which contains the keys under prefix only
+type prefixDB struct {
+	store  db.DB
+	prefix []byte
+}
+
+func (p *prefixDB) key(key []byte) []byte {
+	return append(append([]byte{}, p.prefix...), key...)
+}
+
+// end returns the smallest key greater than all keys under prefix
+func (p *prefixDB) end() []byte {
+	end := append([]byte{}, p.prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func (p *prefixDB) Type() string {
+	return p.store.Type()
+}
+
+func (p *prefixDB) Set(key, value []byte) {
+	p.store.Set(p.key(key), value)
+}
+
+func (p *prefixDB) Delete(key []byte) {
+	p.store.Delete(p.key(key))
+}
+
+func (p *prefixDB) Get(key []byte) []byte {
+	return p.store.Get(p.key(key))
+}
+
+func (p *prefixDB) Exist(key []byte) bool {
+	return p.store.Exist(p.key(key))
+}
+
+func (p *prefixDB) Close() {
+	p.store.Close()
+}
+
+func (p *prefixDB) NewTx() db.Transaction {
+	return &prefixTx{tx: p.store.NewTx(), p: p}
+}
+
+func (p *prefixDB) NewBulk() db.Bulk {
+	return &prefixBulk{bulk: p.store.NewBulk(), p: p}
+}
+
+// Iterator iterates keys under prefix in the same order as the store. nil start or end is the bound of prefix.
+func (p *prefixDB) Iterator(start, end []byte) db.Iterator {
+	e := p.end()
+	if end != nil {
+		e = p.key(end)
+	}
+	return &prefixIterator{it: p.store.Iterator(p.key(start), e), prefix: p.prefix}
+}
+
+func (p *prefixDB) Stats() map[string]string {
+	return Stats(p.store)
+}
+
+type prefixTx struct {
+	tx db.Transaction
+	p  *prefixDB
+}
+
+func (t *prefixTx) Set(key, value []byte) {
+	t.tx.Set(t.p.key(key), value)
+}
+
+func (t *prefixTx) Delete(key []byte) {
+	t.tx.Delete(t.p.key(key))
+}
+
+func (t *prefixTx) Commit() {
+	t.tx.Commit()
+}
+
+func (t *prefixTx) Discard() {
+	t.tx.Discard()
+}
+
+type prefixBulk struct {
+	bulk db.Bulk
+	p    *prefixDB
+}
+
+func (b *prefixBulk) Set(key, value []byte) {
+	b.bulk.Set(b.p.key(key), value)
+}
+
+func (b *prefixBulk) Delete(key []byte) {
+	b.bulk.Delete(b.p.key(key))
+}
+
+func (b *prefixBulk) Flush() {
+	b.bulk.Flush()
+}
+
+func (b *prefixBulk) DiscardLast() {
+	b.bulk.DiscardLast()
+}
+
+type prefixIterator struct {
+	it     db.Iterator
+	prefix []byte
+}
+
+func (i *prefixIterator) Next() {
+	i.it.Next()
+}
+
+func (i *prefixIterator) Valid() bool {
+	return i.it.Valid() && bytes.HasPrefix(i.it.Key(), i.prefix)
+}
+
+func (i *prefixIterator) Key() []byte {
+	return i.it.Key()[len(i.prefix):]
+}
+
+func (i *prefixIterator) Value() []byte {
+	return i.it.Value()
+}
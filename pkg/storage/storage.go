@@ -0,0 +1,86 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package storage opens the key-value stores of node with the backends of aergo-lib. It provides the key layout which
+// separates blocks, receipts and tx index of chain store by prefixes, and the statistics of stores. Trie nodes are
+// kept in the state store.
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aergoio/aergo-lib/db"
+)
+
+const (
+	LevelImpl  = string(db.LevelImpl)
+	BadgerImpl = string(db.BadgerImpl)
+	MemoryImpl = string(db.MemoryImpl)
+)
+
+var ErrUnknownImpl = errors.New("unknown db implementation")
+
+// StatsReporter is implemented by the stores which report internal statistics
+type StatsReporter interface {
+	Stats() map[string]string
+}
+
+// Impls returns the names of supported db implementations
+func Impls() []string {
+	return []string{BadgerImpl, LevelImpl, MemoryImpl}
+}
+
+// NewDB opens the store of dbType at dir
+func NewDB(dbType string, dir string) (db.DB, error) {
+	switch dbType {
+	case LevelImpl, BadgerImpl, MemoryImpl:
+		return db.NewDB(db.ImplType(dbType), dir), nil
+	default:
+		return nil, fmt.Errorf("%s: %s (supported: %s)", ErrUnknownImpl.Error(), dbType, strings.Join(Impls(), ", "))
+	}
+}
+
+// Stats returns the statistics of store. It contains at least the type of store.
+func Stats(store db.DB) map[string]string {
+	stats := make(map[string]string)
+	if r, ok := store.(StatsReporter); ok {
+		for k, v := range r.Stats() {
+			stats[k] = v
+		}
+	}
+	stats["type"] = store.Type()
+	return stats
+}
+
+// Copy copies all keys of src to dst by bulk of size, and returns the number of copied keys. progress is called
+// after each bulk if not nil.
+func Copy(dst, src db.DB, size int, progress func(copied int)) int {
+	if size <= 0 {
+		size = 10000
+	}
+	var (
+		bulk   = dst.NewBulk()
+		copied int
+	)
+	for it := src.Iterator(nil, nil); it.Valid(); it.Next() {
+		// the buffers of iterator can be reused by the next item
+		bulk.Set(append([]byte(nil), it.Key()...), append([]byte(nil), it.Value()...))
+		copied++
+		if copied%size == 0 {
+			bulk.Flush()
+			bulk = dst.NewBulk()
+			if progress != nil {
+				progress(copied)
+			}
+		}
+	}
+	bulk.Flush()
+	if progress != nil {
+		progress(copied)
+	}
+	return copied
+}
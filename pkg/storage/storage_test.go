@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDB(t *testing.T, dbType string) (db.DB, func()) {
+	dir, err := ioutil.TempDir("", "storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewDB(dbType, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, func() {
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestNewDB(t *testing.T) {
+	_, err := NewDB("pebbledb", "")
+	assert.Error(t, err, "unknown db implementation")
+
+	store, closer := newTestDB(t, LevelImpl)
+	defer closer()
+
+	store.Set([]byte("a"), []byte("1"))
+	assert.Equal(t, []byte("1"), store.Get([]byte("a")))
+	assert.True(t, store.Exist([]byte("a")))
+	assert.Empty(t, store.Get([]byte("b")))
+
+	tx := store.NewTx()
+	tx.Set([]byte("b"), []byte("2"))
+	tx.Set([]byte("c"), []byte("3"))
+	tx.Delete([]byte("a"))
+	assert.Empty(t, store.Get([]byte("b")), "not committed yet")
+	tx.Commit()
+	assert.False(t, store.Exist([]byte("a")))
+	assert.Equal(t, []byte("3"), store.Get([]byte("c")))
+
+	var keys []string
+	for it := store.Iterator(nil, nil); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	assert.Equal(t, []string{"b", "c"}, keys)
+
+	keys = nil
+	for it := store.Iterator([]byte("c"), []byte("b")); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	assert.Equal(t, []string{"c", "b"}, keys, "reverse order")
+
+	assert.Equal(t, LevelImpl, Stats(store)["type"])
+}
+
+func TestLayout(t *testing.T) {
+	store, closer := newTestDB(t, LevelImpl)
+	defer closer()
+
+	layout := OpenLayout(store, true)
+	assert.True(t, layout.Prefixed)
+
+	blocks := layout.View(store, FamilyBlock)
+	receipts := layout.View(store, FamilyReceipt)
+	blocks.Set([]byte("k1"), []byte("block1"))
+	blocks.Set([]byte("k2"), []byte("block2"))
+	receipts.Set([]byte("k1"), []byte("receipt1"))
+
+	assert.Equal(t, []byte("block1"), blocks.Get([]byte("k1")))
+	assert.Equal(t, []byte("receipt1"), receipts.Get([]byte("k1")))
+	assert.Equal(t, []byte("block1"), store.Get(layout.Key(FamilyBlock, []byte("k1"))))
+
+	var keys []string
+	for it := blocks.Iterator(nil, nil); it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	assert.Equal(t, []string{"k1", "k2"}, keys)
+
+	// the layout of existing store doesn't change
+	assert.True(t, OpenLayout(store, false).Prefixed)
+
+	other, closeOther := newTestDB(t, LevelImpl)
+	defer closeOther()
+	other.Set([]byte("k"), []byte("v"))
+	assert.False(t, OpenLayout(other, true).Prefixed, "not empty store keeps legacy layout")
+}
+
+func TestCopy(t *testing.T) {
+	src, closeSrc := newTestDB(t, LevelImpl)
+	defer closeSrc()
+	dst, closeDst := newTestDB(t, LevelImpl)
+	defer closeDst()
+
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		src.Set([]byte(k), []byte(k+k))
+	}
+	assert.Equal(t, 5, Copy(dst, src, 2, nil))
+	assert.Equal(t, []byte("cc"), dst.Get([]byte("c")))
+}
+
+func TestOverlay(t *testing.T) {
+	base, closeBase := newTestDB(t, LevelImpl)
+	defer closeBase()
+	top, closeTop := newTestDB(t, LevelImpl)
+	defer closeTop()
+
+	for _, k := range []string{"a", "b", "c"} {
+		base.Set([]byte(k), []byte(k))
+	}
+	o := Overlay(top, base)
+	o.Set([]byte("b"), []byte("bb"))
+	o.Set([]byte("d"), []byte("d"))
+	tx := o.NewTx()
+	tx.Delete([]byte("c"))
+	tx.Commit()
+
+	assert.Equal(t, []byte("a"), o.Get([]byte("a")))
+	assert.Equal(t, []byte("bb"), o.Get([]byte("b")))
+	assert.False(t, o.Exist([]byte("c")))
+	assert.Equal(t, []byte("c"), base.Get([]byte("c")), "base is not modified")
+	assert.Equal(t, []byte("b"), base.Get([]byte("b")), "base is not modified")
+
+	var kvs []string
+	for it := o.Iterator(nil, nil); it.Valid(); it.Next() {
+		kvs = append(kvs, string(it.Key())+"="+string(it.Value()))
+	}
+	assert.Equal(t, []string{"a=a", "b=bb", "d=d"}, kvs)
+
+	kvs = nil
+	for it := o.Iterator([]byte("d"), []byte("a")); it.Valid(); it.Next() {
+		kvs = append(kvs, string(it.Key()))
+	}
+	assert.Equal(t, []string{"d", "b", "a"}, kvs, "reverse order")
+}
@@ -292,6 +292,44 @@ func TestTrieMerkleProofCompressed(t *testing.T) {
 	}
 }
 
+func TestTrieWalk(t *testing.T) {
+	smt := NewTrie(nil, common.Hasher, nil)
+	keys := getFreshData(100, 32)
+	values := getFreshData(100, 32)
+	smt.Update(keys, values)
+
+	var walked [][]byte
+	err := smt.Walk(smt.Root, nil, func(key, value []byte) bool {
+		walked = append(walked, key)
+		if i := len(walked) - 1; !bytes.Equal(keys[i], key) || !bytes.Equal(values[i], value) {
+			t.Fatal("walked key and value are not in order")
+		}
+		return false
+	})
+	if err != nil || len(walked) != len(keys) {
+		t.Fatal("failed to walk all keys", err, len(walked))
+	}
+
+	// walk from the key in the middle, and one just after it
+	start := keys[40]
+	next := append([]byte(nil), start...)
+	next[len(next)-1]++
+	for i, from := range [][]byte{start, next} {
+		var first []byte
+		count := 0
+		smt.Walk(smt.Root, from, func(key, value []byte) bool {
+			if first == nil {
+				first = key
+			}
+			count++
+			return count == 10
+		})
+		if !bytes.Equal(keys[40+i], first) || count != 10 {
+			t.Fatal("failed to walk from the start key", i)
+		}
+	}
+}
+
 func TestTrieCommit(t *testing.T) {
 	dbPath := path.Join(".aergo", "db")
 	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
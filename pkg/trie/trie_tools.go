@@ -110,6 +110,43 @@ func (s *Trie) get(root, key []byte, batch [][]byte, iBatch, height int) ([]byte
 	return s.get(lnode, key, batch, 2*iBatch+1, height-1)
 }
 
+// Walk calls fn with each key which is greater than or equal to start and its value in ascending order of keys,
+// given a trie root. The whole trie is walked if start is nil, and it stops walking when fn returns true.
+func (s *Trie) Walk(root, start []byte, fn func(key, value []byte) bool) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	s.atomicUpdate = false
+	_, err := s.walk(root, start, nil, 0, s.TrieHeight, fn)
+	return err
+}
+
+// walk returns true if fn stopped walking
+func (s *Trie) walk(root, start []byte, batch [][]byte, iBatch, height int, fn func(key, value []byte) bool) (bool, error) {
+	if len(root) == 0 {
+		return false, nil
+	}
+	batch, iBatch, lnode, rnode, isShortcut, err := s.loadChildren(root, height, iBatch, batch)
+	if err != nil {
+		return false, err
+	}
+	if isShortcut {
+		key := lnode[:HashLength]
+		if start != nil && bytes.Compare(key, start) < 0 {
+			return false, nil
+		}
+		// copy, since the nodes are shared with cache
+		return fn(append([]byte(nil), key...), append([]byte(nil), rnode[:HashLength]...)), nil
+	}
+	if start != nil && bitIsSet(start, s.TrieHeight-height) {
+		return s.walk(rnode, start, batch, 2*iBatch+2, height-1, fn)
+	}
+	if stop, err := s.walk(lnode, start, batch, 2*iBatch+1, height-1, fn); stop || err != nil {
+		return stop, err
+	}
+	// all keys in right subtree are greater than start
+	return s.walk(rnode, nil, batch, 2*iBatch+2, height-1, fn)
+}
+
 // TrieRootExists returns true if the root exists in Database.
 func (s *Trie) TrieRootExists(root []byte) bool {
 	s.db.lock.RLock()
@@ -0,0 +1,107 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"google.golang.org/grpc"
+)
+
+// AllowlistRetryInterval is the interval to reconnect to aergo node after the block stream is broken.
+const AllowlistRetryInterval = time.Second * 10
+
+// allowlistWatcher follows the on-chain peer allowlist of permissioned network via rpc of an aergo node, since
+// polaris doesn't have chain state. It reads the allowlist at every new block, and notifies it to the map service
+// only if it is changed. The map service denies all peers while the rpc is unreachable.
+type allowlistWatcher struct {
+	pms    *PeerMapService
+	logger *log.Logger
+	addr   string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newAllowlistWatcher(pms *PeerMapService, addr string) *allowlistWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &allowlistWatcher{pms: pms, logger: pms.Logger, addr: addr, ctx: ctx, cancel: cancel}
+}
+
+func (w *allowlistWatcher) Start() {
+	if len(w.addr) == 0 {
+		return
+	}
+	w.logger.Info().Str("rpc", w.addr).Msg("Starting to follow peer allowlist of chain")
+	go w.run()
+}
+
+func (w *allowlistWatcher) Stop() {
+	w.cancel()
+}
+
+func (w *allowlistWatcher) run() {
+	for {
+		err := w.watch()
+		if w.ctx.Err() != nil {
+			return
+		}
+		// fail closed: the allowlist may be changed while it can't be followed
+		w.pms.forgetAllowedPeers()
+		w.logger.Info().Err(err).Str("rpc", w.addr).Msg("Failed to follow peer allowlist, denying all peers until retried")
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(AllowlistRetryInterval):
+		}
+	}
+}
+
+func (w *allowlistWatcher) watch() error {
+	conn, err := grpc.DialContext(w.ctx, w.addr, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := types.NewAergoRPCServiceClient(conn)
+
+	stream, err := client.ListBlockMetadataStream(w.ctx, &types.Empty{})
+	if err != nil {
+		return err
+	}
+	// read once before the first block, since the stream only sends new blocks
+	if err = w.refresh(client); err != nil {
+		return err
+	}
+	for {
+		if _, err = stream.Recv(); err != nil {
+			return err
+		}
+		if err = w.refresh(client); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *allowlistWatcher) refresh(client types.AergoRPCServiceClient) error {
+	msg, err := client.GetAllowedPeers(w.ctx, &types.Empty{})
+	if err != nil {
+		return err
+	}
+	ids := make([]peer.ID, len(msg.GetIds()))
+	for i, id := range msg.GetIds() {
+		ids[i] = peer.ID(id)
+	}
+	if w.pms.setAllowedPeers(ids) {
+		w.logger.Info().Array("allowed", p2putil.NewLogB58EncMarshaler(msg.GetIds(), 10)).Msg("Peer allowlist is changed")
+	}
+	return nil
+}
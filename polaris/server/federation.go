@@ -0,0 +1,147 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pkey"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/polaris/common"
+	"github.com/aergoio/aergo/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// FederationSyncInterval is interval for fetching peer map from other polarises.
+const FederationSyncInterval = time.Minute
+
+// federation exchanges registered peers with other polarises serving the same chain, so that any
+// polaris can answer map query with the full network view. Members are polarises set in npaddpolarises
+// of polaris configuration. It uses the map query protocol of polaris, and the chain id check in
+// handleQuery of the remote polaris prevents mixing peers of different chains.
+//
+// Registrations are not pushed to other polarises. Each polaris pulls the peer map of every member
+// in FederationSyncInterval, and a response holds at most ResponseMaxPeerLimit peers picked in random
+// order. So a new registration reaches other polarises within FederationSyncInterval, and a map larger than
+// ResponseMaxPeerLimit is completed in several rounds. Peers got from other polarises are not passed on
+// again, so every polaris must list all the others in npaddpolarises to get the full view.
+type federation struct {
+	pms     *PeerMapService
+	logger  *log.Logger
+	members []p2pcommon.PeerMeta
+	finish  chan interface{}
+}
+
+func newFederation(pms *PeerMapService, addrs []string) *federation {
+	f := &federation{pms: pms, logger: pms.Logger, finish: make(chan interface{}, 1)}
+	for _, addrStr := range addrs {
+		meta, err := p2putil.ParseMultiAddrString(addrStr)
+		if err != nil {
+			f.logger.Info().Str("addr_str", addrStr).Msg("invalid polaris address in config file ")
+			continue
+		}
+		f.members = append(f.members, meta)
+	}
+	return f
+}
+
+func (f *federation) isMember(peerID peer.ID) bool {
+	for _, meta := range f.members {
+		if meta.ID == peerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *federation) Start() {
+	if len(f.members) == 0 {
+		f.logger.Info().Msg("no other polaris is set, so peer map is not shared")
+		return
+	}
+	f.logger.Info().Array("polarises", p2putil.NewLogPeerMetasMarshaler(f.members, 10)).Msg("Starting to share peer map with other polarises")
+	go f.run()
+}
+
+func (f *federation) Stop() {
+	if len(f.members) == 0 {
+		return
+	}
+	f.finish <- struct{}{}
+}
+
+func (f *federation) run() {
+	ticker := time.NewTicker(FederationSyncInterval)
+	defer ticker.Stop()
+	f.syncAll()
+	for {
+		select {
+		case <-ticker.C:
+			f.syncAll()
+		case <-f.finish:
+			f.logger.Info().Msg("Polaris federation finished")
+			return
+		}
+	}
+}
+
+func (f *federation) syncAll() {
+	for _, meta := range f.members {
+		addrs, err := f.queryMember(meta)
+		if err != nil {
+			f.logger.Debug().Err(err).Str(p2putil.LogPeerID, p2putil.ShortForm(meta.ID)).Msg("failed to get peer map from other polaris")
+			continue
+		}
+		added := f.pms.mergePeers(addrs, f.isMember)
+		f.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(meta.ID)).Int("peer_cnt", len(addrs)).Int("added", added).Msg("Got peer map from other polaris")
+	}
+}
+
+func (f *federation) queryMember(meta p2pcommon.PeerMeta) ([]*types.PeerAddress, error) {
+	nt := f.pms.nt
+	s, err := nt.GetOrCreateStreamWithTTL(meta, common.PolarisMapSub, common.PolarisConnectionTTL)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+
+	rw := p2p.NewV030ReadWriter(bufio.NewReader(s), bufio.NewWriter(s))
+
+	selfAddr := nt.SelfMeta().ToPeerAddress()
+	chainBytes, _ := f.pms.ntc.ChainID().Bytes()
+	status := &types.Status{Sender: &selfAddr, ChainID: chainBytes, Version: p2pkey.NodeVersion(), NoExpose: true}
+	// polaris itself is not a node, so it must not be registered to remote polaris.
+	query := &types.MapQuery{Status: status, Size: ResponseMaxPeerLimit, AddMe: false}
+	bytes, err := p2putil.MarshalMessage(query)
+	if err != nil {
+		return nil, err
+	}
+	msgID := p2pcommon.NewMsgID()
+	if err = rw.WriteMsg(common.NewPolarisMessage(msgID, common.MapQuery, bytes)); err != nil {
+		return nil, err
+	}
+
+	data, err := rw.ReadMsg()
+	if err != nil {
+		return nil, err
+	}
+	if data.OriginalID() != msgID {
+		return nil, fmt.Errorf("unexpected response id %s", data.OriginalID().String())
+	}
+	resp := &types.MapResponse{}
+	if err = p2putil.UnmarshalMessage(data.Payload(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != types.ResultStatus_OK {
+		return nil, fmt.Errorf("remote error %s : %s", resp.Status.String(), resp.Message)
+	}
+	return resp.Addresses, nil
+}
@@ -79,4 +79,5 @@ func (hcm *healthCheckManager) checkPeers() {
 	}
 	wg.Wait()
 	hcm.logger.Debug().Msg("Finished checks")
+	hcm.ms.saveRegistry()
 }
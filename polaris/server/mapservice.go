@@ -9,6 +9,7 @@ import (
 	"bufio"
 	"fmt"
 	"math"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -51,6 +52,8 @@ type mapService interface {
 	getPeerCheckers() []peerChecker
 	registerPeer(receivedMeta p2pcommon.PeerMeta) error
 	unregisterPeer(peerID peer.ID)
+	// saveRegistry writes registered peers and their health check states to disk.
+	saveRegistry()
 }
 
 type peerChecker interface {
@@ -69,9 +72,20 @@ type PeerMapService struct {
 	ntc p2pcommon.NTContainer
 	nt  p2pcommon.NetworkTransport
 	hc  HealthCheckManager
+	fed *federation
+	alw *allowlistWatcher
+
+	// store is nil if peer map is not persisted
+	store *peerMapStore
 
 	rwmutex      *sync.RWMutex
 	peerRegistry map[peer.ID]*peerState
+	// allowedPeers is the on-chain allowlist of permissioned network. all peers are allowed if it is empty.
+	allowedPeers map[peer.ID]bool
+	// allowlistRequired is set if the allowlist is followed by rpc. no peer is allowed while the allowlist is
+	// unknown, so that an unreachable aergo node doesn't open the permissioned network to anyone.
+	allowlistRequired bool
+	allowlistKnown    bool
 }
 
 func NewPolarisService(cfg *config.Config, ntc p2pcommon.NTContainer) *PeerMapService {
@@ -85,6 +99,16 @@ func NewPolarisService(cfg *config.Config, ntc p2pcommon.NTContainer) *PeerMapSe
 
 	pms.ntc = ntc
 	pms.hc = NewHCM(pms, pms.nt)
+	pms.fed = newFederation(pms, cfg.P2P.NPAddPolarises)
+	pms.alw = newAllowlistWatcher(pms, cfg.Polaris.AllowlistRPC)
+	pms.allowlistRequired = len(cfg.Polaris.AllowlistRPC) > 0
+	if len(cfg.Polaris.PeerMapFile) > 0 {
+		path := cfg.Polaris.PeerMapFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(cfg.DataDir, path)
+		}
+		pms.store = newPeerMapStore(path)
+	}
 
 	pms.PrivateNet = !ntc.ChainID().MainNet
 
@@ -96,20 +120,27 @@ func (pms *PeerMapService) SetHub(hub *component.ComponentHub) {
 	pms.BaseComponent.SetHub(hub)
 }
 
-func (pms *PeerMapService) BeforeStart() {}
+func (pms *PeerMapService) BeforeStart() {
+	pms.loadRegistry()
+}
 
 func (pms *PeerMapService) AfterStart() {
 	pms.nt = pms.ntc.GetNetworkTransport()
 	pms.Logger.Info().Str("version", string(common.PolarisMapSub)).Msg("Starting polaris listening")
 	pms.nt.AddStreamHandler(common.PolarisMapSub, pms.onConnect)
 	pms.hc.Start()
+	pms.fed.Start()
+	pms.alw.Start()
 }
 
 func (pms *PeerMapService) BeforeStop() {
+	pms.alw.Stop()
 	if pms.nt != nil {
+		pms.fed.Stop()
 		pms.hc.Stop()
 		pms.nt.RemoveStreamHandler(common.PolarisMapSub)
 	}
+	pms.saveRegistry()
 }
 
 func (pms *PeerMapService) Statistics() *map[string]interface{} {
@@ -203,6 +234,13 @@ func (pms *PeerMapService) handleQuery(container p2pcommon.Message, query *types
 		return resp, nil
 	}
 
+	if !pms.isAllowed(receivedMeta.ID) {
+		pms.Logger.Debug().Str(p2putil.LogPeerID, receivedMeta.ID.String()).Msg("err not allowed peer")
+		resp.Status = types.ResultStatus_PERMISSION_DENIED
+		resp.Message = "not allowed peer"
+		return resp, nil
+	}
+
 	resp.Addresses = pms.retrieveList(maxPeers, receivedMeta.ID)
 
 	// old syntax (AddMe) and newer syntax (status.NoExpose) for expose peer
@@ -228,7 +266,7 @@ func (pms *PeerMapService) retrieveList(maxPeers int, exclude peer.ID) []*types.
 	pms.rwmutex.Lock()
 	defer pms.rwmutex.Unlock()
 	for id, ps := range pms.peerRegistry {
-		if id == exclude {
+		if id == exclude || !pms.isAllowedLocked(id) {
 			continue
 		}
 		list = append(list, &ps.addr)
@@ -260,6 +298,29 @@ func (pms *PeerMapService) registerPeer(receivedMeta p2pcommon.PeerMeta) error {
 	return nil
 }
 
+// mergePeers registers peers which are got from other polaris. Already registered peers are kept as is,
+// since this polaris has fresher information about them. It returns the number of newly added peers.
+func (pms *PeerMapService) mergePeers(addrs []*types.PeerAddress, isPolaris func(peer.ID) bool) int {
+	selfID := pms.nt.SelfMeta().ID
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	now := time.Now()
+	added := 0
+	for _, addr := range addrs {
+		meta := p2pcommon.FromPeerAddress(addr)
+		if meta.ID == selfID || isPolaris(meta.ID) {
+			continue
+		}
+		if _, exist := pms.peerRegistry[meta.ID]; exist || !pms.isAllowedLocked(meta.ID) {
+			continue
+		}
+		// newly added peer will be verified by own health check
+		pms.peerRegistry[meta.ID] = &peerState{connected: now, PeerMapService: pms, meta: meta, addr: meta.ToPeerAddress(), lCheckTime: now}
+		added++
+	}
+	return added
+}
+
 func (pms *PeerMapService) unregisterPeer(peerID peer.ID) {
 	pms.rwmutex.Lock()
 	defer pms.rwmutex.Unlock()
@@ -268,6 +329,99 @@ func (pms *PeerMapService) unregisterPeer(peerID peer.ID) {
 
 }
 
+// setAllowedPeers replaces the allowlist and unregisters peers not in the new one. It returns false if the
+// allowlist is not changed.
+func (pms *PeerMapService) setAllowedPeers(ids []peer.ID) bool {
+	allowed := make(map[peer.ID]bool, len(ids))
+	for _, id := range ids {
+		allowed[id] = true
+	}
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	known := pms.allowlistKnown
+	pms.allowlistKnown = true
+	if known && len(allowed) == len(pms.allowedPeers) {
+		same := true
+		for id := range allowed {
+			if !pms.allowedPeers[id] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return false
+		}
+	}
+	pms.allowedPeers = allowed
+	for id := range pms.peerRegistry {
+		if !pms.isAllowedLocked(id) {
+			pms.Logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(id)).Msg("Unregistering peer not in allowlist")
+			delete(pms.peerRegistry, id)
+		}
+	}
+	return true
+}
+
+// forgetAllowedPeers makes the allowlist unknown when it can't be followed. no peer is allowed until it is read
+// again if the allowlist is required.
+func (pms *PeerMapService) forgetAllowedPeers() {
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	pms.allowlistKnown = false
+}
+
+func (pms *PeerMapService) isAllowed(id peer.ID) bool {
+	pms.rwmutex.RLock()
+	defer pms.rwmutex.RUnlock()
+	return pms.isAllowedLocked(id)
+}
+
+func (pms *PeerMapService) isAllowedLocked(id peer.ID) bool {
+	if pms.allowlistRequired && !pms.allowlistKnown {
+		return false
+	}
+	return len(pms.allowedPeers) == 0 || pms.allowedPeers[id]
+}
+
+func (pms *PeerMapService) loadRegistry() {
+	if pms.store == nil {
+		return
+	}
+	peers, err := pms.store.load()
+	if err != nil {
+		pms.Logger.Warn().Err(err).Str("path", pms.store.path).Msg("failed to load stored peer map")
+		return
+	}
+	now := time.Now()
+	pms.rwmutex.Lock()
+	defer pms.rwmutex.Unlock()
+	for _, sp := range peers {
+		if sp.Address == nil || sp.expired(now) {
+			continue
+		}
+		ps := sp.toPeerState(pms)
+		pms.peerRegistry[ps.meta.ID] = ps
+	}
+	pms.Logger.Info().Int("peer_cnt", len(pms.peerRegistry)).Str("path", pms.store.path).Msg("Loaded stored peer map")
+}
+
+func (pms *PeerMapService) saveRegistry() {
+	if pms.store == nil {
+		return
+	}
+	pms.rwmutex.RLock()
+	peers := make([]storedPeer, 0, len(pms.peerRegistry))
+	for _, ps := range pms.peerRegistry {
+		peers = append(peers, toStoredPeer(ps))
+	}
+	pms.rwmutex.RUnlock()
+	if err := pms.store.save(peers); err != nil {
+		pms.Logger.Warn().Err(err).Str("path", pms.store.path).Msg("failed to save peer map")
+		return
+	}
+	pms.Logger.Debug().Int("peer_cnt", len(peers)).Msg("Saved peer map")
+}
+
 func (pms *PeerMapService) writeResponse(reqContainer p2pcommon.Message, meta p2pcommon.PeerMeta, resp *types.MapResponse, wt p2pcommon.MsgWriter) error {
 	msgID := p2pcommon.NewMsgID()
 	respMsg, err := createV030Message(msgID, reqContainer.ID(), common.MapResponse, resp)
@@ -252,6 +252,81 @@ func TestPeerMapService_registerPeer(t *testing.T) {
 	}
 }
 
+func TestPeerMapService_setAllowedPeers(t *testing.T) {
+	pms := NewPolarisService(pmapDummyCfg, pmapDummyNTC)
+	for _, meta := range metas[:5] {
+		pms.registerPeer(meta)
+	}
+	assert.True(t, pms.isAllowed(metas[7].ID), "all peers are allowed without allowlist")
+
+	allowed := []peer.ID{metas[0].ID, metas[1].ID, metas[7].ID}
+	assert.True(t, pms.setAllowedPeers(allowed))
+	assert.False(t, pms.setAllowedPeers([]peer.ID{metas[7].ID, metas[1].ID, metas[0].ID}), "same allowlist in other order")
+	assert.Equal(t, 2, len(pms.peerRegistry), "peers not in allowlist are unregistered")
+	assert.False(t, pms.isAllowed(metas[2].ID))
+
+	pms.registerPeer(metas[3])
+	pms.registerPeer(metas[7])
+	list := pms.retrieveList(10, metas[0].ID)
+	assert.Equal(t, 2, len(list))
+
+	assert.True(t, pms.setAllowedPeers(nil))
+	assert.True(t, pms.isAllowed(metas[2].ID))
+}
+
+func TestPeerMapService_allowlistFailClosed(t *testing.T) {
+	pms := NewPolarisService(pmapDummyCfg, pmapDummyNTC)
+	pms.allowlistRequired = true
+	pms.registerPeer(metas[0])
+	assert.False(t, pms.isAllowed(metas[0].ID), "no peer is allowed before the allowlist is read")
+	assert.Empty(t, pms.retrieveList(10, metas[1].ID))
+
+	assert.True(t, pms.setAllowedPeers(nil), "empty allowlist is read")
+	assert.True(t, pms.isAllowed(metas[0].ID))
+
+	pms.forgetAllowedPeers()
+	assert.False(t, pms.isAllowed(metas[0].ID), "no peer is allowed while the rpc is unreachable")
+	assert.True(t, pms.setAllowedPeers(nil), "same allowlist read again after failure")
+	assert.True(t, pms.isAllowed(metas[0].ID))
+}
+
+func TestPeerMapService_mergePeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockNT := p2pmock.NewMockNetworkTransport(ctrl)
+	mockNT.EXPECT().SelfMeta().Return(metas[0]).AnyTimes()
+	pms := NewPolarisService(pmapDummyCfg, pmapDummyNTC)
+	pms.nt = mockNT
+
+	known := metas[2]
+	known.IPAddress, known.Port = "192.168.1.2", 7846
+	pms.registerPeer(known)
+	isPolaris := func(id peer.ID) bool { return id == metas[1].ID }
+
+	addrs := make([]*types.PeerAddress, 0, 6)
+	for i, meta := range metas[:6] {
+		meta.IPAddress, meta.Port = fmt.Sprintf("10.0.0.%d", i), 7846
+		addr := meta.ToPeerAddress()
+		addrs = append(addrs, &addr)
+	}
+	assert.Equal(t, 3, pms.mergePeers(addrs, isPolaris), "self, other polaris and known peer are not added")
+	assert.Equal(t, 4, len(pms.peerRegistry))
+	assert.Equal(t, known.IPAddress, pms.peerRegistry[known.ID].meta.IPAddress, "info of known peer is kept")
+	assert.NotContains(t, pms.peerRegistry, metas[0].ID)
+	assert.NotContains(t, pms.peerRegistry, metas[1].ID)
+	assert.Equal(t, 0, pms.mergePeers(addrs, isPolaris), "merging same map again")
+
+	assert.True(t, pms.setAllowedPeers([]peer.ID{metas[3].ID, metas[7].ID}))
+	added := make([]*types.PeerAddress, 0, 2)
+	for _, meta := range metas[6:8] {
+		addr := meta.ToPeerAddress()
+		added = append(added, &addr)
+	}
+	assert.Equal(t, 1, pms.mergePeers(added, isPolaris), "peer not in allowlist is not added")
+	assert.Contains(t, pms.peerRegistry, metas[7].ID)
+	assert.NotContains(t, pms.peerRegistry, metas[6].ID)
+}
+
 func TestPeerMapService_unregisterPeer(t *testing.T) {
 	dupMetas := MakeMetaSlice(metas[2:5], metas[3:7])
 	allSize := len(metas)
@@ -0,0 +1,91 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+// StoredPeerTTL is the longest time since the last health check of stored peer to be restored. Polaris that
+// was stopped for long would serve peers gone away in the meantime until they are checked again, otherwise.
+const StoredPeerTTL = time.Hour
+
+// storedPeer is persisted form of peerState
+type storedPeer struct {
+	Address   *types.PeerAddress `json:"address"`
+	Version   string             `json:"version"`
+	Connected int64              `json:"connected"`
+	LastCheck int64              `json:"lastCheck"`
+	ContFail  int32              `json:"contFail"`
+}
+
+// peerMapStore saves peer registry and health check states of polaris to file, so polaris can restore
+// the peer map after restart without waiting nodes to register again.
+type peerMapStore struct {
+	path string
+}
+
+func newPeerMapStore(path string) *peerMapStore {
+	return &peerMapStore{path: path}
+}
+
+// save overwrites store file with peers. It writes to temporary file first and then rename it, to not
+// break previous file when polaris is killed during writing.
+func (s *peerMapStore) save(peers []storedPeer) error {
+	data, err := json.Marshal(peers)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	tmpPath := s.path + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, s.path)
+}
+
+// load returns peers in store file. It returns empty list if file is not exist yet.
+func (s *peerMapStore) load() ([]storedPeer, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var peers []storedPeer
+	if err = json.Unmarshal(data, &peers); err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
+
+func (sp storedPeer) expired(now time.Time) bool {
+	return now.Sub(time.Unix(0, sp.LastCheck)) > StoredPeerTTL
+}
+
+func toStoredPeer(ps *peerState) storedPeer {
+	addr := ps.addr
+	return storedPeer{Address: &addr, Version: ps.meta.Version, Connected: ps.connected.UnixNano(),
+		LastCheck: ps.lastCheck().UnixNano(), ContFail: atomic.LoadInt32(&ps.contFail)}
+}
+
+func (sp storedPeer) toPeerState(pms *PeerMapService) *peerState {
+	meta := p2pcommon.FromPeerAddress(sp.Address)
+	meta.Version = sp.Version
+	return &peerState{PeerMapService: pms, meta: meta, addr: meta.ToPeerAddress(), connected: time.Unix(0, sp.Connected),
+		lCheckTime: time.Unix(0, sp.LastCheck), contFail: sp.ContFail}
+}
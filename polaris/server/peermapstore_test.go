@@ -0,0 +1,94 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_peerMapStore_saveAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "polaris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := newPeerMapStore(filepath.Join(dir, "sub", "peermap.json"))
+	// not exist yet
+	loaded, err := store.load()
+	assert.Nil(t, err)
+	assert.Empty(t, loaded)
+
+	pms := &PeerMapService{}
+	now := time.Now()
+	meta1 := p2pcommon.PeerMeta{ID: peer.ID("peer1"), IPAddress: "192.168.1.2", Port: 7846, Version: "v1.2.0"}
+	meta2 := p2pcommon.PeerMeta{ID: peer.ID("peer2"), IPAddress: "192.168.1.3", Port: 7847}
+	states := []*peerState{
+		{PeerMapService: pms, meta: meta1, addr: meta1.ToPeerAddress(), connected: now.Add(-time.Hour), lCheckTime: now},
+		{PeerMapService: pms, meta: meta2, addr: meta2.ToPeerAddress(), connected: now, lCheckTime: now, contFail: 1},
+	}
+	toStore := make([]storedPeer, 0, len(states))
+	for _, ps := range states {
+		toStore = append(toStore, toStoredPeer(ps))
+	}
+	assert.Nil(t, store.save(toStore))
+
+	loaded, err = store.load()
+	assert.Nil(t, err)
+	assert.Equal(t, len(states), len(loaded))
+	for i, sp := range loaded {
+		ps := sp.toPeerState(pms)
+		assert.Equal(t, states[i].meta, ps.meta)
+		assert.Equal(t, states[i].addr.Address, ps.addr.Address)
+		assert.Equal(t, states[i].connected.UnixNano(), ps.connected.UnixNano())
+		assert.Equal(t, states[i].lCheckTime.UnixNano(), ps.lastCheck().UnixNano())
+		assert.Equal(t, states[i].contFail, ps.contFail)
+	}
+}
+
+func TestPeerMapService_loadRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "polaris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := &config.Config{BaseConfig: config.BaseConfig{DataDir: dir}, P2P: &config.P2PConfig{},
+		Polaris: &config.PolarisConfig{PeerMapFile: "peermap.json"}}
+	pms := NewPolarisService(cfg, pmapDummyNTC)
+	assert.Equal(t, filepath.Join(dir, "peermap.json"), pms.store.path, "relative path is based on datadir")
+
+	now := time.Now()
+	fresh := p2pcommon.PeerMeta{ID: peer.ID("fresh"), IPAddress: "192.168.1.2", Port: 7846}
+	old := p2pcommon.PeerMeta{ID: peer.ID("old"), IPAddress: "192.168.1.3", Port: 7846}
+	states := []*peerState{
+		{PeerMapService: pms, meta: fresh, addr: fresh.ToPeerAddress(), connected: now.Add(-time.Hour * 2), lCheckTime: now.Add(-time.Minute)},
+		{PeerMapService: pms, meta: old, addr: old.ToPeerAddress(), connected: now.Add(-time.Hour * 2), lCheckTime: now.Add(-StoredPeerTTL - time.Minute)},
+	}
+	toStore := make([]storedPeer, 0, len(states))
+	for _, ps := range states {
+		toStore = append(toStore, toStoredPeer(ps))
+	}
+	assert.Nil(t, pms.store.save(toStore))
+
+	pms.loadRegistry()
+	assert.Equal(t, 1, len(pms.peerRegistry), "peer not checked for long is expired")
+	assert.Contains(t, pms.peerRegistry, fresh.ID)
+	assert.NotContains(t, pms.peerRegistry, old.ID)
+
+	abs := filepath.Join(dir, "other", "peermap.json")
+	cfg.Polaris.PeerMapFile = abs
+	assert.Equal(t, abs, NewPolarisService(cfg, pmapDummyNTC).store.path)
+}
@@ -0,0 +1,368 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// MethodGroup is the access unit of rpc methods in the auth policy.
+type MethodGroup string
+
+const (
+	// GroupPublic is the read only methods of chain data
+	GroupPublic MethodGroup = "public"
+	// GroupTx is the submission of signed txs
+	GroupTx MethodGroup = "tx"
+	// GroupPersonal is the methods using the keys in node
+	GroupPersonal MethodGroup = "personal"
+	// GroupAdmin is the methods about node and network status
+	GroupAdmin MethodGroup = "admin"
+)
+
+const (
+	// IdentityAnonymous is the identity of the requests without any credential
+	IdentityAnonymous = "anonymous"
+	// identityAnyAuthenticated matches every identity except anonymous in the policy
+	identityAnyAuthenticated = "*"
+
+	authMetadataKey = "authorization"
+	bearerPrefix    = "bearer "
+	methodPrefix    = "/types.AergoRPCService/"
+)
+
+// methodGroups maps rpc methods to the groups. methods not in it belong to admin group, so that a new method
+// is not opened by mistake.
+var methodGroups = map[string]MethodGroup{
+	"Blockchain":              GroupPublic,
+	"GetChainInfo":            GroupPublic,
+	"ChainStat":               GroupPublic,
+	"ListBlockHeaders":        GroupPublic,
+	"ListBlockMetadata":       GroupPublic,
+	"GetBlock":                GroupPublic,
+	"GetBlockMetadata":        GroupPublic,
+	"GetBlockBody":            GroupPublic,
+	"GetTX":                   GroupPublic,
+	"GetBlockTX":              GroupPublic,
+	"GetReceipt":              GroupPublic,
+	"GetABI":                  GroupPublic,
+	"VerifyTX":                GroupPublic,
+	"GetState":                GroupPublic,
+	"GetStateAndProof":        GroupPublic,
+	"QueryContract":           GroupPublic,
+	"QueryContractState":      GroupPublic,
+	"GetVotes":                GroupPublic,
+	"GetAccountVotes":         GroupPublic,
+	"GetStaking":              GroupPublic,
+	"GetNameInfo":             GroupPublic,
+	"GetNameByAddress":        GroupPublic,
+	"GetDelegation":           GroupPublic,
+	"ListProposals":           GroupPublic,
+	"GetAllowedPeers":         GroupPublic,
+	"ListEvents":              GroupPublic,
+	"GetConsensusInfo":        GroupPublic,
+	"GetTxProof":              GroupPublic,
+	"GetReceiptProof":         GroupPublic,
+	"GetEventProof":           GroupPublic,
+	"ListBlockStream":         GroupPublic,
+	"ListBlockMetadataStream": GroupPublic,
+	"ListEventStream":         GroupPublic,
+
+	"CommitTX": GroupTx,
+
+	"SendTX":                GroupPersonal,
+	"SignTX":                GroupPersonal,
+	"CreateAccount":         GroupPersonal,
+	"GetAccounts":           GroupPersonal,
+	"LockAccount":           GroupPersonal,
+	"UnlockAccount":         GroupPersonal,
+	"ImportAccount":         GroupPersonal,
+	"ExportAccount":         GroupPersonal,
+	"ExportAccountKeystore": GroupPersonal,
+	"CreateAccountHD":       GroupPersonal,
+	"RecoverAccountHD":      GroupPersonal,
+
+	"NodeState":     GroupAdmin,
+	"Metric":        GroupAdmin,
+	"GetPeers":      GroupAdmin,
+	"GetServerInfo": GroupAdmin,
+	"GetDBStats":    GroupAdmin,
+}
+
+// GroupOfMethod returns the group of full method name of grpc like /types.AergoRPCService/GetBlock
+func GroupOfMethod(fullMethod string) MethodGroup {
+	if group, exist := methodGroups[strings.TrimPrefix(fullMethod, methodPrefix)]; exist {
+		return group
+	}
+	return GroupAdmin
+}
+
+// default policy when it is not configured. it is the same as the access without auth, except admin methods.
+var defaultPolicy = map[string][]MethodGroup{
+	IdentityAnonymous:        {GroupPublic, GroupTx},
+	identityAnyAuthenticated: {GroupPublic, GroupTx, GroupPersonal, GroupAdmin},
+}
+
+var (
+	ErrInvalidToken = errors.New("invalid auth token")
+	ErrTokenExpired = errors.New("auth token expired")
+)
+
+type identityKey struct{}
+
+// IdentityFromContext returns the identity of rpc client authenticated by auth interceptor.
+func IdentityFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(identityKey{}).(string); ok {
+		return id
+	}
+	return IdentityAnonymous
+}
+
+// Authenticator identifies rpc clients by api token, jwt or tls client certificate, and checks the policy
+// on method groups. The identity of the client certificate is its common name.
+type Authenticator struct {
+	tokens    map[string]string // token -> identity
+	jwtSecret []byte
+	policy    map[string]map[MethodGroup]bool
+
+	// tls connections by remote address. cmux hides tls state from grpc and http server, so the client
+	// certificate is found by the remote address of request.
+	tlsConns sync.Map
+}
+
+// NewAuthenticator builds the authenticator from rpc config.
+func NewAuthenticator(cfg *config.RPCConfig) (*Authenticator, error) {
+	a := &Authenticator{
+		tokens:    make(map[string]string),
+		jwtSecret: []byte(cfg.NSJWTSecret),
+		policy:    make(map[string]map[MethodGroup]bool),
+	}
+	for _, entry := range cfg.NSTokens {
+		idx := strings.Index(entry, ":")
+		if idx <= 0 || idx == len(entry)-1 {
+			return nil, fmt.Errorf("invalid token entry, it must be identity:token")
+		}
+		a.tokens[entry[idx+1:]] = entry[:idx]
+	}
+
+	policy, err := parsePolicy(cfg.NSPolicy)
+	if err != nil {
+		return nil, err
+	}
+	for id, groups := range policy {
+		a.policy[id] = make(map[MethodGroup]bool)
+		for _, g := range groups {
+			a.policy[id][g] = true
+		}
+	}
+	return a, nil
+}
+
+// parsePolicy parses entries like "alice=public,tx,personal". Identity "anonymous" is the client without
+// credential, and "*" is any authenticated client without its own entry.
+func parsePolicy(entries []string) (map[string][]MethodGroup, error) {
+	if len(entries) == 0 {
+		return defaultPolicy, nil
+	}
+	policy := make(map[string][]MethodGroup)
+	for _, entry := range entries {
+		kv := strings.SplitN(entry, "=", 2)
+		id := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || id == "" {
+			return nil, fmt.Errorf("invalid policy entry %s, it must be identity=group,group", entry)
+		}
+		groups := make([]MethodGroup, 0)
+		for _, g := range strings.Split(kv[1], ",") {
+			group := MethodGroup(strings.TrimSpace(g))
+			switch group {
+			case GroupPublic, GroupTx, GroupPersonal, GroupAdmin:
+				groups = append(groups, group)
+			case "":
+			default:
+				return nil, fmt.Errorf("unknown method group %s in policy", group)
+			}
+		}
+		policy[id] = groups
+	}
+	return policy, nil
+}
+
+// authorize authenticates the client of ctx and checks the policy for method. It returns the context with
+// identity.
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	id, err := a.identify(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	group := GroupOfMethod(fullMethod)
+	if !a.allowed(id, group) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s methods", id, group)
+	}
+	return context.WithValue(ctx, identityKey{}, id), nil
+}
+
+func (a *Authenticator) allowed(id string, group MethodGroup) bool {
+	groups, exist := a.policy[id]
+	if !exist && id != IdentityAnonymous {
+		groups = a.policy[identityAnyAuthenticated]
+	}
+	return groups[group]
+}
+
+func (a *Authenticator) identify(ctx context.Context) (string, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(authMetadataKey) {
+			if len(value) > len(bearerPrefix) && strings.ToLower(value[:len(bearerPrefix)]) == bearerPrefix {
+				return a.identifyToken(strings.TrimSpace(value[len(bearerPrefix):]))
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if id := a.certIdentity(p.Addr.String()); id != "" {
+			return id, nil
+		}
+	}
+	return IdentityAnonymous, nil
+}
+
+func (a *Authenticator) identifyToken(token string) (string, error) {
+	for t, id := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return id, nil
+		}
+	}
+	if len(a.jwtSecret) > 0 && strings.Count(token, ".") == 2 {
+		return a.verifyJWT(token)
+	}
+	return "", ErrInvalidToken
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Sub string `json:"sub"`
+	Exp int64  `json:"exp"`
+	Nbf int64  `json:"nbf"`
+}
+
+// verifyJWT verifies HS256 jwt and returns the subject as identity.
+func (a *Authenticator) verifyJWT(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	header := &jwtHeader{}
+	if err = json.Unmarshal(headerBytes, header); err != nil || header.Alg != "HS256" {
+		return "", ErrInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	mac := hmac.New(sha256.New, a.jwtSecret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return "", ErrInvalidToken
+	}
+	claimBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	claims := &jwtClaims{}
+	if err = json.Unmarshal(claimBytes, claims); err != nil || claims.Sub == "" || claims.Sub == IdentityAnonymous {
+		return "", ErrInvalidToken
+	}
+	now := time.Now().Unix()
+	if (claims.Exp != 0 && now >= claims.Exp) || (claims.Nbf != 0 && now < claims.Nbf) {
+		return "", ErrTokenExpired
+	}
+	return claims.Sub, nil
+}
+
+func (a *Authenticator) certIdentity(remoteAddr string) string {
+	conn, exist := a.tlsConns.Load(remoteAddr)
+	if !exist {
+		return ""
+	}
+	state := conn.(*tls.Conn).ConnectionState()
+	// certificate is verified by tls, if client sent it
+	if len(state.VerifiedChains) == 0 || len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return state.PeerCertificates[0].Subject.CommonName
+}
+
+// UnaryInterceptor checks auth of unary rpc calls.
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor checks auth of stream rpc calls.
+func (a *Authenticator) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// tlsListener keeps the accepted tls connections in authenticator, until they are closed.
+type tlsListener struct {
+	net.Listener
+	auth *Authenticator
+}
+
+func (l *tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if l.auth == nil {
+		return conn, nil
+	}
+	key := conn.RemoteAddr().String()
+	l.auth.tlsConns.Store(key, conn)
+	return &trackedConn{Conn: conn, onClose: func() { l.auth.tlsConns.Delete(key) }}, nil
+}
+
+type trackedConn struct {
+	net.Conn
+	once    sync.Once
+	onClose func()
+}
+
+func (c *trackedConn) Close() error {
+	c.once.Do(c.onClose)
+	return c.Conn.Close()
+}
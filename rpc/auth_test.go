@@ -0,0 +1,111 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodGroupsCoverAllMethods(t *testing.T) {
+	svcType := reflect.TypeOf((*types.AergoRPCServiceServer)(nil)).Elem()
+	for i := 0; i < svcType.NumMethod(); i++ {
+		name := svcType.Method(i).Name
+		_, exist := methodGroups[name]
+		assert.Truef(t, exist, "method %s is not classified in method groups", name)
+	}
+	assert.Equal(t, GroupAdmin, GroupOfMethod(methodPrefix+"NotExistMethod"))
+}
+
+func makeTestJWT(secret string, claims string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(header + "." + payload))
+	return header + "." + payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func callWithToken(a *Authenticator, token string, method string) error {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+	_, err := a.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: methodPrefix + method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return IdentityFromContext(ctx), nil
+		})
+	return err
+}
+
+func TestAuthenticator(t *testing.T) {
+	cfg := &config.RPCConfig{
+		NSAuth:      true,
+		NSTokens:    []string{"wallet:wallettoken", "ops:opstoken"},
+		NSJWTSecret: "jwtsecret",
+		NSPolicy:    []string{"anonymous=public", "wallet=public,tx,personal", "*=public,tx"},
+	}
+	a, err := NewAuthenticator(cfg)
+	assert.NoError(t, err)
+
+	exp := time.Now().Add(time.Hour).Unix()
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		code   codes.Code
+	}{
+		{"anonymous read", "", "GetBlock", codes.OK},
+		{"anonymous commit", "", "CommitTX", codes.PermissionDenied},
+		{"wallet unlock", "wallettoken", "UnlockAccount", codes.OK},
+		{"wallet admin", "wallettoken", "NodeState", codes.PermissionDenied},
+		{"other identity commit", "opstoken", "CommitTX", codes.OK},
+		{"other identity personal", "opstoken", "SignTX", codes.PermissionDenied},
+		{"wrong token", "wrongtoken", "GetBlock", codes.Unauthenticated},
+		{"jwt", makeTestJWT("jwtsecret", fmt.Sprintf(`{"sub":"wallet","exp":%d}`, exp)), "SignTX", codes.OK},
+		{"jwt wrong secret", makeTestJWT("other", `{"sub":"wallet"}`), "GetBlock", codes.Unauthenticated},
+		{"jwt expired", makeTestJWT("jwtsecret", `{"sub":"wallet","exp":1}`), "GetBlock", codes.Unauthenticated},
+		{"jwt anonymous subject", makeTestJWT("jwtsecret", `{"sub":"anonymous"}`), "GetBlock", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		err := callWithToken(a, tt.token, tt.method)
+		assert.Equal(t, tt.code, status.Code(err), tt.name)
+	}
+}
+
+func TestAuthenticatorDefaultPolicy(t *testing.T) {
+	a, err := NewAuthenticator(&config.RPCConfig{NSAuth: true, NSTokens: []string{"admin:admintoken"}})
+	assert.NoError(t, err)
+	assert.NoError(t, callWithToken(a, "", "CommitTX"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(callWithToken(a, "", "UnlockAccount")))
+	assert.NoError(t, callWithToken(a, "admintoken", "NodeState"))
+}
+
+func TestParsePolicy(t *testing.T) {
+	_, err := parsePolicy([]string{"alice"})
+	assert.Error(t, err)
+	_, err = parsePolicy([]string{"alice=public,root"})
+	assert.Error(t, err)
+	policy, err := parsePolicy([]string{"alice = public, tx", "bob="})
+	assert.NoError(t, err)
+	assert.Equal(t, []MethodGroup{GroupPublic, GroupTx}, policy["alice"])
+	assert.Empty(t, policy["bob"])
+
+	_, err = NewAuthenticator(&config.RPCConfig{NSTokens: []string{"notoken"}})
+	assert.Error(t, err)
+}
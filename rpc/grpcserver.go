@@ -13,12 +13,14 @@ import (
 	"encoding/json"
 	"errors"
 	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/internal/common"
@@ -107,7 +109,7 @@ func (rpc *AergoRPCService) fillPeerMetrics(result *types.Metrics) {
 	mets := make([]*types.PeerMetric, len(metrics))
 	for i, met := range metrics {
 		rMet := &types.PeerMetric{PeerID: []byte(met.PeerID), SumIn: met.TotalIn(), AvrIn: met.InMetric.APS(),
-			SumOut: met.TotalOut(), AvrOut: met.OutMetric.APS()}
+			SumOut: met.TotalOut(), AvrOut: met.OutMetric.APS(), SavedIn: met.SavedIn(), SavedOut: met.SavedOut()}
 		mets[i] = rMet
 	}
 
@@ -412,6 +414,9 @@ func (rpc *AergoRPCService) GetBlockBody(ctx context.Context, in *types.BlockBod
 		return nil, err
 	}
 	body := block.GetBody()
+	if body == nil {
+		return nil, status.Errorf(codes.NotFound, "body of block is pruned")
+	}
 
 	total := uint32(len(body.Txs))
 
@@ -477,6 +482,20 @@ func (rpc *AergoRPCService) GetBlockTX(ctx context.Context, in *types.SingleByte
 	return &types.TxInBlock{Tx: rsp.Tx, TxIdx: rsp.TxIds}, rsp.Err
 }
 
+// GetTxProof handle rpc request gettxproof
+func (rpc *AergoRPCService) GetTxProof(ctx context.Context, in *types.SingleBytes) (*types.TxProof, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetTxProof{TxHash: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetTxProof").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetTxProofRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Proof, rsp.Err
+}
+
 var emptyBytes = make([]byte, 0)
 
 // SendTX try to fill the nonce, sign, hash, chainIdHash in the transaction automatically and commit it
@@ -732,8 +751,17 @@ func (rpc *AergoRPCService) ImportAccount(ctx context.Context, in *types.ImportF
 }
 
 func (rpc *AergoRPCService) ExportAccount(ctx context.Context, in *types.Personal) (*types.SingleBytes, error) {
+	return rpc.exportAccount(in, false)
+}
+
+// ExportAccountKeystore handle rpc request exportaccountkeystore
+func (rpc *AergoRPCService) ExportAccountKeystore(ctx context.Context, in *types.Personal) (*types.SingleBytes, error) {
+	return rpc.exportAccount(in, true)
+}
+
+func (rpc *AergoRPCService) exportAccount(in *types.Personal, asKeystore bool) (*types.SingleBytes, error) {
 	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
-		&message.ExportAccount{Account: in.Account, Pass: in.Passphrase},
+		&message.ExportAccount{Account: in.Account, Pass: in.Passphrase, AsKeystore: asKeystore},
 		defaultActorTimeout, "rpc.(*AergoRPCService).ExportAccount")
 	if err != nil {
 		if err == component.ErrHubUnregistered {
@@ -749,6 +777,42 @@ func (rpc *AergoRPCService) ExportAccount(ctx context.Context, in *types.Persona
 	return &types.SingleBytes{Value: rsp.Wif}, rsp.Err
 }
 
+// CreateAccountHD handle rpc request of hd wallet accounts
+func (rpc *AergoRPCService) CreateAccountHD(ctx context.Context, in *types.PersonalHD) (*types.HDAccounts, error) {
+	return rpc.requestHDAccounts(&message.CreateHDAccounts{Passphrase: in.Passphrase, Count: int(in.Count)},
+		in.Count, "rpc.(*AergoRPCService).CreateAccountHD")
+}
+
+// RecoverAccountHD handle rpc request of hd wallet recovery
+func (rpc *AergoRPCService) RecoverAccountHD(ctx context.Context, in *types.PersonalHD) (*types.HDAccounts, error) {
+	return rpc.requestHDAccounts(&message.RecoverHDAccounts{Mnemonic: in.Mnemonic, Passphrase: in.Passphrase, Count: int(in.Count)},
+		in.Count, "rpc.(*AergoRPCService).RecoverAccountHD")
+}
+
+func (rpc *AergoRPCService) requestHDAccounts(msg interface{}, count uint32, tip string) (*types.HDAccounts, error) {
+	if count < 1 || count > key.MaxHDAccounts {
+		return nil, status.Errorf(codes.InvalidArgument, key.ErrInvalidHDCount.Error())
+	}
+	// every derived key is encrypted by the kdf of keystore, which takes long time
+	timeout := defaultActorTimeout * time.Duration(count+1)
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc, msg, timeout, tip)
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.HDAccountsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, rsp.Err
+	}
+	return &types.HDAccounts{Mnemonic: rsp.Mnemonic, Accounts: rsp.Accounts}, nil
+}
+
 // SignTX handle rpc request signtx
 func (rpc *AergoRPCService) SignTX(ctx context.Context, in *types.Tx) (*types.Tx, error) {
 	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
@@ -887,6 +951,88 @@ func (rpc *AergoRPCService) GetStaking(ctx context.Context, in *types.AccountAdd
 	return rsp.Staking, rsp.Err
 }
 
+// GetDelegation handle rpc request getdelegation
+func (rpc *AergoRPCService) GetDelegation(ctx context.Context, in *types.AccountAddress) (*types.Delegation, error) {
+	if len(in.Value) > types.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Only support valid address")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetDelegation{Addr: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetDelegation").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetDelegationRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Delegation, rsp.Err
+}
+
+// ListProposals handle rpc request listproposals
+func (rpc *AergoRPCService) ListProposals(ctx context.Context, in *types.ListParams) (*types.ProposalList, error) {
+	size := in.Size
+	if size == 0 || size > uint32(100) {
+		size = uint32(100)
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListProposals{Size: size, Offset: in.Offset, Asc: in.Asc}, defaultActorTimeout,
+		"rpc.(*AergoRPCService).ListProposals").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListProposalsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Proposals, rsp.Err
+}
+
+// GetAllowedPeers handle rpc request getallowedpeers
+func (rpc *AergoRPCService) GetAllowedPeers(ctx context.Context, in *types.Empty) (*types.AllowedPeers, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetAllowedPeers{}, defaultActorTimeout, "rpc.(*AergoRPCService).GetAllowedPeers").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetAllowedPeersRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, rsp.Err
+	}
+	allowed := &types.AllowedPeers{Ids: make([][]byte, len(rsp.Peers))}
+	for i, id := range rsp.Peers {
+		allowed.Ids[i] = []byte(id)
+	}
+	return allowed, nil
+}
+
+func (rpc *AergoRPCService) GetDBStats(ctx context.Context, in *types.Empty) (*types.DBStats, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetDBStats{}, defaultActorTimeout, "rpc.(*AergoRPCService).GetDBStats").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetDBStatsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	stats := &types.DBStats{}
+	for name, values := range rsp.Stats {
+		for key, value := range values {
+			stats.Stats = append(stats.Stats, &types.DBStat{Store: name, Key: key, Value: value})
+		}
+	}
+	sort.Slice(stats.Stats, func(i, j int) bool {
+		if stats.Stats[i].Store != stats.Stats[j].Store {
+			return stats.Stats[i].Store < stats.Stats[j].Store
+		}
+		return stats.Stats[i].Key < stats.Stats[j].Key
+	})
+	return stats, nil
+}
+
 func (rpc *AergoRPCService) GetNameInfo(ctx context.Context, in *types.Name) (*types.NameInfo, error) {
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
 		&message.GetNameInfo{Name: in.Name}, defaultActorTimeout, "rpc.(*AergoRPCService).GetName").Result()
@@ -903,6 +1049,26 @@ func (rpc *AergoRPCService) GetNameInfo(ctx context.Context, in *types.Name) (*t
 	return rsp.Owner, rsp.Err
 }
 
+// GetNameByAddress handle rpc request getnamebyaddress
+func (rpc *AergoRPCService) GetNameByAddress(ctx context.Context, in *types.AccountAddress) (*types.NameInfo, error) {
+	if len(in.Value) != types.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetNameByAddress{Addr: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetNameByAddress").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetNameInfoRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err == types.ErrNameNotFound {
+		return rsp.Owner, status.Errorf(codes.NotFound, rsp.Err.Error())
+	}
+	return rsp.Owner, rsp.Err
+}
+
 func (rpc *AergoRPCService) GetReceipt(ctx context.Context, in *types.SingleBytes) (*types.Receipt, error) {
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
 		&message.GetReceipt{TxHash: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetReceipt").Result()
@@ -916,6 +1082,36 @@ func (rpc *AergoRPCService) GetReceipt(ctx context.Context, in *types.SingleByte
 	return rsp.Receipt, rsp.Err
 }
 
+// GetReceiptProof handle rpc request getreceiptproof
+func (rpc *AergoRPCService) GetReceiptProof(ctx context.Context, in *types.SingleBytes) (*types.ReceiptProof, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetReceiptProof{TxHash: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetReceiptProof").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetReceiptProofRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Proof, rsp.Err
+}
+
+// GetEventProof handle rpc request geteventproof. The proof of event is the proof of receipt which
+// has the event.
+func (rpc *AergoRPCService) GetEventProof(ctx context.Context, in *types.EventProofParams) (*types.EventProof, error) {
+	receiptProof, err := rpc.GetReceiptProof(ctx, &types.SingleBytes{Value: in.TxHash})
+	if err != nil {
+		return nil, err
+	}
+	events := receiptProof.GetReceipt().GetEvents()
+	if in.EventIdx < 0 || int(in.EventIdx) >= len(events) {
+		return nil, status.Errorf(codes.NotFound, "event %d not found in receipt", in.EventIdx)
+	}
+	event := *events[in.EventIdx]
+	event.SetMemoryInfo(receiptProof.Receipt, receiptProof.TxIdx.BlockHash, receiptProof.Receipt.BlockNo, receiptProof.TxIdx.Idx)
+	return &types.EventProof{Event: &event, ReceiptProof: receiptProof}, nil
+}
+
 func (rpc *AergoRPCService) GetABI(ctx context.Context, in *types.SingleBytes) (*types.ABI, error) {
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
 		&message.GetABI{Contract: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetABI").Result()
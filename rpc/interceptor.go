@@ -0,0 +1,54 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// chainUnaryInterceptors makes one interceptor calling interceptors in order, since grpc server takes only one.
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	switch len(interceptors) {
+	case 0:
+		return nil
+	case 1:
+		return interceptors[0]
+	}
+	first, rest := interceptors[0], chainUnaryInterceptors(interceptors[1:]...)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return first(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return rest(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamInterceptors makes one interceptor calling interceptors in order, since grpc server takes only one.
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	switch len(interceptors) {
+	case 0:
+		return nil
+	case 1:
+		return interceptors[0]
+	}
+	first, rest := interceptors[0], chainStreamInterceptors(interceptors[1:]...)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return first(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return rest(srv, ss, info, handler)
+		})
+	}
+}
+
+// contextStream overrides the context of server stream, to pass the values set by interceptors to handler.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
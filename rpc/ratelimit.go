@@ -0,0 +1,231 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMethodCost = 1
+	retryAfterKey     = "retry-after"
+
+	// buckets of clients not seen for a while are removed
+	bucketIdleTimeout = 10 * time.Minute
+	// max count of clients shown in server info
+	maxClientStats = 100
+)
+
+// defaultMethodCosts is the cost of methods which takes more resource than simple lookup. It can be overridden by
+// configuration.
+var defaultMethodCosts = map[string]float64{
+	"ListEvents":              20,
+	"ListProposals":           5,
+	"QueryContract":           10,
+	"QueryContractState":      5,
+	"ListBlockHeaders":        10,
+	"ListBlockMetadata":       10,
+	"GetBlockBody":            5,
+	"GetStateAndProof":        3,
+	"GetTxProof":              3,
+	"GetReceiptProof":         3,
+	"GetEventProof":           3,
+	"ListBlockStream":         10,
+	"ListBlockMetadataStream": 10,
+	"ListEventStream":         10,
+}
+
+// RateLimiter limits the rate of rpc calls of each client with token bucket. A client is identified by the
+// identity of auth, or the ip address for anonymous clients. Each method consumes tokens by its cost.
+type RateLimiter struct {
+	rate  float64 // tokens refilled per second
+	burst float64 // capacity of bucket
+	costs map[string]float64
+
+	mutex     sync.Mutex
+	buckets   map[string]*clientBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type clientBucket struct {
+	tokens   float64
+	last     time.Time
+	requests uint64
+	rejected uint64
+	cost     float64
+}
+
+// NewRateLimiter builds the rate limiter from rpc config.
+func NewRateLimiter(cfg *config.RPCConfig) (*RateLimiter, error) {
+	if cfg.NSRateLimit <= 0 {
+		return nil, fmt.Errorf("rate limit must be positive")
+	}
+	burst := float64(cfg.NSRateBurst)
+	if burst <= 0 {
+		burst = cfg.NSRateLimit
+	}
+	rl := &RateLimiter{
+		rate:    cfg.NSRateLimit,
+		burst:   burst,
+		costs:   make(map[string]float64),
+		buckets: make(map[string]*clientBucket),
+		now:     time.Now,
+	}
+	for method, cost := range defaultMethodCosts {
+		rl.costs[method] = cost
+	}
+	for _, entry := range cfg.NSMethodCosts {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid method cost %s, it must be method=cost", entry)
+		}
+		cost, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid method cost %s", entry)
+		}
+		rl.costs[strings.TrimSpace(kv[0])] = cost
+	}
+	return rl, nil
+}
+
+// methodCost returns the cost of method. A cost over the burst is lowered to the burst, otherwise the method
+// could never be called.
+func (rl *RateLimiter) methodCost(fullMethod string) float64 {
+	cost, exist := rl.costs[strings.TrimPrefix(fullMethod, methodPrefix)]
+	if !exist {
+		cost = defaultMethodCost
+	}
+	return math.Min(cost, rl.burst)
+}
+
+// take consumes tokens of client for method. If tokens are not enough, it returns the time to wait.
+func (rl *RateLimiter) take(client string, fullMethod string) (bool, time.Duration) {
+	cost := rl.methodCost(fullMethod)
+	now := rl.now()
+
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	rl.sweep(now)
+	b, exist := rl.buckets[client]
+	if !exist {
+		b = &clientBucket{tokens: rl.burst, last: now}
+		rl.buckets[client] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(rl.burst, b.tokens+elapsed*rl.rate)
+	}
+	b.last = now
+	b.requests++
+	if b.tokens < cost {
+		b.rejected++
+		wait := time.Duration((cost - b.tokens) / rl.rate * float64(time.Second))
+		return false, wait
+	}
+	b.tokens -= cost
+	b.cost += cost
+	return true, 0
+}
+
+func (rl *RateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < bucketIdleTimeout {
+		return
+	}
+	rl.lastSweep = now
+	for client, b := range rl.buckets {
+		if now.Sub(b.last) > bucketIdleTimeout {
+			delete(rl.buckets, client)
+		}
+	}
+}
+
+// limit checks the rate of client of ctx, and returns ResourceExhausted error with retry-after header if the
+// client exceeded the limit.
+func (rl *RateLimiter) limit(ctx context.Context, fullMethod string, setHeader func(metadata.MD) error) error {
+	ok, wait := rl.take(clientOf(ctx), fullMethod)
+	if ok {
+		return nil
+	}
+	retryAfter := int64(math.Ceil(wait.Seconds()))
+	setHeader(metadata.Pairs(retryAfterKey, strconv.FormatInt(retryAfter, 10)))
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %d seconds", retryAfter)
+}
+
+// clientOf returns the key of client for rate limit. The authenticated identity shares the limit from any
+// address, and anonymous clients are limited by ip address.
+func clientOf(ctx context.Context) string {
+	if id := IdentityFromContext(ctx); id != IdentityAnonymous {
+		return "id:" + id
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		return "ip:" + addr
+	}
+	return IdentityAnonymous
+}
+
+// UnaryInterceptor limits the rate of unary rpc calls.
+func (rl *RateLimiter) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := rl.limit(ctx, info.FullMethod, func(md metadata.MD) error { return grpc.SetHeader(ctx, md) }); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor limits the rate of opening streams. Messages in the stream are not counted.
+func (rl *RateLimiter) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := rl.limit(ss.Context(), info.FullMethod, ss.SetHeader); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// AddStatistics adds the configuration and the usage of clients to server info. Clients are sorted by the
+// used cost, and only the top ones are shown.
+func (rl *RateLimiter) AddStatistics(configInfo map[string]*types.ConfigItem) {
+	types.AddCategory(configInfo, "ratelimit").AddFloat("rate", rl.rate).AddFloat("burst", rl.burst)
+
+	type clientStat struct {
+		client string
+		b      clientBucket
+	}
+	rl.mutex.Lock()
+	stats := make([]clientStat, 0, len(rl.buckets))
+	for client, b := range rl.buckets {
+		stats = append(stats, clientStat{client: client, b: *b})
+	}
+	rl.mutex.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].b.cost > stats[j].b.cost })
+	if len(stats) > maxClientStats {
+		stats = stats[:maxClientStats]
+	}
+	clients := types.AddCategory(configInfo, "ratelimit.clients")
+	for _, s := range stats {
+		clients.Add(s.client, fmt.Sprintf("requests=%d rejected=%d cost=%g", s.b.requests, s.b.rejected, s.b.cost))
+	}
+}
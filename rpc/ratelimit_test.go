@@ -0,0 +1,80 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl, err := NewRateLimiter(&config.RPCConfig{NSRateLimit: 10, NSRateBurst: 20, NSMethodCosts: []string{"GetBlock=4"}})
+	assert.NoError(t, err)
+	now := time.Unix(1000, 0)
+	rl.now = func() time.Time { return now }
+
+	assert.Equal(t, float64(4), rl.methodCost(methodPrefix+"GetBlock"))
+	assert.Equal(t, float64(1), rl.methodCost(methodPrefix+"Blockchain"))
+	// cost over burst is lowered to burst
+	assert.Equal(t, float64(20), rl.methodCost(methodPrefix+"ListEvents"))
+
+	for i := 0; i < 5; i++ {
+		ok, _ := rl.take("ip:1.2.3.4", methodPrefix+"GetBlock")
+		assert.True(t, ok)
+	}
+	ok, wait := rl.take("ip:1.2.3.4", methodPrefix+"GetBlock")
+	assert.False(t, ok)
+	assert.Equal(t, 400*time.Millisecond, wait)
+
+	// other client has its own bucket
+	ok, _ = rl.take("ip:5.6.7.8", methodPrefix+"GetBlock")
+	assert.True(t, ok)
+
+	// bucket is refilled by time
+	now = now.Add(wait)
+	ok, _ = rl.take("ip:1.2.3.4", methodPrefix+"GetBlock")
+	assert.True(t, ok)
+
+	configInfo := make(map[string]*types.ConfigItem)
+	rl.AddStatistics(configInfo)
+	assert.Equal(t, "10", configInfo["ratelimit"].Props["rate"])
+	assert.Equal(t, "requests=7 rejected=1 cost=24", configInfo["ratelimit.clients"].Props["ip:1.2.3.4"])
+	assert.Equal(t, "requests=1 rejected=0 cost=4", configInfo["ratelimit.clients"].Props["ip:5.6.7.8"])
+
+	// idle clients are removed
+	now = now.Add(bucketIdleTimeout * 2)
+	rl.take("ip:5.6.7.8", methodPrefix+"GetBlock")
+	assert.Len(t, rl.buckets, 1)
+
+	_, err = NewRateLimiter(&config.RPCConfig{NSRateLimit: 1, NSMethodCosts: []string{"GetBlock"}})
+	assert.Error(t, err)
+}
+
+func TestRateLimiterInterceptor(t *testing.T) {
+	rl, _ := NewRateLimiter(&config.RPCConfig{NSRateLimit: 1})
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1234}})
+	assert.Equal(t, "ip:1.2.3.4", clientOf(ctx))
+	assert.Equal(t, "id:wallet", clientOf(context.WithValue(ctx, identityKey{}, "wallet")))
+
+	var header metadata.MD
+	setHeader := func(md metadata.MD) error {
+		header = md
+		return nil
+	}
+	assert.NoError(t, rl.limit(ctx, methodPrefix+"Blockchain", setHeader))
+	err := rl.limit(ctx, methodPrefix+"Blockchain", setHeader)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"1"}, header.Get(retryAfterKey))
+}
@@ -6,9 +6,12 @@
 package rpc
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/p2p/p2pkey"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"reflect"
@@ -27,6 +30,8 @@ import (
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/opentracing/opentracing-go"
 	"github.com/soheilhy/cmux"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 )
 
@@ -40,6 +45,8 @@ type RPC struct {
 	grpcWebServer *grpcweb.WrappedGrpcServer
 	actualServer  *AergoRPCService
 	httpServer    *http.Server
+	auth          *Authenticator
+	rateLimiter   *RateLimiter
 
 	ca      types.ChainAccessor
 	version string
@@ -61,9 +68,34 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 		grpc.MaxRecvMsgSize(1024 * 1024 * 256),
 	}
 
+	// interceptors are called in order of auth, rate limit and tracing
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	var auth *Authenticator
+	if cfg.RPC.NSAuth {
+		var err error
+		if auth, err = NewAuthenticator(cfg.RPC); err != nil {
+			logger.Fatal().Err(err).Msg("invalid rpc auth configuration")
+		}
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auth.StreamInterceptor)
+	}
+	var rateLimiter *RateLimiter
+	if cfg.RPC.NSRateLimit > 0 {
+		var err error
+		if rateLimiter, err = NewRateLimiter(cfg.RPC); err != nil {
+			logger.Fatal().Err(err).Msg("invalid rpc rate limit configuration")
+		}
+		unaryInterceptors = append(unaryInterceptors, rateLimiter.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, rateLimiter.StreamInterceptor)
+	}
 	if cfg.RPC.NetServiceTrace {
-		opts = append(opts, grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(tracer)))
-		opts = append(opts, grpc.StreamInterceptor(otgrpc.OpenTracingStreamServerInterceptor(tracer)))
+		unaryInterceptors = append(unaryInterceptors, otgrpc.OpenTracingServerInterceptor(tracer))
+		streamInterceptors = append(streamInterceptors, otgrpc.OpenTracingStreamServerInterceptor(tracer))
+	}
+	if len(unaryInterceptors) > 0 {
+		opts = append(opts, grpc.UnaryInterceptor(chainUnaryInterceptors(unaryInterceptors...)))
+		opts = append(opts, grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors...)))
 	}
 
 	grpcServer := grpc.NewServer(opts...)
@@ -81,6 +113,8 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 		grpcServer:    grpcServer,
 		grpcWebServer: grpcWebServer,
 		actualServer:  actualServer,
+		auth:          auth,
+		rateLimiter:   rateLimiter,
 		ca:            chainAccessor,
 		version:       version,
 	}
@@ -88,7 +122,7 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 	actualServer.actorHelper = rpcsvc
 
 	rpcsvc.httpServer = &http.Server{
-		Handler:        rpcsvc.grpcWebHandlerFunc(grpcWebServer, http.DefaultServeMux),
+		Handler:        h2c.NewHandler(rpcsvc.grpcWebHandlerFunc(grpcWebServer, http.DefaultServeMux), &http2.Server{}),
 		ReadTimeout:    4 * time.Second,
 		WriteTimeout:   4 * time.Second,
 		MaxHeaderBytes: 1 << 20,
@@ -194,17 +228,24 @@ func (ns *RPC) serve() {
 	if err != nil {
 		panic(err)
 	}
+	if ns.conf.RPC.NSEnableTLS {
+		tlsConfig, err := ns.tlsConfig()
+		if err != nil {
+			panic(err)
+		}
+		l = tls.NewListener(l, tlsConfig)
+		if ns.auth != nil {
+			l = &tlsListener{Listener: l, auth: ns.auth}
+		}
+	}
 
 	// Setup TCP multiplexer
 	tcpm := cmux.New(l)
 	grpcL := tcpm.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
-	httpL := tcpm.Match(cmux.HTTP1Fast())
-
-	ns.Info().Msg(fmt.Sprintf("Starting RPC server listening on %s, with TLS: %v", addr, ns.conf.RPC.NSEnableTLS))
+	// grpc-web over tls can be http2, if browser negotiated it
+	httpL := tcpm.Match(cmux.HTTP1Fast(), cmux.HTTP2())
 
-	if ns.conf.RPC.NSEnableTLS {
-		ns.Warn().Msg("TLS is enabled in configuration, but currently not supported")
-	}
+	ns.Info().Msg(fmt.Sprintf("Starting RPC server listening on %s, with TLS: %v, auth: %v", addr, ns.conf.RPC.NSEnableTLS, ns.auth != nil))
 
 	// Server both servers
 	go ns.serveGRPC(grpcL, ns.grpcServer)
@@ -218,6 +259,33 @@ func (ns *RPC) serve() {
 	return
 }
 
+// tlsConfig returns tls configuration of server. If client CA is configured, client certificate is verified
+// when client sent it. Clients without certificate are still accepted, and the auth policy decides their access.
+func (ns *RPC) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(ns.conf.RPC.NSCert, ns.conf.RPC.NSKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"h2", "http/1.1"},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if ns.conf.RPC.NSClientCA != "" {
+		caPEM, err := ioutil.ReadFile(ns.conf.RPC.NSClientCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate in client CA file %s", ns.conf.RPC.NSClientCA)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
 func (ns *RPC) CollectServerInfo(categories []string) *types.ServerInfo {
 	// 3 items are needed
 	statusInfo := make(map[string]string)
@@ -235,6 +303,10 @@ func (ns *RPC) CollectServerInfo(categories []string) *types.ServerInfo {
 	configInfo := make(map[string]*types.ConfigItem)
 	types.AddCategory(configInfo, "base").AddBool("personal", ns.conf.BaseConfig.Personal)
 	types.AddCategory(configInfo, "account").AddInt("unlocktimeout", int(ns.conf.Account.UnlockTimeout))
+	types.AddCategory(configInfo, "rpc").AddBool("nstls", ns.conf.RPC.NSEnableTLS).AddBool("nsauth", ns.conf.RPC.NSAuth)
+	if ns.rateLimiter != nil {
+		ns.rateLimiter.AddStatistics(configInfo)
+	}
 	return &types.ServerInfo{Status: statusInfo, Config: configInfo}
 }
 
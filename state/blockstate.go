@@ -17,6 +17,9 @@ type BlockState struct {
 	BpReward []byte //final bp reward, increment when tx executes
 	receipts types.Receipts
 	CodeMap  map[types.AccountID][]byte
+
+	// events which are not emitted by any tx, such as the application of proposals
+	blockEvents []*types.Event
 }
 
 // NewBlockInfo create new blockInfo contains blockNo, blockHash and blockHash of previous block
@@ -61,6 +64,19 @@ func (bs *BlockState) AddReceipt(r *types.Receipt) error {
 	return nil
 }
 
+// AddBlockEvent adds an event emitted at the end of block, not by a tx.
+func (bs *BlockState) AddBlockEvent(e *types.Event) {
+	bs.blockEvents = append(bs.blockEvents, e)
+}
+
+// BlockEvents returns the events which are not emitted by any tx.
+func (bs *BlockState) BlockEvents() []*types.Event {
+	if bs == nil {
+		return nil
+	}
+	return bs.blockEvents
+}
+
 func (bs *BlockState) Receipts() *types.Receipts {
 	if bs == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/aergoio/aergo-lib/db"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/storage"
 	"github.com/aergoio/aergo/types"
 )
 
@@ -24,6 +25,13 @@ func NewChainStateDB() *ChainStateDB {
 	return &ChainStateDB{}
 }
 
+// OpenChainStateDB returns the ChainStateDB of store, whose state is root.
+func OpenChainStateDB(store db.DB, root []byte) *ChainStateDB {
+	sdb := &ChainStateDB{store: store}
+	sdb.states = NewStateDB(&sdb.store, root, false)
+	return sdb
+}
+
 // Init initialize database and load statedb of latest block
 func (sdb *ChainStateDB) Clone() *ChainStateDB {
 	sdb.Lock()
@@ -45,7 +53,11 @@ func (sdb *ChainStateDB) Init(dbType string, dataDir string, bestBlock *types.Bl
 	// init db
 	if sdb.store == nil {
 		dbPath := common.PathMkdirAll(dataDir, stateName)
-		sdb.store = db.NewDB(db.ImplType(dbType), dbPath)
+		store, err := storage.NewDB(dbType, dbPath)
+		if err != nil {
+			return err
+		}
+		sdb.store = store
 	}
 
 	// init trie
@@ -72,6 +84,11 @@ func (sdb *ChainStateDB) Close() error {
 	return nil
 }
 
+// GetStore returns the store of states
+func (sdb *ChainStateDB) GetStore() db.DB {
+	return sdb.store
+}
+
 // GetStateDB returns statedb stores account states
 func (sdb *ChainStateDB) GetStateDB() *StateDB {
 	return sdb.states
@@ -107,6 +124,16 @@ func (sdb *ChainStateDB) SetGenesis(genesis *types.Genesis, bpInit func(*StateDB
 			return err
 		}
 
+		// the system account holds the initial stakes
+		stake, err := genesis.TotalStake()
+		if err != nil {
+			return err
+		}
+		if stake.Sign() > 0 {
+			scs.State.Balance = stake.Bytes()
+			genesis.AddBalance(stake)
+		}
+
 		if err := gbState.PutState(aid, scs.State); err != nil {
 			return err
 		}
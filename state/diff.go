@@ -0,0 +1,127 @@
+package state
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// Written is the accounts and the contract storage keys written in the buffers of StateDB, with the number of writes.
+type Written struct {
+	Accounts map[types.AccountID]int
+	Storage  map[types.AccountID]map[types.HashID]int
+}
+
+// Written returns the accounts and the contract storage keys written since the last commit
+func (states *StateDB) Written() *Written {
+	states.lock.RLock()
+	defer states.lock.RUnlock()
+
+	w := &Written{
+		Accounts: make(map[types.AccountID]int),
+		Storage:  make(map[types.AccountID]map[types.HashID]int),
+	}
+	for key, idx := range states.buffer.indexes {
+		w.Accounts[types.AccountID(key)] = len(*idx)
+	}
+
+	states.cache.lock.RLock()
+	defer states.cache.lock.RUnlock()
+	for id, storage := range states.cache.storages {
+		keys := make(map[types.HashID]int)
+		for key, idx := range storage.buffer.indexes {
+			if key == checkpointKey {
+				continue
+			}
+			keys[key] = len(*idx)
+		}
+		w.Storage[id] = keys
+	}
+	return w
+}
+
+// AccountDiff is the difference of an account between the expected state and the actual one
+type AccountDiff struct {
+	ID       types.AccountID
+	Expected *types.State
+	Actual   *types.State
+	Storage  []*StorageDiff
+}
+
+// StorageDiff is the difference of a contract storage key. Key is the hash of the key, which is stored in the trie.
+type StorageDiff struct {
+	Key      types.HashID
+	Expected []byte
+	Actual   []byte
+}
+
+// Diff compares the accounts and the contract storage keys written in the buffers of actual with the ones of
+// expected. The changes of actual must be updated to its trie, but not committed yet.
+func Diff(expected, actual *StateDB) ([]*AccountDiff, error) {
+	written := actual.Written()
+	ids := make(map[types.AccountID]bool)
+	for id := range written.Accounts {
+		ids[id] = true
+	}
+	for id := range written.Storage {
+		ids[id] = true
+	}
+
+	var diffs []*AccountDiff
+	for id := range ids {
+		exp, err := expected.GetState(id)
+		if err != nil {
+			return nil, err
+		}
+		act, err := actual.GetState(id)
+		if err != nil {
+			return nil, err
+		}
+		diff := &AccountDiff{ID: id, Expected: exp, Actual: act}
+		if keys, exists := written.Storage[id]; exists && !bytes.Equal(exp.GetStorageRoot(), act.GetStorageRoot()) {
+			if diff.Storage, err = diffStorage(expected, actual, id, exp, keys); err != nil {
+				return nil, err
+			}
+		}
+		if len(diff.Storage) > 0 || !proto.Equal(exp, act) {
+			diffs = append(diffs, diff)
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].ID[:], diffs[j].ID[:]) < 0
+	})
+	return diffs, nil
+}
+
+func diffStorage(expected, actual *StateDB, id types.AccountID, exp *types.State, keys map[types.HashID]int) ([]*StorageDiff, error) {
+	storage := actual.cache.get(id)
+	if storage == nil {
+		return nil, nil
+	}
+	expState := &ContractState{
+		storage: newBufferedStorage(common.Compactz(exp.GetStorageRoot()), *expected.store),
+		store:   expected.store,
+	}
+
+	var diffs []*StorageDiff
+	for key := range keys {
+		var act []byte
+		if et := storage.get(key); et != nil && et.Value() != nil {
+			act = et.Value().([]byte)
+		}
+		value, err := expState.getInitialData(key[:])
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(value, act) {
+			diffs = append(diffs, &StorageDiff{Key: key, Expected: value, Actual: act})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key.Compare(diffs[j].Key) < 0
+	})
+	return diffs, nil
+}
@@ -0,0 +1,56 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateDBDiff(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	contractID := types.ToAccountID([]byte("test_contract"))
+
+	// the expected state
+	expected := chainStateDB.OpenNewStateDB(stateDB.GetRoot())
+	assert.NoError(t, expected.PutState(testAccount, &testStates[0]))
+	cs, err := expected.OpenContractStateAccount(contractID)
+	assert.NoError(t, err)
+	assert.NoError(t, cs.SetData([]byte("k1"), []byte("v1")))
+	assert.NoError(t, cs.SetData([]byte("k2"), []byte("v2")))
+	assert.NoError(t, expected.StageContractState(cs))
+	assert.NoError(t, expected.Update())
+	assert.NoError(t, expected.Commit())
+
+	// the same state except the storage key k2 and the balance of account
+	actual := chainStateDB.OpenNewStateDB(stateDB.GetRoot())
+	assert.NoError(t, actual.PutState(testAccount, &testStates[1]))
+	cs, err = actual.OpenContractStateAccount(contractID)
+	assert.NoError(t, err)
+	assert.NoError(t, cs.SetData([]byte("k1"), []byte("v1")))
+	assert.NoError(t, cs.SetData([]byte("k2"), []byte("v3")))
+	assert.NoError(t, actual.StageContractState(cs))
+
+	written := actual.Written()
+	assert.Equal(t, 1, written.Accounts[testAccount])
+	assert.Equal(t, 1, written.Storage[contractID][types.GetHashID([]byte("k2"))])
+
+	assert.NoError(t, actual.Update())
+	diffs, err := Diff(expected, actual)
+	assert.NoError(t, err)
+	assert.Len(t, diffs, 2)
+	for _, diff := range diffs {
+		switch diff.ID {
+		case testAccount:
+			assert.Equal(t, testStates[0].Nonce, diff.Expected.Nonce)
+			assert.Equal(t, testStates[1].Nonce, diff.Actual.Nonce)
+		case contractID:
+			assert.Len(t, diff.Storage, 1)
+			assert.Equal(t, types.GetHashID([]byte("k2")), diff.Storage[0].Key)
+			assert.Equal(t, []byte("v2"), diff.Storage[0].Expected)
+			assert.Equal(t, []byte("v3"), diff.Storage[0].Actual)
+		}
+	}
+}
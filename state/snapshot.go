@@ -0,0 +1,171 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package state
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// MaxStateChunkEntries is the max number of entries in a state chunk
+	MaxStateChunkEntries = 1024
+	// MaxStateChunkSize is the soft limit of total size of data in a state chunk, which keeps a chunk with merkle
+	// proofs under the max payload of p2p message.
+	MaxStateChunkSize = 1 << 22
+)
+
+var (
+	ErrInvalidStateChunk  = errors.New("invalid state chunk")
+	ErrIncompleteSnapshot = errors.New("state trie of snapshot is incomplete")
+)
+
+// GetStateChunk returns the entries of the trie of root, whose keys are in [start, end), with the stored data of
+// values and their merkle proofs. end is unbounded if it is empty. If the trie is of accounts, the code of contract
+// is attached to the entry. It also returns whether there are more entries in the range.
+func (states *StateDB) GetStateChunk(root, start, end []byte, accounts bool) ([]*types.StateChunkEntry, bool, error) {
+	var (
+		entries []*types.StateChunkEntry
+		size    int
+		hasNext bool
+		err     error
+	)
+	tr := trie.NewTrie(root, common.Hasher, *states.store)
+	walkErr := tr.Walk(root, start, func(key, value []byte) bool {
+		if len(end) != 0 && bytes.Compare(key, end) >= 0 {
+			return true
+		}
+		if len(entries) >= MaxStateChunkEntries || size >= MaxStateChunkSize {
+			hasNext = true
+			return true
+		}
+		entry := &types.StateChunkEntry{Key: key, Data: (*states.store).Get(value)}
+		if len(entry.Data) == 0 {
+			err = errLoadStateData
+			return true
+		}
+		if accounts {
+			var st types.State
+			if err = proto.Unmarshal(entry.Data, &st); err != nil {
+				return true
+			}
+			if len(st.CodeHash) != 0 {
+				entry.Code = (*states.store).Get(st.CodeHash)
+			}
+		}
+		size += len(entry.Data) + len(entry.Code)
+		entries = append(entries, entry)
+		return false
+	})
+	if walkErr != nil {
+		return nil, false, walkErr
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	for _, entry := range entries {
+		bitmap, ap, length, included, _, _, err := tr.MerkleProofCompressedR(entry.Key, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !included {
+			return nil, false, ErrInvalidStateChunk
+		}
+		entry.Bitmap, entry.AuditPath, entry.Length = bitmap, ap, uint32(length)
+	}
+	return entries, hasNext, nil
+}
+
+// SnapshotWriter rebuilds the tries of states from the chunks downloaded by snapshot sync. Each entry is verified
+// by its merkle proof against the root of the trie, and the rebuilt trie must have the same root when all chunks
+// are written, which means that no entry is missing.
+type SnapshotWriter struct {
+	store *db.DB
+	tries map[types.HashID]*trie.Trie
+}
+
+// NewSnapshotWriter returns a writer of snapshot to the store of states
+func (sdb *ChainStateDB) NewSnapshotWriter() *SnapshotWriter {
+	return &SnapshotWriter{store: &sdb.store, tries: make(map[types.HashID]*trie.Trie)}
+}
+
+// Put verifies and stores the entries of a chunk of the trie of root. The keys of entries must be in ascending
+// order.
+func (w *SnapshotWriter) Put(root []byte, entries []*types.StateChunkEntry) error {
+	verifier := trie.NewTrie(root, common.Hasher, nil)
+	keys := make([][]byte, len(entries))
+	values := make([][]byte, len(entries))
+
+	bulk := (*w.store).NewBulk()
+	for i, entry := range entries {
+		if i > 0 && bytes.Compare(keys[i-1], entry.Key) >= 0 {
+			bulk.DiscardLast()
+			return ErrInvalidStateChunk
+		}
+		value := common.Hasher(entry.Data)
+		if !verifier.VerifyInclusionC(entry.Bitmap, entry.Key, value, entry.AuditPath, int(entry.Length)) {
+			bulk.DiscardLast()
+			return ErrInvalidStateChunk
+		}
+		if len(entry.Code) != 0 {
+			var st types.State
+			if err := proto.Unmarshal(entry.Data, &st); err != nil || !bytes.Equal(st.CodeHash, common.Hasher(entry.Code)) {
+				bulk.DiscardLast()
+				return ErrInvalidStateChunk
+			}
+			bulk.Set(st.CodeHash, entry.Code)
+		}
+		bulk.Set(value, entry.Data)
+		keys[i], values[i] = entry.Key, value
+	}
+	if len(entries) == 0 {
+		bulk.DiscardLast()
+		return nil
+	}
+
+	tr := w.tries[types.ToHashID(root)]
+	if tr == nil {
+		tr = trie.NewTrie(nil, common.Hasher, *w.store)
+		w.tries[types.ToHashID(root)] = tr
+	}
+	if _, err := tr.Update(keys, values); err != nil {
+		bulk.DiscardLast()
+		return err
+	}
+	tr.StageUpdates(bulk)
+	bulk.Flush()
+	return nil
+}
+
+// Finish checks that all entries of the trie of root are written
+func (w *SnapshotWriter) Finish(root []byte) error {
+	tr := w.tries[types.ToHashID(root)]
+	if tr == nil || !bytes.Equal(tr.Root, root) {
+		logger.Error().Str("root", enc.ToString(root)).Msg("rebuilt trie of snapshot has different root")
+		return ErrIncompleteSnapshot
+	}
+	delete(w.tries, types.ToHashID(root))
+	return nil
+}
+
+// CommitSnapshot marks the state root of snapshot as finalized, and sets it as the current state
+func (sdb *ChainStateDB) CommitSnapshot(w *SnapshotWriter, root []byte) error {
+	if err := w.Finish(root); err != nil {
+		return err
+	}
+	tx := sdb.store.NewTx()
+	tx.Set(common.Hasher(root), stateMarker)
+	tx.Commit()
+
+	return sdb.SetRoot(root)
+}
@@ -0,0 +1,60 @@
+package state
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateSnapshot(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	for i, st := range testStates {
+		assert.NoError(t, stateDB.PutState(types.ToAccountID([]byte{byte(i)}), &st))
+	}
+	assert.NoError(t, stateDB.Update())
+	assert.NoError(t, stateDB.Commit())
+	root := stateDB.GetRoot()
+
+	entries, hasNext, err := stateDB.GetStateChunk(root, make([]byte, 32), nil, true)
+	assert.NoError(t, err)
+	assert.False(t, hasNext)
+
+	// the range is split by key
+	mid := entries[len(entries)/2].Key
+	first, _, err := stateDB.GetStateChunk(root, make([]byte, 32), mid, true)
+	assert.NoError(t, err)
+	assert.Equal(t, entries[:len(entries)/2], first)
+
+	target := NewChainStateDB()
+	assert.NoError(t, target.Init(string(db.BadgerImpl), "test_snapshot", nil, false))
+	defer func() {
+		_ = target.Close()
+		_ = os.RemoveAll("test_snapshot")
+	}()
+
+	w := target.NewSnapshotWriter()
+	assert.NoError(t, w.Put(root, entries[:len(entries)/2]))
+	assert.Equal(t, ErrIncompleteSnapshot, w.Finish(root), "entries are missing")
+
+	// the entry which is not in the trie of root is rejected
+	tampered := *entries[len(entries)-1]
+	tampered.Data = append([]byte{0}, tampered.Data...)
+	assert.Equal(t, ErrInvalidStateChunk, w.Put(root, []*types.StateChunkEntry{&tampered}))
+	// the keys must be in ascending order
+	assert.Equal(t, ErrInvalidStateChunk, w.Put(root, []*types.StateChunkEntry{entries[len(entries)-1], entries[len(entries)/2]}))
+
+	assert.NoError(t, w.Put(root, entries[len(entries)/2:]))
+	assert.NoError(t, target.CommitSnapshot(w, root))
+	assert.Equal(t, root, target.GetRoot())
+
+	for i, st := range testStates {
+		restored, err := target.GetStateDB().GetState(types.ToAccountID([]byte{byte(i)}))
+		assert.NoError(t, err)
+		assert.True(t, stateEquals(&st, restored))
+	}
+}
@@ -0,0 +1,502 @@
+package syncer
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
+)
+
+// SnapshotFetcher downloads the state of a block, which is the pivot, instead of executing all blocks from genesis.
+// The pivot is the snapshot block configured by its hash, which is got from a trusted source and known to be
+// irreversible. It is verified by the hash instead of the bps in charge of it, since the bps can't be told without
+// the state of chain. The tries of states are split by key range and fetched in chunks from several peers in
+// parallel, and each entry of chunk is verified by merkle proof against the state root in the header of pivot. The
+// storage tries and sql databases of contracts found in account states are fetched in the same way, and each sql
+// database is verified by the digest in the account state of contract. When all is written, the pivot becomes the
+// best block and the syncer continues with normal sync from it.
+type SnapshotFetcher struct {
+	compRequester component.IComponentRequester
+	ctx           *types.SyncContext
+	cfg           *SyncerConfig
+
+	hashCh  chan *message.GetHashByNoRsp
+	blockCh chan *message.GetBlockChunksRsp
+	chunkCh chan *message.GetStateChunkRsp
+	sqlCh   chan *message.GetStateSQLRsp
+
+	quitCh chan interface{}
+
+	peers    map[peer.ID]int // fail count of peer
+	pivot    *types.Block
+	storages map[types.HashID]bool
+	sqls     map[string]*message.SnapshotSQL
+
+	isRunning bool
+	waitGroup *sync.WaitGroup
+}
+
+// snapshotTask is a range of state trie or a sql database to fetch
+type snapshotTask struct {
+	// range of trie
+	root     []byte
+	start    []byte
+	end      []byte
+	accounts bool
+
+	// sql database. version and offset are valid only with the peer who served the first part.
+	name    string
+	version uint64
+	offset  uint64
+	owner   peer.ID
+
+	sentAt time.Time
+}
+
+const (
+	// DfltSnapshotPeers is the max number of peers to fetch state from
+	DfltSnapshotPeers = 8
+	// number of ranges of account trie, split by the first byte of key
+	snapshotAccountRanges = 16
+)
+
+var (
+	ErrSnapshotQuit         = errors.New("snapshot fetcher quit")
+	ErrSnapshotNoPeer       = errors.New("no peer to fetch snapshot")
+	ErrSnapshotInvalidPivot = errors.New("invalid pivot block of snapshot")
+	ErrSnapshotInvalidChunk = errors.New("invalid chunk of snapshot")
+	ErrSnapshotTimeout      = errors.New("snapshot fetcher timeout")
+	ErrSnapshotNoSQLDigest  = errors.New("sql database of snapshot has no digest to verify")
+)
+
+func newSnapshotFetcher(ctx *types.SyncContext, compRequester component.IComponentRequester, cfg *SyncerConfig) *SnapshotFetcher {
+	sf := &SnapshotFetcher{ctx: ctx, compRequester: compRequester, cfg: cfg}
+
+	sf.hashCh = make(chan *message.GetHashByNoRsp, cfg.maxSnapshotPeers)
+	sf.blockCh = make(chan *message.GetBlockChunksRsp, 1)
+	sf.chunkCh = make(chan *message.GetStateChunkRsp, cfg.maxSnapshotPeers)
+	sf.sqlCh = make(chan *message.GetStateSQLRsp, cfg.maxSnapshotPeers)
+	sf.quitCh = make(chan interface{})
+
+	sf.peers = make(map[peer.ID]int)
+	sf.storages = make(map[types.HashID]bool)
+	sf.sqls = make(map[string]*message.SnapshotSQL)
+
+	return sf
+}
+
+func (sf *SnapshotFetcher) start() {
+	sf.waitGroup = &sync.WaitGroup{}
+	sf.waitGroup.Add(1)
+	sf.isRunning = true
+
+	run := func() {
+		defer RecoverSyncer(NameSnapshotFetcher, sf.GetSeq(), sf.compRequester, func() { sf.waitGroup.Done() })
+
+		logger.Info().Str("pivot", enc.ToString(sf.cfg.snapshotBlock)).Msg("start to fetch state snapshot")
+
+		if err := sf.run(); err != nil {
+			logger.Error().Err(err).Msg("failed to fetch state snapshot")
+			stopSyncer(sf.compRequester, sf.GetSeq(), NameSnapshotFetcher, err)
+			return
+		}
+
+		// continue normal sync from the pivot
+		sf.compRequester.TellTo(message.SyncerSvc, &message.FinderResult{Seq: sf.GetSeq(),
+			Ancestor: &types.BlockInfo{Hash: sf.pivot.BlockHash(), No: sf.pivot.BlockNo()}, Err: nil})
+		logger.Info().Msg("stopped snapshot fetcher successfully")
+	}
+
+	go run()
+}
+
+func (sf *SnapshotFetcher) stop() {
+	if sf == nil {
+		return
+	}
+
+	if sf.isRunning {
+		logger.Debug().Msg("snapshot fetcher closed quitChannel")
+
+		close(sf.quitCh)
+		sf.isRunning = false
+	}
+
+	sf.waitGroup.Wait()
+
+	logger.Info().Msg("snapshot fetcher stopped")
+}
+
+func (sf *SnapshotFetcher) GetSeq() uint64 {
+	return sf.ctx.Seq
+}
+
+func (sf *SnapshotFetcher) run() error {
+	if err := sf.fetchPivot(); err != nil {
+		return err
+	}
+	if err := sf.setPeers(); err != nil {
+		return err
+	}
+	if err := sf.checkPeers(); err != nil {
+		return err
+	}
+
+	root := sf.pivot.GetHeader().GetBlocksRootHash()
+	if err := sf.fetchStates(accountTasks(root)); err != nil {
+		return err
+	}
+
+	storageRoots := make([][]byte, 0, len(sf.storages))
+	for id := range sf.storages {
+		storageRoots = append(storageRoots, append([]byte(nil), id[:]...))
+	}
+	logger.Info().Int("storages", len(storageRoots)).Int("sqls", len(sf.sqls)).Msg("fetched account states of snapshot")
+
+	result, err := sf.compRequester.RequestToFutureResult(message.ChainSvc,
+		&message.ApplySnapshot{Block: sf.pivot, StorageRoots: storageRoots, SQLs: sf.sqls}, time.Minute*10, "SnapshotFetcher/apply")
+	if err != nil {
+		return err
+	}
+	return result.(*message.PutSnapshotRsp).Err
+}
+
+// fetchPivot gets the pivot block from the peer which started sync
+func (sf *SnapshotFetcher) fetchPivot() error {
+	hash := sf.cfg.snapshotBlock
+	sf.compRequester.TellTo(message.P2PSvc, &message.GetBlockChunks{Seq: sf.GetSeq(),
+		GetBlockInfos: message.GetBlockInfos{ToWhom: sf.ctx.PeerID, Hashes: []message.BlockHash{hash}}, TTL: sf.cfg.fetchTimeOut})
+
+	timer := time.NewTimer(sf.cfg.fetchTimeOut)
+	defer timer.Stop()
+	select {
+	case rsp := <-sf.blockCh:
+		if rsp.Err != nil {
+			return rsp.Err
+		}
+		if len(rsp.Blocks) != 1 {
+			return ErrSnapshotInvalidPivot
+		}
+		if err := verifyPivot(rsp.Blocks[0], hash, sf.ctx.TargetNo); err != nil {
+			return err
+		}
+		sf.pivot = rsp.Blocks[0]
+	case <-timer.C:
+		return ErrSnapshotTimeout
+	case <-sf.quitCh:
+		return ErrSnapshotQuit
+	}
+
+	logger.Info().Uint64("no", sf.pivot.BlockNo()).Str("hash", enc.ToString(hash)).Msg("pivot of snapshot is fetched")
+	return nil
+}
+
+// verifyPivot checks that block is the snapshot block of hash, by the hash calculated from its header instead of
+// the one given by peer. The pivot must not be higher than the target block of sync.
+func verifyPivot(block *types.Block, hash []byte, targetNo types.BlockNo) error {
+	if block.GetHeader() == nil {
+		return ErrSnapshotInvalidPivot
+	}
+	header := &types.Block{Header: block.GetHeader()}
+	if !bytes.Equal(header.BlockHash(), hash) {
+		return ErrSnapshotInvalidPivot
+	}
+	if valid, err := block.VerifySign(); !valid || err != nil {
+		return ErrSnapshotInvalidPivot
+	}
+	if block.BlockNo() == 0 || block.BlockNo() > targetNo {
+		return ErrSnapshotInvalidPivot
+	}
+	block.Hash = hash
+	return nil
+}
+
+// setPeers selects running peers which may have the pivot block
+func (sf *SnapshotFetcher) setPeers() error {
+	result, err := sf.compRequester.RequestToFutureResult(message.P2PSvc, &message.GetPeers{}, dfltTimeout, "SnapshotFetcher/setPeers")
+	if err != nil {
+		return err
+	}
+	pivot := sf.pivot.BlockNo()
+	sf.peers[sf.ctx.PeerID] = 0
+	for _, peerElem := range result.(*message.GetPeersRsp).Peers {
+		if len(sf.peers) >= sf.cfg.maxSnapshotPeers {
+			break
+		}
+		if peerElem.Self || peerElem.State.Get() != types.RUNNING || peerElem.LastBlockNumber < pivot {
+			continue
+		}
+		sf.peers[peer.ID(peerElem.Addr.PeerID)] = 0
+	}
+	return nil
+}
+
+// checkPeers excludes the peers which don't have the pivot block in their main chain
+func (sf *SnapshotFetcher) checkPeers() error {
+	no := sf.pivot.BlockNo()
+	for peerID := range sf.peers {
+		sf.compRequester.TellTo(message.P2PSvc, &message.GetHashByNo{Seq: sf.GetSeq(), ToWhom: peerID, BlockNo: no})
+	}
+
+	timer := time.NewTimer(sf.cfg.fetchTimeOut)
+	defer timer.Stop()
+	checked := make(map[peer.ID]bool, len(sf.peers))
+wait:
+	for waiting := len(sf.peers); waiting > 0; waiting-- {
+		select {
+		case rsp := <-sf.hashCh:
+			if rsp.Err != nil || !bytes.Equal(rsp.BlockHash, sf.pivot.BlockHash()) {
+				logger.Debug().Err(rsp.Err).Str("peer", p2putil.ShortForm(rsp.ToWhom)).Msg("peer doesn't have pivot of snapshot")
+				continue
+			}
+			checked[rsp.ToWhom] = true
+		case <-timer.C:
+			// the peers which didn't respond are excluded
+			break wait
+		case <-sf.quitCh:
+			return ErrSnapshotQuit
+		}
+	}
+	for peerID := range sf.peers {
+		if !checked[peerID] {
+			delete(sf.peers, peerID)
+		}
+	}
+	if len(sf.peers) == 0 {
+		return ErrSnapshotNoPeer
+	}
+
+	logger.Info().Uint64("no", no).Int("peers", len(sf.peers)).Msg("peers of snapshot are selected")
+	return nil
+}
+
+func accountTasks(root []byte) []*snapshotTask {
+	tasks := make([]*snapshotTask, snapshotAccountRanges)
+	step := 256 / snapshotAccountRanges
+	for i := range tasks {
+		task := &snapshotTask{root: root, start: make([]byte, 32), accounts: true}
+		task.start[0] = byte(i * step)
+		if i < snapshotAccountRanges-1 {
+			task.end = make([]byte, 32)
+			task.end[0] = byte((i + 1) * step)
+		}
+		tasks[i] = task
+	}
+	return tasks
+}
+
+// fetchStates fetches all tasks in parallel with one request per peer, and the tasks found in the fetched chunks.
+func (sf *SnapshotFetcher) fetchStates(pending []*snapshotTask) error {
+	running := make(map[peer.ID]*snapshotTask)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for len(pending) > 0 || len(running) > 0 {
+		// assign tasks to idle peers. a part of sql database must be fetched from the peer who has the copy.
+		for peerID := range sf.peers {
+			if _, busy := running[peerID]; busy {
+				continue
+			}
+			for i, task := range pending {
+				if task.owner != "" && task.owner != peerID {
+					continue
+				}
+				pending = append(pending[:i], pending[i+1:]...)
+				sf.request(peerID, task)
+				running[peerID] = task
+				break
+			}
+		}
+		if len(running) == 0 {
+			return ErrSnapshotNoPeer
+		}
+
+		var (
+			peerID peer.ID
+			next   []*snapshotTask
+			err    error
+		)
+		select {
+		case rsp := <-sf.chunkCh:
+			peerID = rsp.ToWhom
+			if task, exists := running[peerID]; exists {
+				delete(running, peerID)
+				if err = rsp.Err; err == nil {
+					next, err = sf.putChunk(task, rsp)
+				}
+				if err == ErrSnapshotNoSQLDigest {
+					return err
+				}
+				if err != nil {
+					next = []*snapshotTask{task}
+				}
+			}
+		case rsp := <-sf.sqlCh:
+			peerID = rsp.ToWhom
+			if task, exists := running[peerID]; exists {
+				delete(running, peerID)
+				if err = rsp.Err; err == nil {
+					next, err = sf.putSQL(task, rsp)
+				}
+				if err != nil {
+					// the copy of database is in the failed peer, so start over
+					task.owner, task.version, task.offset = "", 0, 0
+					next = []*snapshotTask{task}
+				}
+			}
+		case <-ticker.C:
+			for id, task := range running {
+				if time.Since(task.sentAt) > sf.cfg.fetchTimeOut {
+					delete(running, id)
+					task.owner, task.version, task.offset = "", 0, 0
+					pending = append(pending, task)
+					sf.failPeer(id, ErrSnapshotTimeout)
+				}
+			}
+		case <-sf.quitCh:
+			return ErrSnapshotQuit
+		}
+		if err != nil {
+			sf.failPeer(peerID, err)
+		}
+		pending = append(next, pending...)
+	}
+	return nil
+}
+
+func (sf *SnapshotFetcher) request(peerID peer.ID, task *snapshotTask) {
+	task.sentAt = time.Now()
+	if len(task.name) != 0 {
+		sf.compRequester.TellTo(message.P2PSvc, &message.GetStateSQL{Seq: sf.GetSeq(), ToWhom: peerID,
+			Name: task.name, Version: task.version, Offset: task.offset})
+		return
+	}
+	sf.compRequester.TellTo(message.P2PSvc, &message.GetStateChunk{Seq: sf.GetSeq(), ToWhom: peerID,
+		Root: task.root, Start: task.start, End: task.end, Accounts: task.accounts})
+}
+
+func (sf *SnapshotFetcher) failPeer(peerID peer.ID, err error) {
+	if _, exists := sf.peers[peerID]; !exists {
+		return
+	}
+	sf.peers[peerID]++
+	logger.Debug().Err(err).Str("peer", p2putil.ShortForm(peerID)).Int("failCnt", sf.peers[peerID]).Msg("snapshot fetch failed")
+	if sf.peers[peerID] >= MaxPeerFailCount {
+		logger.Info().Str("peer", p2putil.ShortForm(peerID)).Msg("exclude bad peer from snapshot fetch")
+		delete(sf.peers, peerID)
+	}
+}
+
+// putChunk writes a chunk of trie, and returns the tasks for the rest of range and the contracts in the chunk
+func (sf *SnapshotFetcher) putChunk(task *snapshotTask, rsp *message.GetStateChunkRsp) ([]*snapshotTask, error) {
+	// a late response of timed out request can arrive after the peer got another task
+	for _, entry := range rsp.Entries {
+		if bytes.Compare(entry.Key, task.start) < 0 || (task.end != nil && bytes.Compare(entry.Key, task.end) >= 0) {
+			return nil, ErrSnapshotInvalidChunk
+		}
+	}
+	result, err := sf.compRequester.RequestToFutureResult(message.ChainSvc,
+		&message.PutSnapshotChunk{Root: task.root, Entries: rsp.Entries}, dfltTimeout, "SnapshotFetcher/putChunk")
+	if err != nil {
+		return nil, err
+	}
+	if err = result.(*message.PutSnapshotRsp).Err; err != nil {
+		return nil, err
+	}
+
+	var next []*snapshotTask
+	if task.accounts {
+		for _, entry := range rsp.Entries {
+			var st types.State
+			if err := proto.Unmarshal(entry.Data, &st); err != nil {
+				return nil, err
+			}
+			if id := types.ToHashID(st.StorageRoot); len(st.StorageRoot) != 0 && !sf.storages[id] {
+				sf.storages[id] = true
+				next = append(next, &snapshotTask{root: st.StorageRoot})
+			}
+			if st.SqlRecoveryPoint > 0 {
+				// the database committed before ForkSQLDigest can't be verified
+				if len(st.SqlDigest) == 0 {
+					return nil, ErrSnapshotNoSQLDigest
+				}
+				name := enc.ToString(entry.Key)
+				sf.sqls[name] = &message.SnapshotSQL{RecoveryPoint: st.SqlRecoveryPoint, Digest: st.SqlDigest}
+				next = append(next, &snapshotTask{name: name})
+			}
+		}
+	}
+	if rsp.HasNext {
+		if len(rsp.Entries) == 0 {
+			return nil, ErrSnapshotInvalidChunk
+		}
+		task.start = nextKey(rsp.Entries[len(rsp.Entries)-1].Key)
+		next = append(next, task)
+	}
+	return next, nil
+}
+
+// putSQL writes a part of sql database, and returns the task for the rest of file
+func (sf *SnapshotFetcher) putSQL(task *snapshotTask, rsp *message.GetStateSQLRsp) ([]*snapshotTask, error) {
+	// a late response of timed out request can arrive after the peer got another task
+	if rsp.Name != task.name || rsp.Offset != task.offset {
+		return nil, ErrSnapshotInvalidChunk
+	}
+	if task.version != 0 && task.version != rsp.Version {
+		return nil, ErrSnapshotInvalidChunk
+	}
+	if len(rsp.Data) == 0 && task.offset < rsp.Size {
+		return nil, ErrSnapshotInvalidChunk
+	}
+	result, err := sf.compRequester.RequestToFutureResult(message.ChainSvc,
+		&message.PutSnapshotSQL{Name: task.name, Offset: task.offset, Data: rsp.Data}, dfltTimeout, "SnapshotFetcher/putSQL")
+	if err != nil {
+		return nil, err
+	}
+	if err = result.(*message.PutSnapshotRsp).Err; err != nil {
+		return nil, err
+	}
+
+	task.owner, task.version = rsp.ToWhom, rsp.Version
+	task.offset += uint64(len(rsp.Data))
+	if task.offset < rsp.Size {
+		return []*snapshotTask{task}, nil
+	}
+	return nil, nil
+}
+
+// nextKey returns the smallest key greater than key
+func nextKey(key []byte) []byte {
+	next := append([]byte(nil), key...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func (sf *SnapshotFetcher) GetHashByNoRsp(rsp *message.GetHashByNoRsp) {
+	sf.hashCh <- rsp
+}
+
+func (sf *SnapshotFetcher) GetBlockChunksRsp(rsp *message.GetBlockChunksRsp) {
+	sf.blockCh <- rsp
+}
+
+func (sf *SnapshotFetcher) GetStateChunkRsp(rsp *message.GetStateChunkRsp) {
+	sf.chunkCh <- rsp
+}
+
+func (sf *SnapshotFetcher) GetStateSQLRsp(rsp *message.GetStateSQLRsp) {
+	sf.sqlCh <- rsp
+}
@@ -0,0 +1,114 @@
+package syncer
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSignedBlock(t *testing.T, no types.BlockNo) *types.Block {
+	privKey, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	assert.NoError(t, err)
+	block := &types.Block{Header: &types.BlockHeader{BlockNo: no, BlocksRootHash: []byte("root"), Timestamp: 1}}
+	assert.NoError(t, block.Sign(privKey))
+	return block
+}
+
+func TestSyncerSnapshotConfig(t *testing.T) {
+	block := newSignedBlock(t, 100)
+	for _, test := range []struct {
+		name   string
+		hash   string
+		expect bool
+	}{
+		{"TValid", enc.ToString(block.BlockHash()), true},
+		{"TEmpty", "", false},
+		{"TShort", enc.ToString([]byte("short")), false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := &config.Config{Blockchain: &config.BlockchainConfig{SnapshotSync: true, SnapshotBlock: test.hash}}
+			syncer := NewSyncer(c, nil, nil)
+			assert.Equal(t, test.expect, syncer.useSnapshot(0))
+			assert.False(t, syncer.useSnapshot(1), "only the chain which has genesis block only")
+			if test.expect {
+				assert.Equal(t, block.BlockHash(), syncer.syncerCfg.snapshotBlock)
+			}
+		})
+	}
+	assert.False(t, SyncerCfg.useSnapshot, "default config is not changed")
+}
+
+func TestVerifyPivot(t *testing.T) {
+	block := newSignedBlock(t, 100)
+	hash := append([]byte(nil), block.BlockHash()...)
+
+	assert.NoError(t, verifyPivot(block, hash, 100))
+	assert.Equal(t, ErrSnapshotInvalidPivot, verifyPivot(block, hash, 99), "pivot is higher than target")
+
+	// the hash given by peer is not trusted
+	other := newSignedBlock(t, 100)
+	other.Hash = hash
+	assert.Equal(t, ErrSnapshotInvalidPivot, verifyPivot(other, hash, 200))
+
+	// the header is not changed after signed
+	forged := &types.Block{Header: &types.BlockHeader{}}
+	*forged.Header = *block.Header
+	forged.Header.BlocksRootHash = []byte("other root")
+	assert.Equal(t, ErrSnapshotInvalidPivot, verifyPivot(forged, (&types.Block{Header: forged.Header}).BlockHash(), 200))
+
+	assert.Equal(t, ErrSnapshotInvalidPivot, verifyPivot(&types.Block{}, hash, 200))
+}
+
+func TestSnapshotFetcherPivot(t *testing.T) {
+	pivot := newSignedBlock(t, 100)
+	hash := append([]byte(nil), pivot.BlockHash()...)
+	pivot.Hash = nil
+
+	testCfg := *SyncerCfg
+	testCfg.snapshotBlock = hash
+	requester := NewStubRequester()
+	ctx := types.NewSyncCtx(1, targetPeerID, 200, 0, nil)
+	sf := newSnapshotFetcher(ctx, requester, &testCfg)
+
+	sf.GetBlockChunksRsp(&message.GetBlockChunksRsp{Seq: 1, ToWhom: targetPeerID, Blocks: []*types.Block{pivot}})
+	assert.NoError(t, sf.fetchPivot())
+	req := requester.recvMessage().(*message.GetBlockChunks)
+	assert.Equal(t, targetPeerID, req.ToWhom)
+	assert.Equal(t, []message.BlockHash{hash}, req.Hashes)
+	assert.Equal(t, hash, sf.pivot.BlockHash())
+
+	// the peers which have another block or no block at the pivot are excluded
+	other, missing := peer.ID("other"), peer.ID("missing")
+	sf.peers = map[peer.ID]int{targetPeerID: 0, other: 0, missing: 0}
+	sf.GetHashByNoRsp(&message.GetHashByNoRsp{Seq: 1, ToWhom: other, BlockHash: []byte("other hash")})
+	sf.GetHashByNoRsp(&message.GetHashByNoRsp{Seq: 1, ToWhom: targetPeerID, BlockHash: hash})
+	sf.GetHashByNoRsp(&message.GetHashByNoRsp{Seq: 1, ToWhom: missing, Err: message.RemotePeerFailError})
+	assert.NoError(t, sf.checkPeers())
+	assert.Equal(t, map[peer.ID]int{targetPeerID: 0}, sf.peers)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, types.BlockNo(100), requester.recvMessage().(*message.GetHashByNo).BlockNo)
+	}
+
+	sf.GetHashByNoRsp(&message.GetHashByNoRsp{Seq: 1, ToWhom: targetPeerID, BlockHash: []byte("other hash")})
+	assert.Equal(t, ErrSnapshotNoPeer, sf.checkPeers())
+}
+
+func TestSnapshotFetcherInvalidPivot(t *testing.T) {
+	pivot := newSignedBlock(t, 100)
+	forged := newSignedBlock(t, 100)
+	forged.Hash = pivot.BlockHash()
+
+	testCfg := *SyncerCfg
+	testCfg.snapshotBlock = pivot.BlockHash()
+	sf := newSnapshotFetcher(types.NewSyncCtx(1, targetPeerID, 200, 0, nil), NewStubRequester(), &testCfg)
+
+	sf.GetBlockChunksRsp(&message.GetBlockChunksRsp{Seq: 1, ToWhom: targetPeerID, Blocks: []*types.Block{forged}})
+	assert.Equal(t, ErrSnapshotInvalidPivot, sf.fetchPivot())
+	assert.Nil(t, sf.pivot)
+}
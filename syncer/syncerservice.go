@@ -30,9 +30,12 @@ type Syncer struct {
 	isRunning bool
 	ctx       *types.SyncContext
 
-	finder       *Finder
-	hashFetcher  *HashFetcher
-	blockFetcher *BlockFetcher
+	finder          *Finder
+	snapshotFetcher *SnapshotFetcher
+	hashFetcher     *HashFetcher
+	blockFetcher    *BlockFetcher
+
+	snapshotFailed bool
 
 	compRequester component.IComponentRequester //for test
 }
@@ -47,6 +50,10 @@ type SyncerConfig struct {
 
 	useFullScanOnly bool
 
+	useSnapshot      bool
+	snapshotBlock    []byte
+	maxSnapshotPeers int
+
 	debugContext *SyncerDebug
 }
 type SyncerDebug struct {
@@ -65,18 +72,20 @@ type SyncerDebug struct {
 }
 
 var (
-	logger             = log.NewLogger("syncer")
-	NameFinder         = "Finder"
-	NameHashFetcher    = "HashFetcher"
-	NameBlockFetcher   = "BlockFetcher"
-	NameBlockProcessor = "BlockProcessor"
-	SyncerCfg          = &SyncerConfig{
+	logger              = log.NewLogger("syncer")
+	NameFinder          = "Finder"
+	NameSnapshotFetcher = "SnapshotFetcher"
+	NameHashFetcher     = "HashFetcher"
+	NameBlockFetcher    = "BlockFetcher"
+	NameBlockProcessor  = "BlockProcessor"
+	SyncerCfg           = &SyncerConfig{
 		maxHashReqSize:   DfltHashReqSize,
 		maxBlockReqSize:  DfltBlockFetchSize,
 		maxPendingConn:   MaxBlockPendingTasks,
 		maxBlockReqTasks: DfltBlockFetchTasks,
 		fetchTimeOut:     DfltFetchTimeOut,
-		useFullScanOnly:  false}
+		useFullScanOnly:  false,
+		maxSnapshotPeers: DfltSnapshotPeers}
 )
 
 var (
@@ -97,6 +106,18 @@ func NewSyncer(cfg *cfg.Config, chain types.ChainAccessor, syncerCfg *SyncerConf
 	if syncerCfg == nil {
 		syncerCfg = SyncerCfg
 	}
+	if cfg != nil && cfg.Blockchain != nil && cfg.Blockchain.SnapshotSync {
+		// the pivot of snapshot must be given by a trusted source, since the bps in charge of it can't be told
+		// without the state of chain
+		if id, err := types.DecodeBlockID(cfg.Blockchain.SnapshotBlock); err != nil {
+			logger.Error().Err(err).Str("snapshotblock", cfg.Blockchain.SnapshotBlock).Msg("snapshot sync is disabled")
+		} else {
+			copied := *syncerCfg
+			copied.useSnapshot = true
+			copied.snapshotBlock = id[:]
+			syncerCfg = &copied
+		}
+	}
 
 	syncer := &Syncer{cfg: cfg, syncerCfg: syncerCfg}
 
@@ -131,10 +152,12 @@ func (syncer *Syncer) Reset(err error) {
 		logger.Info().Uint64("targetNo", syncer.ctx.TargetNo).Msg("syncer stop#1")
 
 		syncer.finder.stop()
+		syncer.snapshotFetcher.stop()
 		syncer.hashFetcher.stop()
 		syncer.blockFetcher.stop()
 
 		syncer.finder = nil
+		syncer.snapshotFetcher = nil
 		syncer.hashFetcher = nil
 		syncer.blockFetcher = nil
 		syncer.isRunning = false
@@ -194,6 +217,8 @@ func (syncer *Syncer) Receive(context actor.Context) {
 			*message.GetHashByNoRsp,
 			*message.GetBlockChunks,
 			*message.GetBlockChunksRsp,
+			*message.GetStateChunkRsp,
+			*message.GetStateSQLRsp,
 			*message.AddBlockRsp,
 			*message.SyncStop,
 			*message.CloseFetcher:
@@ -231,6 +256,12 @@ func (syncer *Syncer) verifySeq(inmsg interface{}) bool {
 	case *message.GetBlockChunksRsp:
 		seq = msg.Seq
 		match = isMatch(seq)
+	case *message.GetStateChunkRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
+	case *message.GetStateSQLRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
 	case *message.SyncStop:
 		seq = msg.Seq
 		match = isMatch(seq)
@@ -273,8 +304,20 @@ func (syncer *Syncer) handleMessage(inmsg interface{}) {
 		}
 	case *message.GetHashesRsp:
 		syncer.hashFetcher.GetHahsesRsp(msg)
+	case *message.GetStateChunkRsp:
+		if syncer.snapshotFetcher != nil {
+			syncer.snapshotFetcher.GetStateChunkRsp(msg)
+		}
+	case *message.GetStateSQLRsp:
+		if syncer.snapshotFetcher != nil {
+			syncer.snapshotFetcher.GetStateSQLRsp(msg)
+		}
 
 	case *message.GetBlockChunksRsp:
+		if syncer.snapshotFetcher != nil {
+			syncer.snapshotFetcher.GetBlockChunksRsp(msg)
+			return
+		}
 		err := syncer.blockFetcher.handleBlockRsp(msg)
 		if err != nil {
 			syncer.Reset(err)
@@ -291,6 +334,10 @@ func (syncer *Syncer) handleMessage(inmsg interface{}) {
 			logger.Info().Str("from", msg.FromWho).Msg("syncer try to stop successfully")
 		} else {
 			logger.Error().Str("from", msg.FromWho).Err(msg.Err).Msg("syncer try to stop by error")
+			if msg.FromWho == NameSnapshotFetcher {
+				// retry with full sync
+				syncer.snapshotFailed = true
+			}
 		}
 		syncer.Reset(msg.Err)
 	case *message.CloseFetcher:
@@ -347,12 +394,24 @@ func (syncer *Syncer) handleSyncStart(msg *message.SyncStart) error {
 	syncer.ctx = types.NewSyncCtx(syncer.GetSeq(), msg.PeerID, msg.TargetNo, bestBlockNo, msg.NotifyC)
 	syncer.isRunning = true
 
+	if syncer.useSnapshot(bestBlockNo) {
+		syncer.snapshotFetcher = newSnapshotFetcher(syncer.ctx, syncer.getCompRequester(), syncer.syncerCfg)
+		syncer.snapshotFetcher.start()
+		return err
+	}
+
 	syncer.finder = newFinder(syncer.ctx, syncer.getCompRequester(), syncer.chain, syncer.syncerCfg)
 	syncer.finder.start()
 
 	return err
 }
 
+// useSnapshot returns true if the state of the snapshot block can be downloaded instead of executing all blocks. It
+// is used only for the chain which has genesis block only, and not retried once failed.
+func (syncer *Syncer) useSnapshot(bestNo types.BlockNo) bool {
+	return syncer.syncerCfg.useSnapshot && !syncer.snapshotFailed && bestNo == 0
+}
+
 func (syncer *Syncer) handleAncestorRsp(msg *message.GetSyncAncestorRsp) {
 	var ancestorNo uint64
 
@@ -379,6 +438,11 @@ func (syncer *Syncer) handleAncestorRsp(msg *message.GetSyncAncestorRsp) {
 func (syncer *Syncer) handleGetHashByNoRsp(msg *message.GetHashByNoRsp) {
 	logger.Debug().Msg("syncer received gethashbyno response")
 
+	if syncer.snapshotFetcher != nil {
+		syncer.snapshotFetcher.GetHashByNoRsp(msg)
+		return
+	}
+
 	//set ancestor in types.SyncContext
 	syncer.finder.GetHashByNoRsp(msg)
 }
@@ -402,6 +466,8 @@ func (syncer *Syncer) handleFinderResult(msg *message.FinderResult) error {
 
 	syncer.finder.stop()
 	syncer.finder = nil
+	syncer.snapshotFetcher.stop()
+	syncer.snapshotFetcher = nil
 
 	if syncer.syncerCfg.debugContext != nil && syncer.syncerCfg.debugContext.debugFinder {
 		return nil
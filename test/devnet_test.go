@@ -0,0 +1,86 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/pkg/devnet"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDevnet launches a devnet of the aergosvr binary in $AERGOSVR. The test is skipped if it is not set.
+func newDevnet(t *testing.T, consensus string, nodes int) (*devnet.Devnet, func()) {
+	bin := os.Getenv("AERGOSVR")
+	if bin == "" {
+		t.Skip("set AERGOSVR to the path of aergosvr binary to run devnet tests")
+	}
+	dir, err := ioutil.TempDir("", "devnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := devnet.New(devnet.Config{
+		Bin:       bin,
+		Dir:       filepath.Join(dir, consensus),
+		Nodes:     nodes,
+		Consensus: consensus,
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	if err := d.Start(); err != nil {
+		d.Close()
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return d, func() {
+		d.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestDevnetDPoSPartition(t *testing.T) {
+	d, closer := newDevnet(t, devnet.ConsensusDPOS, 3)
+	defer closer()
+	assert.NoError(t, d.WaitHeight(3, time.Minute))
+
+	// the minority is behind the majority while partitioned, and catches up after healing
+	assert.NoError(t, d.Partition([]int{0, 1}, []int{2}))
+	majority, err := d.Nodes[0].Height()
+	assert.NoError(t, err)
+	minority, err := d.Nodes[2].Height()
+	assert.NoError(t, err)
+	assert.NoError(t, d.Nodes[0].WaitHeight(majority+6, time.Minute))
+	// node2 produces its own blocks only in its slots, which are a third of all, without the blocks of majority
+	stalled, err := d.Nodes[2].Height()
+	assert.NoError(t, err)
+	assert.True(t, stalled < minority+6, "node2 followed the majority while partitioned: %d -> %d", minority, stalled)
+	d.Heal()
+	assert.NoError(t, d.WaitHeight(majority+12, time.Minute))
+}
+
+func TestDevnetSBPRestart(t *testing.T) {
+	d, closer := newDevnet(t, devnet.ConsensusSBP, 2)
+	defer closer()
+	assert.NoError(t, d.WaitHeight(3, time.Minute))
+
+	// the follower syncs the blocks produced while it was killed
+	assert.NoError(t, d.KillNode(1))
+	assert.NoError(t, d.Nodes[0].WaitHeight(8, time.Minute))
+	assert.NoError(t, d.StartNode(1))
+	assert.NoError(t, d.Nodes[1].WaitHeight(8, time.Minute))
+}
+
+func TestDevnetRaftRestart(t *testing.T) {
+	d, closer := newDevnet(t, devnet.ConsensusRaft, 3)
+	defer closer()
+	assert.NoError(t, d.WaitHeight(3, time.Minute))
+
+	assert.NoError(t, d.RestartNode(0))
+	height, err := d.Nodes[1].Height()
+	assert.NoError(t, err)
+	assert.NoError(t, d.WaitHeight(height+3, time.Minute))
+}
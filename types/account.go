@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -13,6 +14,15 @@ const AddressLength = 33
 const NameLength = 12
 const EncodedAddressLength = 52
 
+// MaxNameLength is the max length of hierarchical name such as pay.mycorp. It is shorter than AddressLength to
+// distinguish a name from a raw address.
+const MaxNameLength = 32
+
+// IsNameAddress returns whether addr is a name rather than a raw address
+func IsNameAddress(addr []byte) bool {
+	return len(addr) <= NameLength || (len(addr) <= MaxNameLength && bytes.IndexByte(addr, '.') >= 0)
+}
+
 //NewAccount alloc new account object
 func NewAccount(addr []byte) *Account {
 	return &Account{
@@ -47,7 +57,7 @@ const AddressVersion = 0x42
 const PrivKeyVersion = 0xAA
 
 func EncodeAddress(addr Address) string {
-	if len(addr) <= NameLength {
+	if IsNameAddress(addr) {
 		return string(addr)
 	}
 	encoded, _ := base58check.Encode(fmt.Sprintf("%x", AddressVersion), hex.EncodeToString(addr))
@@ -57,7 +67,7 @@ func EncodeAddress(addr Address) string {
 const allowed = "abcdefghijklmnopqrstuvwxyz1234567890."
 
 func DecodeAddress(encodedAddr string) (Address, error) {
-	if len(encodedAddr) <= NameLength {
+	if IsNameAddress([]byte(encodedAddr)) {
 		name := encodedAddr
 		for _, char := range string(name) {
 			if !strings.Contains(allowed, strings.ToLower(string(char))) {
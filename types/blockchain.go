@@ -8,6 +8,7 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"io"
 	"math"
 	"math/big"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/internal/merkle"
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/gogo/protobuf/proto"
 	crypto "github.com/libp2p/go-libp2p-crypto"
 	peer "github.com/libp2p/go-libp2p-peer"
@@ -59,6 +61,9 @@ var StakingMinimum *big.Int
 ///NamePrice is default value of creating and updating name
 var NamePrice *big.Int
 
+//NameValidPeriod is the number of blocks for which a name is registered or renewed
+var NameValidPeriod BlockNo = 60 * 60 * 24 * 365
+
 var lastIndexOfBH int
 
 func init() {
@@ -355,11 +360,18 @@ func (block *Block) BlockNo() BlockNo {
 	return block.GetHeader().GetBlockNo()
 }
 
+// BlockSigner signs the digest of block header. crypto.PrivKey is a BlockSigner, and the key can be also held
+// by an external signer.
+type BlockSigner interface {
+	GetPublic() crypto.PubKey
+	Sign(msg []byte) ([]byte, error)
+}
+
 // Sign adds a pubkey and a block signature to block.
-func (block *Block) Sign(privKey crypto.PrivKey) error {
+func (block *Block) Sign(signer BlockSigner) error {
 	var err error
 
-	if err = block.setPubKey(privKey.GetPublic()); err != nil {
+	if err = block.setPubKey(signer.GetPublic()); err != nil {
 		return err
 	}
 
@@ -369,7 +381,7 @@ func (block *Block) Sign(privKey crypto.PrivKey) error {
 	}
 
 	var sig []byte
-	if sig, err = privKey.Sign(msg); err != nil {
+	if sig, err = signer.Sign(msg); err != nil {
 		return err
 	}
 	block.Header.Sign = sig
@@ -420,6 +432,21 @@ func (block *Block) BPID() (id peer.ID, err error) {
 	return
 }
 
+// BPAddress returns the account address corresponding to the public key of
+// its Block Producer. Since an aergo address is the compressed secp256k1
+// public key, the BP key is also an account key.
+func (block *Block) BPAddress() (Address, error) {
+	pubKey, err := crypto.UnmarshalPublicKey(block.Header.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	pk, ok := pubKey.(*crypto.Secp256k1PublicKey)
+	if !ok {
+		return nil, errors.New("block producer key is not secp256k1")
+	}
+	return (*btcec.PublicKey)(pk).SerializeCompressed(), nil
+}
+
 // BpID2Str returns its Block Producer's ID in base64 format.
 func (block *Block) BPID2Str() string {
 	id, err := block.BPID()
@@ -452,6 +479,28 @@ func (block *Block) PrevID() string {
 
 }
 
+// EncodeBlockHeader returns the base58 encoded protobuf bytes of bh.
+func EncodeBlockHeader(bh *BlockHeader) (string, error) {
+	b, err := proto.Marshal(bh)
+	if err != nil {
+		return "", err
+	}
+	return enc.ToString(b), nil
+}
+
+// DecodeBlockHeader decodes a block header encoded by EncodeBlockHeader.
+func DecodeBlockHeader(encoded string) (*BlockHeader, error) {
+	b, err := enc.ToBytes(encoded)
+	if err != nil {
+		return nil, err
+	}
+	var bh BlockHeader
+	if err := proto.Unmarshal(b, &bh); err != nil {
+		return nil, err
+	}
+	return &bh, nil
+}
+
 // SetPubKey sets block.Header.PubKey to pubkey.
 func (block *Block) setPubKey(pubKey crypto.PubKey) error {
 	var pk []byte
@@ -477,6 +526,33 @@ func CalculateTxsRootHash(txs []*Tx) []byte {
 	return merkle.CalculateMerkleRoot(mes)
 }
 
+// CalculateTxsMerkleProof returns the audit path of the tx at index, which can be verified with the
+// TxsRootHash of block header.
+func CalculateTxsMerkleProof(txs []*Tx, index int) [][]byte {
+	mes := make([]merkle.MerkleEntry, len(txs))
+	for i, tx := range txs {
+		mes[i] = tx
+	}
+	return merkle.CalculateMerkleProof(mes, index)
+}
+
+// Verify checks that the tx of proof is included in the block of header.
+func (p *TxProof) Verify() bool {
+	if p.GetTx() == nil || p.GetHeader() == nil || p.GetTxIdx() == nil {
+		return false
+	}
+	block := &Block{Header: p.Header}
+	if !bytes.Equal(block.BlockHash(), p.TxIdx.BlockHash) || !bytes.Equal(p.Tx.CalculateTxHash(), p.Tx.Hash) {
+		return false
+	}
+	return merkle.VerifyMerkleProof(p.Header.TxsRootHash, p.Tx.GetHash(), int(p.TxIdx.Idx), int(p.LeafCount), p.MerklePath)
+}
+
+// VerifyTx checks that proof is valid and proves the tx of txHash.
+func (p *TxProof) VerifyTx(txHash []byte) bool {
+	return bytes.Equal(p.GetTx().GetHash(), txHash) && p.Verify()
+}
+
 func NewTx() *Tx {
 	tx := &Tx{
 		Body: &TxBody{
@@ -508,11 +584,11 @@ func (tx *Tx) NeedNameVerify() bool {
 }
 
 func (tx *Tx) HasNameAccount() bool {
-	return len(tx.Body.Account) <= NameLength
+	return IsNameAddress(tx.Body.Account)
 }
 
 func (tx *Tx) HasNameRecipient() bool {
-	return tx.Body.Recipient != nil && len(tx.Body.Recipient) <= NameLength
+	return tx.Body.Recipient != nil && IsNameAddress(tx.Body.Recipient)
 }
 
 func (tx *Tx) Clone() *Tx {
@@ -38,7 +38,7 @@ func (x TxType) String() string {
 	return proto.EnumName(TxType_name, int32(x))
 }
 func (TxType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{0}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{0}
 }
 
 type Block struct {
@@ -54,7 +54,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{0}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{0}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -116,7 +116,7 @@ func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{1}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{1}
 }
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHeader.Unmarshal(m, b)
@@ -224,7 +224,7 @@ func (m *BlockBody) Reset()         { *m = BlockBody{} }
 func (m *BlockBody) String() string { return proto.CompactTextString(m) }
 func (*BlockBody) ProtoMessage()    {}
 func (*BlockBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{2}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{2}
 }
 func (m *BlockBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBody.Unmarshal(m, b)
@@ -262,7 +262,7 @@ func (m *TxList) Reset()         { *m = TxList{} }
 func (m *TxList) String() string { return proto.CompactTextString(m) }
 func (*TxList) ProtoMessage()    {}
 func (*TxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{3}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{3}
 }
 func (m *TxList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxList.Unmarshal(m, b)
@@ -301,7 +301,7 @@ func (m *Tx) Reset()         { *m = Tx{} }
 func (m *Tx) String() string { return proto.CompactTextString(m) }
 func (*Tx) ProtoMessage()    {}
 func (*Tx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{4}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{4}
 }
 func (m *Tx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tx.Unmarshal(m, b)
@@ -355,7 +355,7 @@ func (m *TxBody) Reset()         { *m = TxBody{} }
 func (m *TxBody) String() string { return proto.CompactTextString(m) }
 func (*TxBody) ProtoMessage()    {}
 func (*TxBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{5}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{5}
 }
 func (m *TxBody) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxBody.Unmarshal(m, b)
@@ -458,7 +458,7 @@ func (m *TxIdx) Reset()         { *m = TxIdx{} }
 func (m *TxIdx) String() string { return proto.CompactTextString(m) }
 func (*TxIdx) ProtoMessage()    {}
 func (*TxIdx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{6}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{6}
 }
 func (m *TxIdx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxIdx.Unmarshal(m, b)
@@ -504,7 +504,7 @@ func (m *TxInBlock) Reset()         { *m = TxInBlock{} }
 func (m *TxInBlock) String() string { return proto.CompactTextString(m) }
 func (*TxInBlock) ProtoMessage()    {}
 func (*TxInBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{7}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{7}
 }
 func (m *TxInBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxInBlock.Unmarshal(m, b)
@@ -544,6 +544,7 @@ type State struct {
 	CodeHash             []byte   `protobuf:"bytes,3,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
 	StorageRoot          []byte   `protobuf:"bytes,4,opt,name=storageRoot,proto3" json:"storageRoot,omitempty"`
 	SqlRecoveryPoint     uint64   `protobuf:"varint,5,opt,name=sqlRecoveryPoint" json:"sqlRecoveryPoint,omitempty"`
+	SqlDigest            []byte   `protobuf:"bytes,6,opt,name=sqlDigest,proto3" json:"sqlDigest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -553,7 +554,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{8}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{8}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_State.Unmarshal(m, b)
@@ -608,6 +609,13 @@ func (m *State) GetSqlRecoveryPoint() uint64 {
 	return 0
 }
 
+func (m *State) GetSqlDigest() []byte {
+	if m != nil {
+		return m.SqlDigest
+	}
+	return nil
+}
+
 type AccountProof struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state" json:"state,omitempty"`
 	Inclusion            bool     `protobuf:"varint,2,opt,name=inclusion" json:"inclusion,omitempty"`
@@ -626,7 +634,7 @@ func (m *AccountProof) Reset()         { *m = AccountProof{} }
 func (m *AccountProof) String() string { return proto.CompactTextString(m) }
 func (*AccountProof) ProtoMessage()    {}
 func (*AccountProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{9}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{9}
 }
 func (m *AccountProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountProof.Unmarshal(m, b)
@@ -720,7 +728,7 @@ func (m *ContractVarProof) Reset()         { *m = ContractVarProof{} }
 func (m *ContractVarProof) String() string { return proto.CompactTextString(m) }
 func (*ContractVarProof) ProtoMessage()    {}
 func (*ContractVarProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{10}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{10}
 }
 func (m *ContractVarProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContractVarProof.Unmarshal(m, b)
@@ -808,7 +816,7 @@ func (m *StateQueryProof) Reset()         { *m = StateQueryProof{} }
 func (m *StateQueryProof) String() string { return proto.CompactTextString(m) }
 func (*StateQueryProof) ProtoMessage()    {}
 func (*StateQueryProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{11}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{11}
 }
 func (m *StateQueryProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateQueryProof.Unmarshal(m, b)
@@ -865,7 +873,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{12}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{12}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Receipt.Unmarshal(m, b)
@@ -994,7 +1002,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{13}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{13}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Event.Unmarshal(m, b)
@@ -1081,7 +1089,7 @@ func (m *FnArgument) Reset()         { *m = FnArgument{} }
 func (m *FnArgument) String() string { return proto.CompactTextString(m) }
 func (*FnArgument) ProtoMessage()    {}
 func (*FnArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{14}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{14}
 }
 func (m *FnArgument) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FnArgument.Unmarshal(m, b)
@@ -1122,7 +1130,7 @@ func (m *Function) Reset()         { *m = Function{} }
 func (m *Function) String() string { return proto.CompactTextString(m) }
 func (*Function) ProtoMessage()    {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{15}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{15}
 }
 func (m *Function) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Function.Unmarshal(m, b)
@@ -1183,7 +1191,7 @@ func (m *StateVar) Reset()         { *m = StateVar{} }
 func (m *StateVar) String() string { return proto.CompactTextString(m) }
 func (*StateVar) ProtoMessage()    {}
 func (*StateVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{16}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{16}
 }
 func (m *StateVar) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateVar.Unmarshal(m, b)
@@ -1238,7 +1246,7 @@ func (m *ABI) Reset()         { *m = ABI{} }
 func (m *ABI) String() string { return proto.CompactTextString(m) }
 func (*ABI) ProtoMessage()    {}
 func (*ABI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{17}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{17}
 }
 func (m *ABI) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ABI.Unmarshal(m, b)
@@ -1298,7 +1306,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{18}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{18}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -1346,7 +1354,7 @@ func (m *StateQuery) Reset()         { *m = StateQuery{} }
 func (m *StateQuery) String() string { return proto.CompactTextString(m) }
 func (*StateQuery) ProtoMessage()    {}
 func (*StateQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{19}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{19}
 }
 func (m *StateQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateQuery.Unmarshal(m, b)
@@ -1411,7 +1419,7 @@ func (m *FilterInfo) Reset()         { *m = FilterInfo{} }
 func (m *FilterInfo) String() string { return proto.CompactTextString(m) }
 func (*FilterInfo) ProtoMessage()    {}
 func (*FilterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_c7f13acaf0991c73, []int{20}
+	return fileDescriptor_blockchain_a338d8f921c20d18, []int{20}
 }
 func (m *FilterInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilterInfo.Unmarshal(m, b)
@@ -1505,92 +1513,93 @@ func init() {
 	proto.RegisterEnum("types.TxType", TxType_name, TxType_value)
 }
 
-func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_c7f13acaf0991c73) }
-
-var fileDescriptor_blockchain_c7f13acaf0991c73 = []byte{
-	// 1338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x23, 0x45,
-	0x13, 0x7e, 0xc7, 0xf6, 0x38, 0x76, 0xe5, 0xcb, 0xdb, 0xef, 0x0a, 0x06, 0x58, 0xad, 0xcc, 0x28,
-	0xa0, 0x68, 0x05, 0x59, 0x69, 0x11, 0x02, 0xc4, 0x29, 0xd9, 0xdd, 0x80, 0xd9, 0x25, 0x1b, 0x9a,
-	0x90, 0x03, 0x17, 0xd4, 0x9e, 0x69, 0xdb, 0xc3, 0x7a, 0xa6, 0xbd, 0x33, 0x3d, 0x66, 0x7c, 0xe0,
-	0xc4, 0x95, 0x3f, 0xc0, 0x11, 0x89, 0xdf, 0xc5, 0x11, 0x71, 0x41, 0x88, 0x7f, 0x80, 0xaa, 0xba,
-	0xe7, 0xc3, 0x4e, 0x40, 0x44, 0xe2, 0xc0, 0x29, 0x5d, 0x4f, 0x57, 0xb7, 0xab, 0x9e, 0xa7, 0xaa,
-	0x7a, 0x02, 0x83, 0xf1, 0x5c, 0x05, 0xcf, 0x83, 0x99, 0x88, 0x92, 0xa3, 0x45, 0xaa, 0xb4, 0x62,
-	0xae, 0x5e, 0x2d, 0x64, 0xe6, 0xc7, 0xe0, 0x9e, 0xe0, 0x16, 0x63, 0xd0, 0x99, 0x89, 0x6c, 0xe6,
-	0x39, 0x43, 0xe7, 0x70, 0x87, 0xd3, 0x9a, 0xdd, 0x83, 0xee, 0x4c, 0x8a, 0x50, 0xa6, 0x5e, 0x6b,
-	0xe8, 0x1c, 0x6e, 0x3f, 0x60, 0x47, 0x74, 0xe8, 0x88, 0x4e, 0x7c, 0x4c, 0x3b, 0xdc, 0x7a, 0xb0,
-	0x03, 0xe8, 0x8c, 0x55, 0xb8, 0xf2, 0xda, 0xe4, 0x39, 0x68, 0x7a, 0x9e, 0xa8, 0x70, 0xc5, 0x69,
-	0xd7, 0xff, 0xad, 0x05, 0xdb, 0x8d, 0xd3, 0xcc, 0x83, 0x2d, 0x0a, 0x6a, 0xf4, 0xc8, 0xfe, 0x70,
-	0x69, 0xb2, 0x03, 0xd8, 0x5d, 0xa4, 0x72, 0x69, 0x9c, 0x31, 0xb0, 0x16, 0xed, 0xaf, 0x83, 0x78,
-	0x9e, 0x32, 0x3b, 0x53, 0xf4, 0xc3, 0x1d, 0x5e, 0x9a, 0xec, 0x0e, 0xf4, 0x75, 0x14, 0xcb, 0x4c,
-	0x8b, 0x78, 0xe1, 0x75, 0x86, 0xce, 0x61, 0x9b, 0xd7, 0x00, 0x7b, 0x13, 0xf6, 0xc8, 0x31, 0xe3,
-	0x4a, 0x69, 0xba, 0xde, 0xa5, 0xeb, 0x37, 0x50, 0x36, 0x84, 0x6d, 0x5d, 0xd4, 0x4e, 0x5d, 0x72,
-	0x6a, 0x42, 0xec, 0x1e, 0x0c, 0x52, 0x19, 0xc8, 0x68, 0xa1, 0x6b, 0xb7, 0x2d, 0x72, 0xbb, 0x82,
-	0xb3, 0x57, 0xa1, 0x17, 0xa8, 0x64, 0x12, 0xa5, 0x71, 0xe6, 0xf5, 0x28, 0xdc, 0xca, 0x66, 0x2f,
-	0x41, 0x77, 0x91, 0x8f, 0x9f, 0xc8, 0x95, 0xd7, 0xa7, 0xd3, 0xd6, 0x62, 0x87, 0xb0, 0x1f, 0xa8,
-	0x28, 0x19, 0x8b, 0x4c, 0x1e, 0x07, 0x81, 0xca, 0x13, 0xed, 0x01, 0x39, 0x6c, 0xc2, 0xa8, 0x60,
-	0x16, 0x4d, 0x13, 0x6f, 0xdb, 0x28, 0x88, 0x6b, 0xff, 0x10, 0xfa, 0x95, 0x04, 0xec, 0x35, 0x68,
-	0xeb, 0x22, 0xf3, 0x9c, 0x61, 0xfb, 0x70, 0xfb, 0x41, 0xdf, 0x2a, 0x74, 0x51, 0x70, 0x44, 0xfd,
-	0x37, 0xa0, 0x7b, 0x51, 0x3c, 0x8d, 0x32, 0xfd, 0xf7, 0x6e, 0x1f, 0x42, 0xeb, 0xa2, 0xb8, 0xb6,
-	0x58, 0x5e, 0xb7, 0x05, 0x60, 0x4a, 0x65, 0xb7, 0x3a, 0xd7, 0x50, 0xff, 0x87, 0x16, 0xfe, 0x08,
-	0xc5, 0x72, 0x1b, 0xdc, 0x44, 0x25, 0x81, 0xa4, 0x2b, 0x3a, 0xdc, 0x18, 0x28, 0xa7, 0xb0, 0x49,
-	0x1a, 0xb9, 0x4b, 0x13, 0xe5, 0x4c, 0x65, 0x10, 0x2d, 0x22, 0x99, 0x68, 0x92, 0x7a, 0x87, 0xd7,
-	0x00, 0x92, 0x27, 0x62, 0x3a, 0xd6, 0x31, 0xe4, 0x19, 0x0b, 0xef, 0x5b, 0x88, 0xd5, 0x5c, 0x89,
-	0xd0, 0xea, 0x5b, 0x9a, 0x28, 0xc5, 0x54, 0x64, 0x4f, 0xa3, 0x38, 0xd2, 0xa4, 0x6a, 0x87, 0x57,
-	0xb6, 0xdd, 0x3b, 0x4f, 0xa3, 0x40, 0x5a, 0x29, 0x2b, 0x1b, 0xb3, 0xc4, 0xc4, 0x48, 0xbe, 0xbd,
-	0x46, 0x96, 0x17, 0xab, 0x85, 0xe4, 0xb4, 0x85, 0x35, 0x63, 0x8a, 0x38, 0xa4, 0x62, 0x30, 0x72,
-	0x36, 0xa1, 0x4a, 0x29, 0x68, 0x28, 0xf5, 0x1e, 0xb8, 0x17, 0xc5, 0x28, 0x2c, 0x30, 0xd3, 0x71,
-	0x55, 0xf4, 0x86, 0xe0, 0x1a, 0x60, 0x03, 0x68, 0x47, 0x61, 0x41, 0xec, 0xb8, 0x1c, 0x97, 0xfe,
-	0x27, 0xd0, 0xbf, 0x28, 0x46, 0x89, 0xe9, 0x62, 0x1f, 0x5c, 0x8d, 0xb7, 0xd0, 0xc1, 0xed, 0x07,
-	0x3b, 0x55, 0x7c, 0xa3, 0xb0, 0xe0, 0x66, 0x8b, 0xbd, 0x02, 0x2d, 0x5d, 0x58, 0x99, 0x1a, 0xf2,
-	0xb6, 0x74, 0xe1, 0xff, 0xe8, 0x80, 0xfb, 0xb9, 0x16, 0x5a, 0xfe, 0xb5, 0x3e, 0x63, 0x31, 0x17,
-	0x88, 0x5b, 0x7d, 0xac, 0x69, 0x4a, 0x3b, 0x94, 0x14, 0xb4, 0x91, 0xa7, 0xb2, 0x91, 0x90, 0x4c,
-	0xab, 0x54, 0x4c, 0x25, 0x76, 0x82, 0x95, 0xa8, 0x09, 0x61, 0x13, 0x65, 0x2f, 0xe6, 0x5c, 0x06,
-	0x6a, 0x29, 0xd3, 0xd5, 0xb9, 0x8a, 0x12, 0x4d, 0x82, 0x75, 0xf8, 0x15, 0xdc, 0xff, 0xd5, 0x81,
-	0x1d, 0x5b, 0xf2, 0xe7, 0xa9, 0x52, 0x13, 0xcc, 0x39, 0xc3, 0x98, 0x37, 0x72, 0xa6, 0x3c, 0xb8,
-	0xd9, 0x42, 0x52, 0xa3, 0x24, 0x98, 0xe7, 0x59, 0xa4, 0x12, 0x0a, 0xbd, 0xc7, 0x6b, 0x00, 0x49,
-	0x7d, 0x2e, 0x57, 0x36, 0x6e, 0x5c, 0x62, 0x3a, 0x0b, 0xbc, 0x1c, 0xfb, 0xd1, 0xc4, 0x5b, 0xd9,
-	0xd5, 0xde, 0xa5, 0x98, 0xdb, 0xaa, 0xaa, 0x6c, 0x2c, 0xc4, 0x71, 0xa4, 0x63, 0xb1, 0xb0, 0xa3,
-	0xc2, 0x5a, 0x88, 0xcf, 0x64, 0x34, 0x9d, 0x69, 0x2a, 0xa8, 0x5d, 0x6e, 0x2d, 0x8c, 0x4b, 0xe4,
-	0x61, 0xa4, 0xcf, 0x85, 0x9e, 0x79, 0xbd, 0x61, 0x1b, 0xc5, 0xae, 0x00, 0xff, 0x67, 0x07, 0x06,
-	0x0f, 0x55, 0xa2, 0x53, 0x11, 0xe8, 0x4b, 0x91, 0x9a, 0x74, 0x6f, 0x83, 0xbb, 0x14, 0xf3, 0x5c,
-	0xda, 0xda, 0x30, 0xc6, 0x3f, 0x4f, 0xb0, 0xff, 0x5f, 0x4a, 0xf0, 0x3b, 0x07, 0xf6, 0x49, 0xa7,
-	0xcf, 0x72, 0xd4, 0x97, 0xf2, 0xfb, 0x00, 0x76, 0x03, 0x9b, 0x33, 0x01, 0x56, 0xd6, 0xff, 0x5b,
-	0x59, 0x9b, 0xd2, 0xf3, 0x75, 0x4f, 0xf6, 0x2e, 0xf4, 0x97, 0x96, 0xa6, 0xcc, 0x6b, 0xd1, 0xfc,
-	0x7a, 0xd9, 0x1e, 0xdb, 0xa4, 0x91, 0xd7, 0x9e, 0xfe, 0xef, 0x2d, 0xd8, 0xe2, 0x66, 0x56, 0x9b,
-	0x71, 0x6b, 0x5c, 0x8f, 0xc3, 0x30, 0x95, 0x59, 0x66, 0x79, 0xde, 0x84, 0x31, 0x63, 0xac, 0xad,
-	0x3c, 0x23, 0xba, 0xfb, 0xdc, 0x5a, 0xc8, 0x75, 0x2a, 0x75, 0xc9, 0x75, 0x2a, 0x69, 0x3a, 0xe9,
-	0x82, 0x3a, 0xc3, 0x4e, 0x27, 0x63, 0x61, 0x37, 0x4d, 0xa4, 0xfc, 0x22, 0x93, 0xd5, 0x74, 0xb2,
-	0x26, 0x7b, 0x0b, 0x6e, 0x05, 0x79, 0x9c, 0xcf, 0x85, 0x8e, 0x96, 0xf2, 0xd4, 0xfa, 0x18, 0xc2,
-	0xaf, 0x6e, 0x60, 0x45, 0x8c, 0xe7, 0x4a, 0xc5, 0x76, 0x58, 0x19, 0x83, 0x1d, 0x40, 0x57, 0x2e,
-	0x65, 0xa2, 0x33, 0xa2, 0xbd, 0xee, 0x8b, 0xc7, 0x08, 0x72, 0xbb, 0xd7, 0x7c, 0x40, 0xfb, 0x57,
-	0x1e, 0xd0, 0x7a, 0x0e, 0xc1, 0xe6, 0x1c, 0xf2, 0x60, 0x4b, 0x17, 0xa3, 0x24, 0x94, 0x05, 0xbd,
-	0x37, 0x2e, 0x2f, 0x4d, 0x1c, 0x6e, 0x93, 0x54, 0xc5, 0xde, 0x8e, 0x19, 0x6e, 0xb8, 0x66, 0x7b,
-	0xd0, 0xd2, 0xca, 0xdb, 0x25, 0xa4, 0xa5, 0x95, 0xff, 0x87, 0x03, 0x2e, 0xc5, 0x71, 0x03, 0xbe,
-	0xef, 0x40, 0x9f, 0x62, 0x3e, 0x13, 0xb1, 0xb4, 0x94, 0xd7, 0x00, 0xd6, 0xec, 0xd7, 0x99, 0x4a,
-	0x8e, 0xd3, 0x69, 0x66, 0xa9, 0xaf, 0x6c, 0xdc, 0x23, 0x47, 0x9c, 0x8b, 0x1d, 0x0a, 0xb6, 0xb2,
-	0x1b, 0xda, 0xb8, 0x6b, 0xda, 0xac, 0x65, 0xdf, 0xbd, 0x26, 0xfb, 0x92, 0xb5, 0xad, 0x75, 0xd6,
-	0x1a, 0xbc, 0xf4, 0xd6, 0x78, 0xf1, 0x87, 0x00, 0xa7, 0x18, 0x4f, 0x1e, 0x4b, 0xf3, 0x58, 0x27,
-	0x98, 0x88, 0x43, 0xb1, 0xd2, 0xda, 0xff, 0x16, 0x7a, 0xa7, 0x79, 0x12, 0x68, 0xec, 0xd8, 0x6b,
-	0xf6, 0xd9, 0x7d, 0xe8, 0x0b, 0x7b, 0xbe, 0x2c, 0xef, 0x5b, 0x56, 0xd4, 0xfa, 0x66, 0x5e, 0xfb,
-	0xd8, 0xe7, 0x4f, 0x8c, 0xe7, 0x92, 0x38, 0xe9, 0xf1, 0xd2, 0xc4, 0xeb, 0x97, 0x91, 0xfc, 0x86,
-	0xe8, 0xe8, 0x71, 0x5a, 0xfb, 0x8f, 0xa0, 0x47, 0xbd, 0x78, 0x29, 0xd2, 0x6b, 0x7f, 0x9e, 0xd9,
-	0xa7, 0xcf, 0x70, 0x6f, 0xde, 0xba, 0x01, 0xb4, 0xe7, 0x32, 0xa1, 0xdb, 0x5d, 0x8e, 0x4b, 0xff,
-	0x27, 0x07, 0xda, 0xc7, 0x27, 0x23, 0xfc, 0xed, 0xa5, 0x4c, 0x69, 0x1c, 0x99, 0x4b, 0x4a, 0x13,
-	0xe5, 0x98, 0x8b, 0x64, 0x9a, 0x8b, 0x69, 0x79, 0x57, 0x65, 0xb3, 0xb7, 0xa1, 0x3f, 0xb1, 0x14,
-	0xa0, 0x8e, 0x98, 0xe2, 0x7e, 0x99, 0xa2, 0xc5, 0x79, 0xed, 0xc1, 0xde, 0x87, 0x7d, 0x9a, 0xef,
-	0x5f, 0x2d, 0x45, 0x1a, 0x61, 0x62, 0x99, 0xd7, 0x59, 0x3b, 0x54, 0x26, 0xc4, 0xf7, 0x32, 0xbb,
-	0x32, 0x6e, 0xfe, 0x33, 0x70, 0x69, 0xe6, 0xdc, 0xac, 0x00, 0x5f, 0xe0, 0x91, 0x28, 0x99, 0x28,
-	0xfb, 0xfc, 0xd5, 0x80, 0xff, 0xbd, 0x03, 0x50, 0x8f, 0xb2, 0x1b, 0x5c, 0x5b, 0xbf, 0x8e, 0x4f,
-	0xe4, 0xca, 0xe8, 0xda, 0xe7, 0x4d, 0x08, 0x89, 0x4f, 0xf1, 0xe1, 0x34, 0xef, 0x13, 0xad, 0xd9,
-	0x5d, 0x80, 0x40, 0xc5, 0x0b, 0xbc, 0x41, 0x86, 0x56, 0xc6, 0x06, 0xe2, 0xff, 0xe2, 0x00, 0x9c,
-	0x46, 0x73, 0x2d, 0xd3, 0x51, 0x32, 0x51, 0xff, 0x5a, 0x9b, 0x95, 0x6d, 0x41, 0x1d, 0x6e, 0xbe,
-	0xb8, 0x6b, 0xa0, 0x6a, 0x0b, 0xad, 0x28, 0xa2, 0xb2, 0x2d, 0xb4, 0xc2, 0x14, 0x42, 0x99, 0x05,
-	0xd4, 0x64, 0x3d, 0x4e, 0x6b, 0x7a, 0x1a, 0xd2, 0xa9, 0x09, 0xb2, 0x6c, 0xb1, 0x0a, 0xc0, 0x2f,
-	0x74, 0xfc, 0x7e, 0x4e, 0x34, 0x7d, 0xd8, 0x3c, 0x4c, 0xcc, 0xc3, 0xe2, 0xf2, 0x0d, 0xf4, 0xde,
-	0x01, 0x7e, 0x52, 0xe2, 0xd7, 0x17, 0x03, 0xe8, 0x9e, 0x3d, 0xe3, 0x9f, 0x1e, 0x3f, 0x1d, 0xfc,
-	0x8f, 0xed, 0x01, 0x7c, 0xf4, 0xec, 0xf2, 0x31, 0x3f, 0x3b, 0x3e, 0x7b, 0xf8, 0x78, 0xe0, 0x9c,
-	0x0c, 0xbf, 0xbc, 0x3b, 0x8d, 0xf4, 0x2c, 0x1f, 0x1f, 0x05, 0x2a, 0xbe, 0x2f, 0x64, 0x3a, 0x55,
-	0x91, 0x32, 0x7f, 0xef, 0x53, 0xa5, 0x8c, 0xbb, 0xf4, 0x6f, 0xd1, 0x3b, 0x7f, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x1c, 0x39, 0xe4, 0x2a, 0x0d, 0x00, 0x00,
+func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_a338d8f921c20d18) }
+
+var fileDescriptor_blockchain_a338d8f921c20d18 = []byte{
+	// 1345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6e, 0x23, 0xc5,
+	0x13, 0xfe, 0x8d, 0xed, 0x71, 0xec, 0xca, 0x3f, 0x6f, 0xff, 0x56, 0x30, 0xc0, 0x6a, 0x65, 0x46,
+	0x01, 0x45, 0x2b, 0xc8, 0x4a, 0x8b, 0x10, 0x20, 0x4e, 0xc9, 0xee, 0x06, 0xcc, 0x2e, 0xd9, 0xd0,
+	0x84, 0x1c, 0xb8, 0xa0, 0xf6, 0x4c, 0xdb, 0x1e, 0x76, 0x66, 0xda, 0x3b, 0xd3, 0x36, 0xe3, 0x03,
+	0x27, 0xae, 0xbc, 0x00, 0x77, 0xde, 0x83, 0x37, 0xe1, 0x88, 0xb8, 0x20, 0xc4, 0x1b, 0xa0, 0xaa,
+	0xee, 0xf9, 0x63, 0x27, 0x20, 0x22, 0x71, 0xe0, 0x94, 0xae, 0xaf, 0xab, 0xcb, 0x5d, 0xdf, 0x57,
+	0x55, 0x3d, 0x81, 0xc1, 0x38, 0x56, 0xc1, 0xf3, 0x60, 0x26, 0xa2, 0xf4, 0x68, 0x9e, 0x29, 0xad,
+	0x98, 0xab, 0x57, 0x73, 0x99, 0xfb, 0x09, 0xb8, 0x27, 0xb8, 0xc5, 0x18, 0x74, 0x66, 0x22, 0x9f,
+	0x79, 0xce, 0xd0, 0x39, 0xdc, 0xe1, 0xb4, 0x66, 0xf7, 0xa0, 0x3b, 0x93, 0x22, 0x94, 0x99, 0xd7,
+	0x1a, 0x3a, 0x87, 0xdb, 0x0f, 0xd8, 0x11, 0x1d, 0x3a, 0xa2, 0x13, 0x1f, 0xd3, 0x0e, 0xb7, 0x1e,
+	0xec, 0x00, 0x3a, 0x63, 0x15, 0xae, 0xbc, 0x36, 0x79, 0x0e, 0x9a, 0x9e, 0x27, 0x2a, 0x5c, 0x71,
+	0xda, 0xf5, 0x7f, 0x6b, 0xc1, 0x76, 0xe3, 0x34, 0xf3, 0x60, 0x8b, 0x2e, 0x35, 0x7a, 0x64, 0x7f,
+	0xb8, 0x34, 0xd9, 0x01, 0xec, 0xce, 0x33, 0xb9, 0x34, 0xce, 0x78, 0xb1, 0x16, 0xed, 0xaf, 0x83,
+	0x78, 0x9e, 0x32, 0x3b, 0x53, 0xf4, 0xc3, 0x1d, 0x5e, 0x9a, 0xec, 0x0e, 0xf4, 0x75, 0x94, 0xc8,
+	0x5c, 0x8b, 0x64, 0xee, 0x75, 0x86, 0xce, 0x61, 0x9b, 0xd7, 0x00, 0x7b, 0x13, 0xf6, 0xc8, 0x31,
+	0xe7, 0x4a, 0x69, 0x0a, 0xef, 0x52, 0xf8, 0x0d, 0x94, 0x0d, 0x61, 0x5b, 0x17, 0xb5, 0x53, 0x97,
+	0x9c, 0x9a, 0x10, 0xbb, 0x07, 0x83, 0x4c, 0x06, 0x32, 0x9a, 0xeb, 0xda, 0x6d, 0x8b, 0xdc, 0xae,
+	0xe0, 0xec, 0x55, 0xe8, 0x05, 0x2a, 0x9d, 0x44, 0x59, 0x92, 0x7b, 0x3d, 0xba, 0x6e, 0x65, 0xb3,
+	0x97, 0xa0, 0x3b, 0x5f, 0x8c, 0x9f, 0xc8, 0x95, 0xd7, 0xa7, 0xd3, 0xd6, 0x62, 0x87, 0xb0, 0x1f,
+	0xa8, 0x28, 0x1d, 0x8b, 0x5c, 0x1e, 0x07, 0x81, 0x5a, 0xa4, 0xda, 0x03, 0x72, 0xd8, 0x84, 0x51,
+	0xc1, 0x3c, 0x9a, 0xa6, 0xde, 0xb6, 0x51, 0x10, 0xd7, 0xfe, 0x21, 0xf4, 0x2b, 0x09, 0xd8, 0x6b,
+	0xd0, 0xd6, 0x45, 0xee, 0x39, 0xc3, 0xf6, 0xe1, 0xf6, 0x83, 0xbe, 0x55, 0xe8, 0xa2, 0xe0, 0x88,
+	0xfa, 0x6f, 0x40, 0xf7, 0xa2, 0x78, 0x1a, 0xe5, 0xfa, 0xef, 0xdd, 0x3e, 0x84, 0xd6, 0x45, 0x71,
+	0x6d, 0xb1, 0xbc, 0x6e, 0x0b, 0xc0, 0x94, 0xca, 0x6e, 0x75, 0xae, 0xa1, 0xfe, 0x0f, 0x2d, 0xe8,
+	0x1a, 0x80, 0xdd, 0x06, 0x37, 0x55, 0x69, 0x20, 0x29, 0x44, 0x87, 0x1b, 0x03, 0xe5, 0x14, 0x36,
+	0x49, 0x23, 0x77, 0x69, 0xa2, 0x9c, 0x99, 0x0c, 0xa2, 0x79, 0x24, 0x53, 0x4d, 0x52, 0xef, 0xf0,
+	0x1a, 0x40, 0xf2, 0x44, 0x42, 0xc7, 0x3a, 0x86, 0x3c, 0x63, 0x61, 0xbc, 0xb9, 0x58, 0xc5, 0x4a,
+	0x84, 0x56, 0xdf, 0xd2, 0x44, 0x29, 0xa6, 0x22, 0x7f, 0x1a, 0x25, 0x91, 0x26, 0x55, 0x3b, 0xbc,
+	0xb2, 0xed, 0xde, 0x79, 0x16, 0x05, 0xd2, 0x4a, 0x59, 0xd9, 0x98, 0x25, 0x26, 0x46, 0xf2, 0xed,
+	0x35, 0xb2, 0xbc, 0x58, 0xcd, 0x25, 0xa7, 0x2d, 0xac, 0x19, 0x53, 0xc4, 0x21, 0x15, 0x83, 0x91,
+	0xb3, 0x09, 0x55, 0x4a, 0x41, 0x43, 0xa9, 0xf7, 0xc0, 0xbd, 0x28, 0x46, 0x61, 0x81, 0x99, 0x8e,
+	0xab, 0xa2, 0x37, 0x04, 0xd7, 0x00, 0x1b, 0x40, 0x3b, 0x0a, 0x0b, 0x62, 0xc7, 0xe5, 0xb8, 0xf4,
+	0x3f, 0x81, 0xfe, 0x45, 0x31, 0x4a, 0x4d, 0x17, 0xfb, 0xe0, 0x6a, 0x8c, 0x42, 0x07, 0xb7, 0x1f,
+	0xec, 0x54, 0xf7, 0x1b, 0x85, 0x05, 0x37, 0x5b, 0xec, 0x15, 0x68, 0xe9, 0xc2, 0xca, 0xd4, 0x90,
+	0xb7, 0xa5, 0x0b, 0xff, 0x27, 0x07, 0xdc, 0xcf, 0xb5, 0xd0, 0xf2, 0xaf, 0xf5, 0x19, 0x8b, 0x58,
+	0x20, 0x6e, 0xf5, 0xb1, 0xa6, 0x29, 0xed, 0x50, 0xd2, 0xa5, 0x8d, 0x3c, 0x95, 0x8d, 0x84, 0xe4,
+	0x5a, 0x65, 0x62, 0x2a, 0xb1, 0x13, 0xac, 0x44, 0x4d, 0x08, 0x9b, 0x28, 0x7f, 0x11, 0x73, 0x19,
+	0xa8, 0xa5, 0xcc, 0x56, 0xe7, 0x2a, 0x4a, 0x35, 0x09, 0xd6, 0xe1, 0x57, 0x70, 0xe4, 0x27, 0x7f,
+	0x11, 0x3f, 0x8a, 0xa6, 0x32, 0xd7, 0xb6, 0x21, 0x6b, 0xc0, 0xff, 0xd5, 0x81, 0x1d, 0xdb, 0x10,
+	0xe7, 0x99, 0x52, 0x13, 0x64, 0x24, 0xc7, 0x8c, 0x36, 0x18, 0xa1, 0x2c, 0xb9, 0xd9, 0xc2, 0x90,
+	0x51, 0x1a, 0xc4, 0x8b, 0x3c, 0x52, 0x29, 0x25, 0xd6, 0xe3, 0x35, 0x80, 0x94, 0x3f, 0x97, 0x2b,
+	0x9b, 0x15, 0x2e, 0x31, 0xd9, 0x39, 0x06, 0xc7, 0x6e, 0x35, 0xd9, 0x54, 0x76, 0xb5, 0x77, 0x29,
+	0x62, 0x5b, 0x73, 0x95, 0x8d, 0x65, 0x3a, 0x8e, 0x74, 0x22, 0xe6, 0xf6, 0xde, 0xd6, 0x42, 0x7c,
+	0x26, 0xa3, 0xe9, 0x4c, 0x53, 0xb9, 0xed, 0x72, 0x6b, 0xe1, 0xbd, 0xc4, 0x22, 0x8c, 0xf4, 0xb9,
+	0xd0, 0x33, 0xaf, 0x37, 0x6c, 0x63, 0xaa, 0x15, 0xe0, 0xff, 0xec, 0xc0, 0xe0, 0xa1, 0x4a, 0x75,
+	0x26, 0x02, 0x7d, 0x29, 0x32, 0x93, 0xee, 0x6d, 0x70, 0x97, 0x22, 0x5e, 0x48, 0x5b, 0x39, 0xc6,
+	0xf8, 0xe7, 0x09, 0xf6, 0xff, 0x4b, 0x09, 0x7e, 0xe7, 0xc0, 0x3e, 0xe9, 0xf4, 0xd9, 0x02, 0xd5,
+	0xa7, 0xfc, 0x3e, 0x80, 0xdd, 0xc0, 0xe6, 0x4c, 0x80, 0x95, 0xf5, 0xff, 0x56, 0xd6, 0xa6, 0xf4,
+	0x7c, 0xdd, 0x93, 0xbd, 0x0b, 0xfd, 0xa5, 0xa5, 0x29, 0xf7, 0x5a, 0x34, 0xdd, 0x5e, 0xb6, 0xc7,
+	0x36, 0x69, 0xe4, 0xb5, 0xa7, 0xff, 0x7b, 0x0b, 0xb6, 0xb8, 0x99, 0xe4, 0x66, 0x18, 0x1b, 0xd7,
+	0xe3, 0x30, 0xcc, 0x64, 0x9e, 0x5b, 0x9e, 0x37, 0x61, 0xcc, 0x18, 0x6b, 0x6b, 0x91, 0x13, 0xdd,
+	0x7d, 0x6e, 0x2d, 0xe4, 0x3a, 0x93, 0xba, 0xe4, 0x3a, 0x93, 0x34, 0xbb, 0x74, 0x41, 0x7d, 0x63,
+	0x67, 0x97, 0xb1, 0xb0, 0xd7, 0x26, 0x52, 0x7e, 0x91, 0xcb, 0x6a, 0x76, 0x59, 0x93, 0xbd, 0x05,
+	0xb7, 0x82, 0x45, 0xb2, 0x88, 0x85, 0x8e, 0x96, 0xf2, 0xd4, 0xfa, 0x18, 0xc2, 0xaf, 0x6e, 0x60,
+	0x45, 0x8c, 0x63, 0xa5, 0x12, 0x3b, 0xca, 0x8c, 0xc1, 0x0e, 0xa0, 0x2b, 0x97, 0x32, 0xd5, 0x39,
+	0xd1, 0x5e, 0xf7, 0xc5, 0x63, 0x04, 0xb9, 0xdd, 0x6b, 0x3e, 0xaf, 0xfd, 0x2b, 0xcf, 0x6b, 0x3d,
+	0xa5, 0x60, 0x73, 0x4a, 0x79, 0xb0, 0xa5, 0x8b, 0x51, 0x1a, 0xca, 0x82, 0x5e, 0x23, 0x97, 0x97,
+	0x26, 0x8e, 0xbe, 0x49, 0xa6, 0x12, 0x6f, 0xc7, 0x8c, 0x3e, 0x5c, 0xb3, 0x3d, 0x68, 0x69, 0xe5,
+	0xed, 0x12, 0xd2, 0xd2, 0xca, 0xff, 0xc3, 0x01, 0x97, 0xee, 0x71, 0x03, 0xbe, 0xef, 0x40, 0x9f,
+	0xee, 0x7c, 0x26, 0x12, 0x69, 0x29, 0xaf, 0x01, 0xac, 0xd9, 0xaf, 0x73, 0x95, 0x1e, 0x67, 0xd3,
+	0xdc, 0x52, 0x5f, 0xd9, 0xb8, 0x47, 0x8e, 0x38, 0x35, 0x3b, 0x74, 0xd9, 0xca, 0x6e, 0x68, 0xe3,
+	0xae, 0x69, 0xb3, 0x96, 0x7d, 0xf7, 0x9a, 0xec, 0x4b, 0xd6, 0xb6, 0xd6, 0x59, 0x6b, 0xf0, 0xd2,
+	0x5b, 0xe3, 0xc5, 0x1f, 0x02, 0x9c, 0xe2, 0x7d, 0x16, 0x89, 0x34, 0x4f, 0x79, 0x8a, 0x89, 0x38,
+	0x74, 0x57, 0x5a, 0xfb, 0xdf, 0x42, 0xef, 0x74, 0x91, 0x06, 0x1a, 0x3b, 0xf6, 0x9a, 0x7d, 0x76,
+	0x1f, 0xfa, 0xc2, 0x9e, 0x2f, 0xcb, 0xfb, 0x96, 0x15, 0xb5, 0x8e, 0xcc, 0x6b, 0x1f, 0xfb, 0x38,
+	0x8a, 0x71, 0x2c, 0x89, 0x93, 0x1e, 0x2f, 0x4d, 0x0c, 0xbf, 0x8c, 0xe4, 0x37, 0x44, 0x47, 0x8f,
+	0xd3, 0xda, 0x7f, 0x04, 0x3d, 0xea, 0xc5, 0x4b, 0x91, 0x5d, 0xfb, 0xf3, 0xcc, 0x3e, 0x8c, 0x86,
+	0x7b, 0x5a, 0x63, 0xb1, 0xc7, 0x32, 0xa5, 0xe8, 0x2e, 0xc7, 0xa5, 0xff, 0xa3, 0x03, 0xed, 0xe3,
+	0x93, 0x11, 0xfe, 0xf6, 0x52, 0x66, 0x34, 0x8e, 0x4c, 0x90, 0xd2, 0x44, 0x39, 0x62, 0x91, 0x4e,
+	0x17, 0x62, 0x5a, 0xc6, 0xaa, 0x6c, 0xf6, 0x36, 0xf4, 0x27, 0x96, 0x02, 0xd4, 0x11, 0x53, 0xdc,
+	0x2f, 0x53, 0xb4, 0x38, 0xaf, 0x3d, 0xd8, 0xfb, 0xb0, 0x4f, 0xf3, 0xfd, 0xab, 0xa5, 0xc8, 0x22,
+	0x4c, 0x2c, 0xf7, 0x3a, 0x6b, 0x87, 0xca, 0x84, 0xf8, 0x5e, 0x6e, 0x57, 0xc6, 0xcd, 0x7f, 0x06,
+	0x2e, 0xcd, 0x9c, 0x9b, 0x15, 0xe0, 0x0b, 0x3c, 0x12, 0xa5, 0x13, 0x65, 0x1f, 0xc7, 0x1a, 0xf0,
+	0xbf, 0x77, 0x00, 0xea, 0x51, 0x76, 0x83, 0xb0, 0xf5, 0xdb, 0xf9, 0x44, 0xae, 0x8c, 0xae, 0x7d,
+	0xde, 0x84, 0x90, 0xf8, 0x0c, 0x9f, 0x55, 0xf3, 0x3e, 0xd1, 0x9a, 0xdd, 0x05, 0x08, 0x54, 0x32,
+	0xc7, 0x08, 0x32, 0xb4, 0x32, 0x36, 0x10, 0xff, 0x17, 0x07, 0xe0, 0x34, 0x8a, 0xb5, 0xcc, 0x46,
+	0xe9, 0x44, 0xfd, 0x6b, 0x6d, 0x56, 0xb6, 0x05, 0x75, 0xb8, 0xf9, 0x1e, 0xaf, 0x81, 0xaa, 0x2d,
+	0xb4, 0xf2, 0x3a, 0x8d, 0xb6, 0xd0, 0x0a, 0x53, 0x08, 0x65, 0x1e, 0x50, 0x93, 0xf5, 0x38, 0xad,
+	0xe9, 0x69, 0xc8, 0xa6, 0xe6, 0x92, 0x65, 0x8b, 0x55, 0x00, 0x7e, 0xbf, 0xe3, 0xd7, 0x75, 0xaa,
+	0xe9, 0xb3, 0xe7, 0x61, 0x6a, 0x1e, 0x16, 0x97, 0x6f, 0xa0, 0xf7, 0x0e, 0xa0, 0x6b, 0xbe, 0xcd,
+	0x18, 0x40, 0xf7, 0xec, 0x19, 0xff, 0xf4, 0xf8, 0xe9, 0xe0, 0x7f, 0x6c, 0x0f, 0xe0, 0xa3, 0x67,
+	0x97, 0x8f, 0xf9, 0xd9, 0xf1, 0xd9, 0xc3, 0xc7, 0x03, 0xe7, 0x64, 0xf8, 0xe5, 0xdd, 0x69, 0xa4,
+	0x67, 0x8b, 0xf1, 0x51, 0xa0, 0x92, 0xfb, 0x42, 0x66, 0x53, 0x15, 0x29, 0xf3, 0xf7, 0x3e, 0x55,
+	0xca, 0xb8, 0x4b, 0xff, 0x34, 0xbd, 0xf3, 0xe7, 0x00, 0xb1, 0xfe, 0xa9, 0x58, 0x48, 0x0d, 0x00,
+	0x00,
 }
@@ -12,6 +12,7 @@ import (
 
 	crypto "github.com/libp2p/go-libp2p-crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/willf/bloom"
 )
 
 func TestBlockHash(t *testing.T) {
@@ -242,3 +243,67 @@ func TestBlockLimit(t *testing.T) {
 	a.True(block.Size() <= txSize*i+hdrSize, "block size violation")
 	a.True(block.Size() <= limit, "block size violation")
 }
+
+func TestTxProof(t *testing.T) {
+	txs := make([]*Tx, 5)
+	for i := range txs {
+		tx := NewTx()
+		tx.Body.Nonce = uint64(i + 1)
+		tx.Hash = tx.CalculateTxHash()
+		txs[i] = tx
+	}
+	block := NewBlock(nil, nil, nil, txs, nil, 0)
+
+	for i, tx := range txs {
+		proof := &TxProof{TxIdx: &TxIdx{BlockHash: block.BlockHash(), Idx: int32(i)}, Tx: tx, Header: block.Header,
+			MerklePath: CalculateTxsMerkleProof(txs, i), LeafCount: uint32(len(txs))}
+		assert.True(t, proof.Verify(), "idx=%d", i)
+		assert.True(t, proof.VerifyTx(tx.Hash), "idx=%d", i)
+		assert.False(t, proof.VerifyTx(txs[(i+1)%len(txs)].Hash), "idx=%d", i)
+
+		// tx of other position must not be verified
+		proof.Tx = txs[(i+1)%len(txs)]
+		assert.False(t, proof.Verify(), "idx=%d", i)
+	}
+
+	// the last tx is copied to the padding of merkle tree, but can't be proven at the index of copy
+	last := len(txs) - 1
+	proof := &TxProof{TxIdx: &TxIdx{BlockHash: block.BlockHash(), Idx: int32(last + 1)}, Tx: txs[last], Header: block.Header,
+		MerklePath: CalculateTxsMerkleProof(txs, last), LeafCount: uint32(len(txs))}
+	assert.False(t, proof.Verify())
+	proof.LeafCount++
+	assert.False(t, proof.Verify())
+}
+
+func TestReceiptProof(t *testing.T) {
+	contract := make([]byte, 33)
+	rs := make([]*Receipt, 3)
+	for i := range rs {
+		rs[i] = &Receipt{ContractAddress: contract, Status: "SUCCESS", Ret: fmt.Sprintf("%d", i), TxHash: []byte(fmt.Sprintf("tx%d", i))}
+		rs[i].Events = []*Event{{ContractAddress: contract, EventName: "ev", JsonArgs: fmt.Sprintf("[%d]", i)}}
+	}
+	receipts := &Receipts{}
+	receipts.Set(rs)
+	receipts.MergeBloom(bloom.New(BloomBitBits, BloomHashKNum))
+	block := NewBlock(nil, nil, receipts, nil, nil, 0)
+
+	for i, r := range rs {
+		proof := &ReceiptProof{TxIdx: &TxIdx{BlockHash: block.BlockHash(), Idx: int32(i)}, Receipt: r, Header: block.Header,
+			MerklePath: receipts.MerkleProof(i), LeafCount: uint32(receipts.MerkleLeafCount())}
+		assert.True(t, proof.Verify(), "idx=%d", i)
+		assert.True(t, proof.VerifyTx(r.TxHash), "idx=%d", i)
+		// a valid proof of another tx
+		assert.False(t, proof.VerifyTx(rs[(i+1)%len(rs)].TxHash), "idx=%d", i)
+
+		evProof := &EventProof{Event: r.Events[0], ReceiptProof: proof}
+		assert.True(t, evProof.Verify(), "idx=%d", i)
+		assert.True(t, evProof.VerifyEvent(r.TxHash, 0), "idx=%d", i)
+		assert.False(t, evProof.VerifyEvent(r.TxHash, 1), "idx=%d", i)
+		evProof.Event = rs[(i+1)%len(rs)].Events[0]
+		assert.False(t, evProof.Verify(), "idx=%d", i)
+
+		proof.Receipt = rs[(i+1)%len(rs)]
+		assert.False(t, proof.Verify(), "idx=%d", i)
+	}
+	assert.Nil(t, receipts.MerkleProof(len(rs)))
+}
@@ -68,4 +68,67 @@ var (
 
 	//ErrTooSmallAmount
 	ErrExceedAmount = errors.New("request amount exceeds")
+
+	//ErrNoCommission
+	ErrNoCommission = errors.New("bp has not declared commission")
+
+	//ErrDelegatedToOtherBP
+	ErrDelegatedToOtherBP = errors.New("already delegated to other bp")
+
+	//ErrMustDelegateBeforeUndelegate
+	ErrMustDelegateBeforeUndelegate = errors.New("must delegate before undelegate")
+
+	//ErrNoReward
+	ErrNoReward = errors.New("no reward to claim")
+
+	//ErrTooManyUnbondings
+	ErrTooManyUnbondings = errors.New("too many unbonding requests in progress")
+
+	//ErrNothingToWithdraw
+	ErrNothingToWithdraw = errors.New("nothing to withdraw")
+
+	//ErrInvalidEvidence
+	ErrInvalidEvidence = errors.New("invalid evidence of double signing")
+
+	//ErrEvidenceExpired
+	ErrEvidenceExpired = errors.New("evidence is too old")
+
+	//ErrAlreadySlashed
+	ErrAlreadySlashed = errors.New("already slashed for the evidence")
+
+	//ErrUnknownParameter
+	ErrUnknownParameter = errors.New("unknown parameter")
+
+	//ErrInvalidParameterValue
+	ErrInvalidParameterValue = errors.New("parameter value is out of range")
+
+	//ErrProposalNotFound
+	ErrProposalNotFound = errors.New("could not find proposal")
+
+	//ErrProposalClosed
+	ErrProposalClosed = errors.New("voting for proposal is closed")
+
+	//ErrAlreadyVoted
+	ErrAlreadyVoted = errors.New("already voted for proposal")
+
+	//ErrTooManyProposals
+	ErrTooManyProposals = errors.New("too many proposals in progress")
+
+	//ErrStakingLocked
+	ErrStakingLocked = errors.New("staking is locked until the end of voting for proposal")
+
+	//ErrNotBlockProducer
+	ErrNotBlockProducer = errors.New("sender is not a block producer")
+
+	//ErrPeerAlreadyAllowed
+	ErrPeerAlreadyAllowed = errors.New("peer is already in allowlist")
+
+	//ErrPeerNotInAllowlist
+	ErrPeerNotInAllowlist = errors.New("peer is not in allowlist")
+
+	//ErrTooManyAllowedPeers
+	ErrTooManyAllowedPeers = errors.New("too many peers in allowlist")
+
+	//ErrAlreadyApproved
+	ErrAlreadyApproved = errors.New("already approved the change of allowlist")
 )
@@ -0,0 +1,215 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ForkSchedule is the activation block numbers of named forks. The rules changed by a fork apply to the blocks from
+// its activation block number. The name of fork is defined with the code which changes the rules, and is asked by
+// IsForkActive.
+type ForkSchedule map[string]BlockNo
+
+// names of forks
+const (
+	// ForkNameExpiry applies the expiry, the renewal and the reverse records of names
+	ForkNameExpiry = "nameexpiry"
+	// ForkSubName applies the subnames given by the owner of parent name. It must not be activated before
+	// ForkNameExpiry.
+	ForkSubName = "subname"
+	// ForkUnbonding applies the unbonding period of unstaked amount, the withdrawal and the slashing of bp
+	ForkUnbonding = "unbonding"
+	// ForkSQLDigest commits the digest of the sql database of contract next to its recovery point, so that the
+	// database downloaded by snapshot sync can be verified
+	ForkSQLDigest = "sqldigest"
+	// ForkDelegation applies the delegation of staking to bp, the commission of bp and the distribution of block
+	// reward to the delegators
+	ForkDelegation = "delegation"
+	// ForkGovernance applies the proposals of chain parameters, the voting for them and their application
+	ForkGovernance = "governance"
+	// ForkAllowlist applies the allowlist of peers which the bps approve to make a permissioned network
+	ForkAllowlist = "allowlist"
+)
+
+var (
+	forkLock sync.RWMutex
+	forks    ForkSchedule
+)
+
+var (
+	ErrInvalidForkName  = errors.New("invalid fork name")
+	ErrForkIncompatible = errors.New("incompatible fork schedule")
+)
+
+// InitForks sets the fork schedule of the chain
+func InitForks(schedule ForkSchedule) {
+	forkLock.Lock()
+	defer forkLock.Unlock()
+	forks = schedule.Merge(nil)
+}
+
+// GetForks returns the fork schedule of the chain
+func GetForks() ForkSchedule {
+	forkLock.RLock()
+	defer forkLock.RUnlock()
+	return forks.Merge(nil)
+}
+
+// IsForkActive reports whether the fork is active at blockNo. The fork which is not scheduled is never active.
+func IsForkActive(name string, blockNo BlockNo) bool {
+	forkLock.RLock()
+	defer forkLock.RUnlock()
+	height, exists := forks[name]
+	return exists && blockNo >= height
+}
+
+// ParseForks parses the forks in the form of name=blockNo
+func ParseForks(list []string) (ForkSchedule, error) {
+	schedule := make(ForkSchedule)
+	for _, f := range list {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid fork %s: not in the form of name=blockNo", f)
+		}
+		height, err := strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fork %s: %s", f, err.Error())
+		}
+		schedule[strings.TrimSpace(kv[0])] = height
+	}
+	return schedule, schedule.Validate()
+}
+
+// Validate checks the names of forks and the order of dependent forks
+func (s ForkSchedule) Validate() error {
+	for name := range s {
+		if len(name) == 0 || strings.ContainsAny(name, " \t\n=,") {
+			return fmt.Errorf("%s: '%s'", ErrInvalidForkName.Error(), name)
+		}
+	}
+	if height, exists := s[ForkSubName]; exists {
+		if expiry, exists := s[ForkNameExpiry]; !exists || expiry > height {
+			return fmt.Errorf("fork %s must not be activated before %s", ForkSubName, ForkNameExpiry)
+		}
+	}
+	return nil
+}
+
+// Merge returns a new schedule with the forks of s and other. The fork of other takes precedence.
+func (s ForkSchedule) Merge(other ForkSchedule) ForkSchedule {
+	merged := make(ForkSchedule, len(s)+len(other))
+	for name, height := range s {
+		merged[name] = height
+	}
+	for name, height := range other {
+		merged[name] = height
+	}
+	return merged
+}
+
+// heights returns the distinct activation block numbers in ascending order
+func (s ForkSchedule) heights() []BlockNo {
+	seen := make(map[BlockNo]bool)
+	var heights []BlockNo
+	for _, height := range s {
+		if !seen[height] {
+			seen[height] = true
+			heights = append(heights, height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
+
+// namesAt returns the names of forks activated at height in ascending order
+func (s ForkSchedule) namesAt(height BlockNo) []string {
+	var names []string
+	for name, h := range s {
+		if h == height {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ForkID identifies the forks which a node has activated, and the next fork it will activate. Nodes exchange their
+// fork ids to tell apart the nodes with incompatible fork schedules.
+type ForkID struct {
+	// Hash is the checksum of genesis block hash and the activated forks
+	Hash uint32
+	// Next is the block number of the next fork, or 0 if there isn't
+	Next BlockNo
+}
+
+// forkIDs returns the fork ids after each activation block number of s. The first one is for the blocks before any
+// fork.
+func (s ForkSchedule) forkIDs(genesisHash []byte) []ForkID {
+	heights := s.heights()
+	ids := make([]ForkID, 0, len(heights)+1)
+	hash := crc32.ChecksumIEEE(genesisHash)
+	for _, height := range heights {
+		ids = append(ids, ForkID{Hash: hash, Next: height})
+
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, height)
+		hash = crc32.Update(hash, crc32.IEEETable, buf)
+		for _, name := range s.namesAt(height) {
+			hash = crc32.Update(hash, crc32.IEEETable, []byte(name))
+		}
+	}
+	return append(ids, ForkID{Hash: hash})
+}
+
+// passed returns the number of activation block numbers which are not higher than blockNo
+func (s ForkSchedule) passed(blockNo BlockNo) int {
+	n := 0
+	for _, height := range s.heights() {
+		if height <= blockNo {
+			n++
+		}
+	}
+	return n
+}
+
+// ForkID returns the fork id of node whose best block number is blockNo
+func (s ForkSchedule) ForkID(genesisHash []byte, blockNo BlockNo) ForkID {
+	return s.forkIDs(genesisHash)[s.passed(blockNo)]
+}
+
+// CheckForkID checks whether the remote node with fork id is compatible with the local node whose best block number
+// is blockNo. The remote node may be behind or ahead of the local node, as long as their schedules agree on the forks
+// both of them have activated and the next one of the node behind.
+func (s ForkSchedule) CheckForkID(genesisHash []byte, blockNo BlockNo, remote ForkID) error {
+	ids := s.forkIDs(genesisHash)
+	local := s.passed(blockNo)
+	for i, id := range ids {
+		if id.Hash != remote.Hash {
+			continue
+		}
+		switch {
+		case i == local:
+			// the remote node schedules a fork which the local node has passed without it
+			if remote.Next != 0 && remote.Next <= blockNo {
+				return fmt.Errorf("%s: remote fork at %d is already passed", ErrForkIncompatible.Error(), remote.Next)
+			}
+			return nil
+		case i < local:
+			// the remote node is behind, and must activate the same fork next
+			if remote.Next != id.Next {
+				return fmt.Errorf("%s: remote next fork at %d, local at %d", ErrForkIncompatible.Error(), remote.Next, id.Next)
+			}
+			return nil
+		default:
+			// the remote node is ahead, and has activated the forks of local schedule
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: unknown fork id %08x", ErrForkIncompatible.Error(), remote.Hash)
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkSchedule(t *testing.T) {
+	s, err := ParseForks([]string{"v2=100", " v3 = 200"})
+	assert.NoError(t, err)
+	assert.Equal(t, ForkSchedule{"v2": 100, "v3": 200}, s)
+
+	_, err = ParseForks([]string{"v2"})
+	assert.Error(t, err)
+	_, err = ParseForks([]string{"=10"})
+	assert.Error(t, err)
+
+	InitForks(s)
+	defer InitForks(nil)
+	assert.False(t, IsForkActive("v2", 99))
+	assert.True(t, IsForkActive("v2", 100))
+	assert.False(t, IsForkActive("unknown", 1000))
+
+	merged := s.Merge(ForkSchedule{"v3": 300})
+	assert.Equal(t, BlockNo(300), merged["v3"])
+	assert.Equal(t, BlockNo(200), s["v3"], "not modified")
+}
+
+func TestForkID(t *testing.T) {
+	genesis := []byte("genesis")
+	s := ForkSchedule{"v2": 100, "v3": 200}
+
+	before := s.ForkID(genesis, 10)
+	assert.Equal(t, BlockNo(100), before.Next)
+	after := s.ForkID(genesis, 150)
+	assert.Equal(t, BlockNo(200), after.Next)
+	assert.NotEqual(t, before.Hash, after.Hash)
+	assert.Equal(t, BlockNo(0), s.ForkID(genesis, 200).Next)
+
+	// same node, remote behind and remote ahead
+	assert.NoError(t, s.CheckForkID(genesis, 150, after))
+	assert.NoError(t, s.CheckForkID(genesis, 150, before))
+	assert.NoError(t, s.CheckForkID(genesis, 10, after))
+
+	// the remote node which doesn't know v3 and passed 200
+	old := ForkSchedule{"v2": 100}
+	assert.Error(t, s.CheckForkID(genesis, 250, old.ForkID(genesis, 250)))
+	// the remote node which is behind and doesn't know v3
+	assert.Error(t, s.CheckForkID(genesis, 250, old.ForkID(genesis, 150)))
+	// it is compatible until the local node passes v3
+	assert.NoError(t, s.CheckForkID(genesis, 150, old.ForkID(genesis, 150)))
+
+	// another name of fork at the same height
+	other := ForkSchedule{"v2x": 100, "v3": 200}
+	assert.Error(t, s.CheckForkID(genesis, 150, other.ForkID(genesis, 150)))
+	// another chain
+	assert.Error(t, s.CheckForkID(genesis, 10, s.ForkID([]byte("other"), 10)))
+}
+
+func TestForkScheduleDependency(t *testing.T) {
+	assert.NoError(t, ForkSchedule{ForkNameExpiry: 100, ForkSubName: 100}.Validate())
+	assert.Error(t, ForkSchedule{ForkSubName: 100}.Validate())
+	assert.Error(t, ForkSchedule{ForkNameExpiry: 200, ForkSubName: 100}.Validate())
+}
+
+func TestValidateForkTx(t *testing.T) {
+	InitForks(ForkSchedule{ForkNameExpiry: 100, ForkSubName: 200, ForkUnbonding: 100, ForkDelegation: 300, ForkGovernance: 400, ForkAllowlist: 500})
+	defer InitForks(nil)
+
+	governance := func(recipient, payload string) *TxBody {
+		return &TxBody{Type: TxType_GOVERNANCE, Recipient: []byte(recipient), Payload: []byte(payload)}
+	}
+	tests := []struct {
+		name   string
+		tx     *TxBody
+		active BlockNo
+	}{
+		{"TRenew", governance(AergoName, `{"Name":"v1renewName","Args":["ab1234567890"]}`), 100},
+		{"TRevoke", governance(AergoName, `{"Name":"v1revokeName","Args":["pay.ab1234567890"]}`), 200},
+		{"TSubName", governance(AergoName, `{"Name":"v1createName","Args":["pay.ab1234567890"]}`), 200},
+		{"TWithdraw", governance(AergoSystem, `{"Name":"v1withdraw"}`), 100},
+		{"TSlash", governance(AergoSystem, `{"Name":"v1slash","Args":["a","b"]}`), 100},
+		{"TDelegate", governance(AergoSystem, `{"Name":"v1delegate","Args":["a"]}`), 300},
+		{"TClaim", governance(AergoSystem, `{"Name":"v1claim"}`), 300},
+		{"TPropose", governance(AergoSystem, `{"Name":"v1propose","Args":["bpcount","5"]}`), 400},
+		{"TVoteProposal", governance(AergoSystem, `{"Name":"v1voteProposal","Args":["1","yes"]}`), 400},
+		{"TAddAllowedPeer", governance(AergoSystem, `{"Name":"v1addAllowedPeer","Args":["a"]}`), 500},
+		{"TCreate", governance(AergoName, `{"Name":"v1createName","Args":["ab1234567890"]}`), 0},
+		{"TStake", governance(AergoSystem, `{"Name":"v1stake"}`), 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.active > 0 {
+				assert.Error(t, ValidateForkTx(test.tx, test.active-1))
+			}
+			assert.NoError(t, ValidateForkTx(test.tx, test.active))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	fmt "fmt"
 	"math"
 	"math/big"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo/internal/common"
+	peer "github.com/libp2p/go-libp2p-peer"
 )
 
 const (
@@ -19,6 +21,10 @@ const (
 	devChainMagic   = "dev.chain"
 )
 
+// ErrGenesisStakesWithoutBP is returned when the genesis has the initial
+// stakes, but no BPs to set up the system contract.
+var ErrGenesisStakesWithoutBP = errors.New("genesis stakes require bps")
+
 var (
 	nilChainID = ChainID{
 		Version:   0,
@@ -188,6 +194,8 @@ type Genesis struct {
 	Timestamp int64             `json:"timestamp,omitempty"`
 	Balance   map[string]string `json:"balance"`
 	BPs       []string          `json:"bps"`
+	Stakes    map[string]string `json:"stakes,omitempty"`
+	Forks     ForkSchedule      `json:"forks,omitempty"`
 
 	// followings are for internal use only
 	totalBalance *big.Int
@@ -201,10 +209,72 @@ func (g *Genesis) Validate() error {
 	if err != nil {
 		return err
 	}
+	if err := g.Forks.Validate(); err != nil {
+		return err
+	}
+	for address, balance := range g.Balance {
+		if _, err := DecodeAddress(address); err != nil {
+			return fmt.Errorf("invalid balance address %s: %s", address, err.Error())
+		}
+		if v, ok := new(big.Int).SetString(balance, 10); !ok || v.Sign() < 0 {
+			return fmt.Errorf("invalid balance %s (address: %s)", balance, address)
+		}
+	}
+	seen := make(map[string]bool, len(g.BPs))
+	for _, bp := range g.BPs {
+		if _, err := peer.IDB58Decode(bp); err != nil {
+			return fmt.Errorf("invalid bp %s: %s", bp, err.Error())
+		}
+		if seen[bp] {
+			return fmt.Errorf("duplicated bp %s", bp)
+		}
+		seen[bp] = true
+	}
+	if len(g.Stakes) > 0 && len(g.BPs) == 0 {
+		return ErrGenesisStakesWithoutBP
+	}
+	if _, err := g.StakeAmounts(); err != nil {
+		return err
+	}
 	//TODO check BP count
 	return nil
 }
 
+// StakeAmounts returns the initial stakes of g by the decoded addresses. Each
+// of them must not be less than StakingMinimum.
+func (g *Genesis) StakeAmounts() (map[string]*big.Int, error) {
+	stakes := make(map[string]*big.Int, len(g.Stakes))
+	for address, amount := range g.Stakes {
+		addr, err := DecodeAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stake address %s: %s", address, err.Error())
+		}
+		v, ok := new(big.Int).SetString(amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("stake conversion failed for %s (address: %s)", amount, address)
+		}
+		if v.Cmp(StakingMinimum) < 0 {
+			return nil, fmt.Errorf("stake %s of %s is less than the minimum %s", amount, address, StakingMinimum.String())
+		}
+		stakes[string(addr)] = v
+	}
+	return stakes, nil
+}
+
+// TotalStake returns the sum of the initial stakes, which is held by the
+// system account.
+func (g *Genesis) TotalStake() (*big.Int, error) {
+	stakes, err := g.StakeAmounts()
+	if err != nil {
+		return nil, err
+	}
+	total := big.NewInt(0)
+	for _, v := range stakes {
+		total.Add(total, v)
+	}
+	return total, nil
+}
+
 // Block returns Block corresponding to g.
 func (g *Genesis) Block() *Block {
 	if g.block == nil {
@@ -251,8 +321,9 @@ func (g *Genesis) ChainID() ([]byte, error) {
 
 // Bytes returns byte-encoded BPs from g.
 func (g Genesis) Bytes() []byte {
-	// Omit the Balance to reduce the resulting data size.
+	// Omit the Balance and the Stakes to reduce the resulting data size.
 	g.Balance = nil
+	g.Stakes = nil
 	if b, err := common.GobEncode(g); err == nil {
 		return b
 	}
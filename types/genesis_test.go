@@ -66,3 +66,24 @@ func TestCodecChainID(t *testing.T) {
 	a.Nil(err)
 	a.True(id1.Equals(id2))
 }
+
+func TestGenesisValidate(t *testing.T) {
+	a := assert.New(t)
+	g := GetDefaultGenesis()
+	g.Balance = map[string]string{"AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ": "1000"}
+	g.BPs = []string{"16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n"}
+	g.Stakes = map[string]string{"AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ": StakingMinimum.String()}
+	a.NoError(g.Validate())
+	total, err := g.TotalStake()
+	a.NoError(err)
+	a.Equal(StakingMinimum, total)
+
+	g.Balance["invalid"] = "1000"
+	a.Error(g.Validate())
+	delete(g.Balance, "invalid")
+
+	g.BPs = append(g.BPs, g.BPs[0])
+	a.Error(g.Validate(), "duplicated bp")
+	g.BPs = nil
+	a.Equal(ErrGenesisStakesWithoutBP, g.Validate())
+}
@@ -40,7 +40,7 @@ func (x MetricType) String() string {
 	return proto.EnumName(MetricType_name, int32(x))
 }
 func (MetricType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_metric_295631cc212b5411, []int{0}
+	return fileDescriptor_metric_027f9482acb395ce, []int{0}
 }
 
 type MetricsRequest struct {
@@ -54,7 +54,7 @@ func (m *MetricsRequest) Reset()         { *m = MetricsRequest{} }
 func (m *MetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*MetricsRequest) ProtoMessage()    {}
 func (*MetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metric_295631cc212b5411, []int{0}
+	return fileDescriptor_metric_027f9482acb395ce, []int{0}
 }
 func (m *MetricsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MetricsRequest.Unmarshal(m, b)
@@ -92,7 +92,7 @@ func (m *Metrics) Reset()         { *m = Metrics{} }
 func (m *Metrics) String() string { return proto.CompactTextString(m) }
 func (*Metrics) ProtoMessage()    {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metric_295631cc212b5411, []int{1}
+	return fileDescriptor_metric_027f9482acb395ce, []int{1}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Metrics.Unmarshal(m, b)
@@ -125,6 +125,8 @@ type PeerMetric struct {
 	AvrIn                int64    `protobuf:"varint,3,opt,name=avrIn" json:"avrIn,omitempty"`
 	SumOut               int64    `protobuf:"varint,4,opt,name=sumOut" json:"sumOut,omitempty"`
 	AvrOut               int64    `protobuf:"varint,5,opt,name=avrOut" json:"avrOut,omitempty"`
+	SavedIn              int64    `protobuf:"varint,6,opt,name=savedIn" json:"savedIn,omitempty"`
+	SavedOut             int64    `protobuf:"varint,7,opt,name=savedOut" json:"savedOut,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -134,7 +136,7 @@ func (m *PeerMetric) Reset()         { *m = PeerMetric{} }
 func (m *PeerMetric) String() string { return proto.CompactTextString(m) }
 func (*PeerMetric) ProtoMessage()    {}
 func (*PeerMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_metric_295631cc212b5411, []int{2}
+	return fileDescriptor_metric_027f9482acb395ce, []int{2}
 }
 func (m *PeerMetric) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerMetric.Unmarshal(m, b)
@@ -189,6 +191,20 @@ func (m *PeerMetric) GetAvrOut() int64 {
 	return 0
 }
 
+func (m *PeerMetric) GetSavedIn() int64 {
+	if m != nil {
+		return m.SavedIn
+	}
+	return 0
+}
+
+func (m *PeerMetric) GetSavedOut() int64 {
+	if m != nil {
+		return m.SavedOut
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MetricsRequest)(nil), "types.MetricsRequest")
 	proto.RegisterType((*Metrics)(nil), "types.Metrics")
@@ -196,24 +212,26 @@ func init() {
 	proto.RegisterEnum("types.MetricType", MetricType_name, MetricType_value)
 }
 
-func init() { proto.RegisterFile("metric.proto", fileDescriptor_metric_295631cc212b5411) }
-
-var fileDescriptor_metric_295631cc212b5411 = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0x40, 0x5d, 0x63, 0x5a, 0x98, 0x94, 0x5a, 0x17, 0x91, 0x9c, 0x24, 0xf4, 0x62, 0xe8, 0x21,
-	0x85, 0x78, 0xf2, 0x2a, 0x8a, 0x06, 0x31, 0x09, 0x4b, 0x40, 0xf0, 0x22, 0x69, 0x19, 0x6a, 0x0e,
-	0xe9, 0xc6, 0xfd, 0x28, 0xf4, 0xe6, 0x4f, 0x97, 0xdd, 0x09, 0xf6, 0x14, 0xde, 0x9b, 0x79, 0x81,
-	0x59, 0x98, 0xf5, 0x68, 0x54, 0xb7, 0xcd, 0x06, 0x25, 0x8d, 0xe4, 0xa1, 0x39, 0x0e, 0xa8, 0x97,
-	0x0f, 0x30, 0x7f, 0xf7, 0x5a, 0x0b, 0xfc, 0xb1, 0xa8, 0x0d, 0xbf, 0x03, 0x1a, 0xc5, 0x2c, 0x09,
-	0xd2, 0x79, 0x7e, 0x95, 0x79, 0xca, 0x68, 0xab, 0x39, 0x0e, 0x28, 0xc6, 0x34, 0x87, 0xe9, 0x98,
-	0xba, 0x66, 0x40, 0x54, 0xd4, 0x44, 0xff, 0x4d, 0x8d, 0xa8, 0x68, 0x45, 0xd0, 0x7c, 0xf9, 0xcb,
-	0x00, 0x4e, 0x96, 0xdf, 0xc0, 0xc4, 0xf9, 0xe2, 0x29, 0x66, 0x09, 0x4b, 0x67, 0x62, 0x24, 0x7e,
-	0x0d, 0xa1, 0xb6, 0x7d, 0xb1, 0x8f, 0xcf, 0x13, 0x96, 0x06, 0x82, 0xc0, 0xd9, 0xf6, 0xa0, 0x8a,
-	0x7d, 0x1c, 0x90, 0xf5, 0xe0, 0xfe, 0xa1, 0x6d, 0x5f, 0x59, 0x13, 0x5f, 0x78, 0x3d, 0x92, 0xf3,
-	0xed, 0x41, 0x39, 0x1f, 0x92, 0x27, 0x5a, 0xad, 0x00, 0x4e, 0xb7, 0xf0, 0x08, 0xa6, 0x65, 0xd5,
-	0xbc, 0x16, 0xe5, 0xcb, 0xe2, 0x8c, 0x5f, 0x42, 0x54, 0xe7, 0xf5, 0x57, 0xf9, 0xdc, 0x7c, 0x54,
-	0xe2, 0x6d, 0xc1, 0x1e, 0x93, 0xcf, 0xdb, 0x5d, 0x67, 0xbe, 0xed, 0x26, 0xdb, 0xca, 0x7e, 0xdd,
-	0xa2, 0xda, 0xc9, 0x4e, 0xd2, 0x77, 0xed, 0x4f, 0xdc, 0x4c, 0xfc, 0x6b, 0xde, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xb5, 0xeb, 0x41, 0xa0, 0x5d, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("metric.proto", fileDescriptor_metric_027f9482acb395ce) }
+
+var fileDescriptor_metric_027f9482acb395ce = []byte{
+	// 275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xcd, 0x4a, 0xf4, 0x30,
+	0x14, 0x86, 0xbf, 0x7c, 0xb5, 0xad, 0x9c, 0x0e, 0xe3, 0x18, 0x44, 0x82, 0x0b, 0x29, 0xb3, 0xb1,
+	0xcc, 0xa2, 0x03, 0x75, 0xe5, 0x56, 0x14, 0x2d, 0x62, 0x5b, 0x42, 0x41, 0x70, 0x23, 0x9d, 0xf1,
+	0x30, 0x76, 0xd1, 0x1f, 0x93, 0xb4, 0x30, 0x37, 0xe6, 0xf5, 0x49, 0x92, 0x3a, 0xb3, 0x4a, 0x9e,
+	0xe7, 0x3d, 0x6f, 0x16, 0x27, 0x30, 0x6b, 0x50, 0x89, 0x7a, 0x1b, 0xf7, 0xa2, 0x53, 0x1d, 0x75,
+	0xd5, 0xbe, 0x47, 0xb9, 0xbc, 0x83, 0xf9, 0xab, 0xd1, 0x92, 0xe3, 0xf7, 0x80, 0x52, 0xd1, 0x1b,
+	0xb0, 0x11, 0x23, 0xa1, 0x13, 0xcd, 0x93, 0xf3, 0xd8, 0x50, 0x6c, 0xa7, 0xca, 0x7d, 0x8f, 0x7c,
+	0xaa, 0x26, 0xe0, 0x4f, 0x55, 0xdd, 0xe9, 0x11, 0x85, 0xed, 0x04, 0x87, 0x4e, 0x81, 0x28, 0xec,
+	0x08, 0xb7, 0xf9, 0xf2, 0x87, 0x00, 0x1c, 0x2d, 0xbd, 0x04, 0x4f, 0xfb, 0xf4, 0x81, 0x91, 0x90,
+	0x44, 0x33, 0x3e, 0x11, 0xbd, 0x00, 0x57, 0x0e, 0x4d, 0xda, 0xb2, 0xff, 0x21, 0x89, 0x1c, 0x6e,
+	0x41, 0xdb, 0x6a, 0x14, 0x69, 0xcb, 0x1c, 0x6b, 0x0d, 0xe8, 0x37, 0xe4, 0xd0, 0xe4, 0x83, 0x62,
+	0x27, 0x46, 0x4f, 0xa4, 0x7d, 0x35, 0x0a, 0xed, 0x5d, 0xeb, 0x2d, 0x51, 0x06, 0xbe, 0xac, 0x46,
+	0xfc, 0x4c, 0x5b, 0xe6, 0x99, 0xe0, 0x0f, 0xe9, 0x15, 0x9c, 0x9a, 0xab, 0xee, 0xf8, 0x26, 0x3a,
+	0xf0, 0x6a, 0x05, 0x70, 0xdc, 0x00, 0x0d, 0xc0, 0xcf, 0xf2, 0xf2, 0x39, 0xcd, 0x9e, 0x16, 0xff,
+	0xe8, 0x19, 0x04, 0x45, 0x52, 0x7c, 0x64, 0x8f, 0xe5, 0x5b, 0xce, 0x5f, 0x16, 0xe4, 0x3e, 0x7c,
+	0xbf, 0xde, 0xd5, 0xea, 0x6b, 0xd8, 0xc4, 0xdb, 0xae, 0x59, 0x57, 0x28, 0x76, 0x5d, 0xdd, 0xd9,
+	0x73, 0x6d, 0x16, 0xb3, 0xf1, 0xcc, 0x1f, 0xdc, 0xfe, 0x0e, 0x00, 0x44, 0x40, 0xb4, 0xcc, 0x93,
+	0x01, 0x00, 0x00,
 }
@@ -110,7 +110,7 @@ func (x ResultStatus) String() string {
 	return proto.EnumName(ResultStatus_name, int32(x))
 }
 func (ResultStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{0}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{0}
 }
 
 // MessageData has datas shared between all app protocols
@@ -142,7 +142,7 @@ func (m *MsgHeader) Reset()         { *m = MsgHeader{} }
 func (m *MsgHeader) String() string { return proto.CompactTextString(m) }
 func (*MsgHeader) ProtoMessage()    {}
 func (*MsgHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{0}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{0}
 }
 func (m *MsgHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgHeader.Unmarshal(m, b)
@@ -237,7 +237,7 @@ func (m *P2PMessage) Reset()         { *m = P2PMessage{} }
 func (m *P2PMessage) String() string { return proto.CompactTextString(m) }
 func (*P2PMessage) ProtoMessage()    {}
 func (*P2PMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{1}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{1}
 }
 func (m *P2PMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PMessage.Unmarshal(m, b)
@@ -284,7 +284,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{2}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{2}
 }
 func (m *Ping) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ping.Unmarshal(m, b)
@@ -332,7 +332,7 @@ func (m *Pong) Reset()         { *m = Pong{} }
 func (m *Pong) String() string { return proto.CompactTextString(m) }
 func (*Pong) ProtoMessage()    {}
 func (*Pong) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{3}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{3}
 }
 func (m *Pong) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pong.Unmarshal(m, b)
@@ -373,8 +373,13 @@ type Status struct {
 	BestHeight    uint64       `protobuf:"varint,3,opt,name=bestHeight,proto3" json:"bestHeight,omitempty"`
 	ChainID       []byte       `protobuf:"bytes,4,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	// noExpose means that peer doesn't want to be known to other peers.
-	NoExpose             bool     `protobuf:"varint,5,opt,name=noExpose,proto3" json:"noExpose,omitempty"`
-	Version              string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	NoExpose bool   `protobuf:"varint,5,opt,name=noExpose,proto3" json:"noExpose,omitempty"`
+	Version  string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// compressions is the list of payload compression algorithms which the sender can read, in order of preference.
+	Compressions []string `protobuf:"bytes,7,rep,name=compressions,proto3" json:"compressions,omitempty"`
+	// forkHash and forkNext are the fork id of sender, which identifies the forks activated and the next one.
+	ForkHash             uint32   `protobuf:"varint,8,opt,name=forkHash,proto3" json:"forkHash,omitempty"`
+	ForkNext             uint64   `protobuf:"varint,9,opt,name=forkNext,proto3" json:"forkNext,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -384,7 +389,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{4}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{4}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Status.Unmarshal(m, b)
@@ -446,6 +451,27 @@ func (m *Status) GetVersion() string {
 	return ""
 }
 
+func (m *Status) GetCompressions() []string {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
+func (m *Status) GetForkHash() uint32 {
+	if m != nil {
+		return m.ForkHash
+	}
+	return 0
+}
+
+func (m *Status) GetForkNext() uint64 {
+	if m != nil {
+		return m.ForkNext
+	}
+	return 0
+}
+
 type GoAwayNotice struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -457,7 +483,7 @@ func (m *GoAwayNotice) Reset()         { *m = GoAwayNotice{} }
 func (m *GoAwayNotice) String() string { return proto.CompactTextString(m) }
 func (*GoAwayNotice) ProtoMessage()    {}
 func (*GoAwayNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{5}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{5}
 }
 func (m *GoAwayNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GoAwayNotice.Unmarshal(m, b)
@@ -496,7 +522,7 @@ func (m *AddressesRequest) Reset()         { *m = AddressesRequest{} }
 func (m *AddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressesRequest) ProtoMessage()    {}
 func (*AddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{6}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{6}
 }
 func (m *AddressesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressesRequest.Unmarshal(m, b)
@@ -542,7 +568,7 @@ func (m *AddressesResponse) Reset()         { *m = AddressesResponse{} }
 func (m *AddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressesResponse) ProtoMessage()    {}
 func (*AddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{7}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{7}
 }
 func (m *AddressesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressesResponse.Unmarshal(m, b)
@@ -588,7 +614,7 @@ func (m *NewBlockNotice) Reset()         { *m = NewBlockNotice{} }
 func (m *NewBlockNotice) String() string { return proto.CompactTextString(m) }
 func (*NewBlockNotice) ProtoMessage()    {}
 func (*NewBlockNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{8}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{8}
 }
 func (m *NewBlockNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewBlockNotice.Unmarshal(m, b)
@@ -635,7 +661,7 @@ func (m *BlockProducedNotice) Reset()         { *m = BlockProducedNotice{} }
 func (m *BlockProducedNotice) String() string { return proto.CompactTextString(m) }
 func (*BlockProducedNotice) ProtoMessage()    {}
 func (*BlockProducedNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{9}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{9}
 }
 func (m *BlockProducedNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockProducedNotice.Unmarshal(m, b)
@@ -695,7 +721,7 @@ func (m *GetBlockHeadersRequest) Reset()         { *m = GetBlockHeadersRequest{}
 func (m *GetBlockHeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeadersRequest) ProtoMessage()    {}
 func (*GetBlockHeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{10}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{10}
 }
 func (m *GetBlockHeadersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockHeadersRequest.Unmarshal(m, b)
@@ -765,7 +791,7 @@ func (m *GetBlockHeadersResponse) Reset()         { *m = GetBlockHeadersResponse
 func (m *GetBlockHeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeadersResponse) ProtoMessage()    {}
 func (*GetBlockHeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{11}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{11}
 }
 func (m *GetBlockHeadersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockHeadersResponse.Unmarshal(m, b)
@@ -825,7 +851,7 @@ func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
 func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockRequest) ProtoMessage()    {}
 func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{12}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{12}
 }
 func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockRequest.Unmarshal(m, b)
@@ -866,7 +892,7 @@ func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
 func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockResponse) ProtoMessage()    {}
 func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{13}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{13}
 }
 func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockResponse.Unmarshal(m, b)
@@ -918,7 +944,7 @@ func (m *NewTransactionsNotice) Reset()         { *m = NewTransactionsNotice{} }
 func (m *NewTransactionsNotice) String() string { return proto.CompactTextString(m) }
 func (*NewTransactionsNotice) ProtoMessage()    {}
 func (*NewTransactionsNotice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{14}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{14}
 }
 func (m *NewTransactionsNotice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewTransactionsNotice.Unmarshal(m, b)
@@ -956,7 +982,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{15}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{15}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -997,7 +1023,7 @@ func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse
 func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsResponse) ProtoMessage()    {}
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{16}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{16}
 }
 func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsResponse.Unmarshal(m, b)
@@ -1060,7 +1086,7 @@ func (m *GetMissingRequest) Reset()         { *m = GetMissingRequest{} }
 func (m *GetMissingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMissingRequest) ProtoMessage()    {}
 func (*GetMissingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{17}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{17}
 }
 func (m *GetMissingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMissingRequest.Unmarshal(m, b)
@@ -1106,7 +1132,7 @@ func (m *GetAncestorRequest) Reset()         { *m = GetAncestorRequest{} }
 func (m *GetAncestorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAncestorRequest) ProtoMessage()    {}
 func (*GetAncestorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{18}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{18}
 }
 func (m *GetAncestorRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAncestorRequest.Unmarshal(m, b)
@@ -1146,7 +1172,7 @@ func (m *GetAncestorResponse) Reset()         { *m = GetAncestorResponse{} }
 func (m *GetAncestorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAncestorResponse) ProtoMessage()    {}
 func (*GetAncestorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{19}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{19}
 }
 func (m *GetAncestorResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAncestorResponse.Unmarshal(m, b)
@@ -1198,7 +1224,7 @@ func (m *GetHashByNo) Reset()         { *m = GetHashByNo{} }
 func (m *GetHashByNo) String() string { return proto.CompactTextString(m) }
 func (*GetHashByNo) ProtoMessage()    {}
 func (*GetHashByNo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{20}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{20}
 }
 func (m *GetHashByNo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashByNo.Unmarshal(m, b)
@@ -1237,7 +1263,7 @@ func (m *GetHashByNoResponse) Reset()         { *m = GetHashByNoResponse{} }
 func (m *GetHashByNoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHashByNoResponse) ProtoMessage()    {}
 func (*GetHashByNoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{21}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{21}
 }
 func (m *GetHashByNoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashByNoResponse.Unmarshal(m, b)
@@ -1288,7 +1314,7 @@ func (m *GetHashesRequest) Reset()         { *m = GetHashesRequest{} }
 func (m *GetHashesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHashesRequest) ProtoMessage()    {}
 func (*GetHashesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{22}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{22}
 }
 func (m *GetHashesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashesRequest.Unmarshal(m, b)
@@ -1343,7 +1369,7 @@ func (m *GetHashesResponse) Reset()         { *m = GetHashesResponse{} }
 func (m *GetHashesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHashesResponse) ProtoMessage()    {}
 func (*GetHashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_p2p_5b2ea3fe81ddec74, []int{23}
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{23}
 }
 func (m *GetHashesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHashesResponse.Unmarshal(m, b)
@@ -1384,6 +1410,320 @@ func (m *GetHashesResponse) GetHasNext() bool {
 	return false
 }
 
+// GetStateChunkRequest asks the entries of state trie of root, whose keys are in [start, end). end is unbounded if empty.
+type GetStateChunkRequest struct {
+	Root  []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Start []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   []byte `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// accounts is true if the trie is of account states, then code of contract is attached to entry
+	Accounts             bool     `protobuf:"varint,4,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateChunkRequest) Reset()         { *m = GetStateChunkRequest{} }
+func (m *GetStateChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateChunkRequest) ProtoMessage()    {}
+func (*GetStateChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{24}
+}
+func (m *GetStateChunkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateChunkRequest.Unmarshal(m, b)
+}
+func (m *GetStateChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateChunkRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStateChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateChunkRequest.Merge(dst, src)
+}
+func (m *GetStateChunkRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateChunkRequest.Size(m)
+}
+func (m *GetStateChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateChunkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateChunkRequest proto.InternalMessageInfo
+
+func (m *GetStateChunkRequest) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *GetStateChunkRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *GetStateChunkRequest) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *GetStateChunkRequest) GetAccounts() bool {
+	if m != nil {
+		return m.Accounts
+	}
+	return false
+}
+
+// StateChunkEntry is a trie entry with the stored data of value hash, and its compressed merkle proof. code is set if data is an account state of contract.
+type StateChunkEntry struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Bitmap               []byte   `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	AuditPath            [][]byte `protobuf:"bytes,4,rep,name=auditPath,proto3" json:"auditPath,omitempty"`
+	Length               uint32   `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Code                 []byte   `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateChunkEntry) Reset()         { *m = StateChunkEntry{} }
+func (m *StateChunkEntry) String() string { return proto.CompactTextString(m) }
+func (*StateChunkEntry) ProtoMessage()    {}
+func (*StateChunkEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{25}
+}
+func (m *StateChunkEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateChunkEntry.Unmarshal(m, b)
+}
+func (m *StateChunkEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateChunkEntry.Marshal(b, m, deterministic)
+}
+func (dst *StateChunkEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChunkEntry.Merge(dst, src)
+}
+func (m *StateChunkEntry) XXX_Size() int {
+	return xxx_messageInfo_StateChunkEntry.Size(m)
+}
+func (m *StateChunkEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChunkEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChunkEntry proto.InternalMessageInfo
+
+func (m *StateChunkEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateChunkEntry) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *StateChunkEntry) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *StateChunkEntry) GetAuditPath() [][]byte {
+	if m != nil {
+		return m.AuditPath
+	}
+	return nil
+}
+
+func (m *StateChunkEntry) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *StateChunkEntry) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+type GetStateChunkResponse struct {
+	Status               ResultStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Entries              []*StateChunkEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	HasNext              bool               `protobuf:"varint,3,opt,name=hasNext,proto3" json:"hasNext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetStateChunkResponse) Reset()         { *m = GetStateChunkResponse{} }
+func (m *GetStateChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateChunkResponse) ProtoMessage()    {}
+func (*GetStateChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{26}
+}
+func (m *GetStateChunkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateChunkResponse.Unmarshal(m, b)
+}
+func (m *GetStateChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateChunkResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetStateChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateChunkResponse.Merge(dst, src)
+}
+func (m *GetStateChunkResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateChunkResponse.Size(m)
+}
+func (m *GetStateChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateChunkResponse proto.InternalMessageInfo
+
+func (m *GetStateChunkResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetStateChunkResponse) GetEntries() []*StateChunkEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *GetStateChunkResponse) GetHasNext() bool {
+	if m != nil {
+		return m.HasNext
+	}
+	return false
+}
+
+// GetStateSQLRequest asks a part of sql database file of contract. version is zero in the first request, and the version in response should be used for the rest of file.
+type GetStateSQLRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              uint64   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Offset               uint64   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateSQLRequest) Reset()         { *m = GetStateSQLRequest{} }
+func (m *GetStateSQLRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateSQLRequest) ProtoMessage()    {}
+func (*GetStateSQLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{27}
+}
+func (m *GetStateSQLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateSQLRequest.Unmarshal(m, b)
+}
+func (m *GetStateSQLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateSQLRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStateSQLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateSQLRequest.Merge(dst, src)
+}
+func (m *GetStateSQLRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateSQLRequest.Size(m)
+}
+func (m *GetStateSQLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateSQLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateSQLRequest proto.InternalMessageInfo
+
+func (m *GetStateSQLRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetStateSQLRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetStateSQLRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type GetStateSQLResponse struct {
+	Status               ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Version              uint64       `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Data                 []byte       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Size                 uint64       `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetStateSQLResponse) Reset()         { *m = GetStateSQLResponse{} }
+func (m *GetStateSQLResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateSQLResponse) ProtoMessage()    {}
+func (*GetStateSQLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_p2p_2f394d9e3bd1b970, []int{28}
+}
+func (m *GetStateSQLResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateSQLResponse.Unmarshal(m, b)
+}
+func (m *GetStateSQLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateSQLResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetStateSQLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateSQLResponse.Merge(dst, src)
+}
+func (m *GetStateSQLResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateSQLResponse.Size(m)
+}
+func (m *GetStateSQLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateSQLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateSQLResponse proto.InternalMessageInfo
+
+func (m *GetStateSQLResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetStateSQLResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetStateSQLResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetStateSQLResponse) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgHeader)(nil), "types.MsgHeader")
 	proto.RegisterType((*P2PMessage)(nil), "types.P2PMessage")
@@ -1409,87 +1749,106 @@ func init() {
 	proto.RegisterType((*GetHashByNoResponse)(nil), "types.GetHashByNoResponse")
 	proto.RegisterType((*GetHashesRequest)(nil), "types.GetHashesRequest")
 	proto.RegisterType((*GetHashesResponse)(nil), "types.GetHashesResponse")
+	proto.RegisterType((*GetStateChunkRequest)(nil), "types.GetStateChunkRequest")
+	proto.RegisterType((*StateChunkEntry)(nil), "types.StateChunkEntry")
+	proto.RegisterType((*GetStateChunkResponse)(nil), "types.GetStateChunkResponse")
+	proto.RegisterType((*GetStateSQLRequest)(nil), "types.GetStateSQLRequest")
+	proto.RegisterType((*GetStateSQLResponse)(nil), "types.GetStateSQLResponse")
 	proto.RegisterEnum("types.ResultStatus", ResultStatus_name, ResultStatus_value)
 }
 
-func init() { proto.RegisterFile("p2p.proto", fileDescriptor_p2p_5b2ea3fe81ddec74) }
-
-var fileDescriptor_p2p_5b2ea3fe81ddec74 = []byte{
-	// 1203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x72, 0xda, 0x46,
-	0x14, 0xae, 0x00, 0x63, 0x38, 0x80, 0x2d, 0xaf, 0x9b, 0x84, 0x71, 0x33, 0x2e, 0xa3, 0xc9, 0xb4,
-	0x34, 0xcd, 0x38, 0x1d, 0xe7, 0x09, 0x64, 0x6b, 0x03, 0x6a, 0xf0, 0x8a, 0x59, 0x20, 0x4d, 0x7b,
-	0x43, 0x05, 0x6c, 0x40, 0xad, 0x2d, 0xa9, 0xda, 0x25, 0xc1, 0xb9, 0xe9, 0x4c, 0x2f, 0xfa, 0x06,
-	0x7d, 0x85, 0x3e, 0x46, 0x1f, 0xa0, 0xef, 0xd4, 0x99, 0xce, 0xae, 0x56, 0x20, 0xf2, 0x53, 0x4f,
-	0x3d, 0xb9, 0x62, 0xcf, 0xb7, 0x67, 0xbf, 0x3d, 0x3f, 0xdf, 0x1e, 0x04, 0xd5, 0xf8, 0x34, 0x3e,
-	0x89, 0x93, 0x48, 0x44, 0x68, 0x47, 0x5c, 0xc7, 0x8c, 0x1f, 0x99, 0x93, 0xcb, 0x68, 0xfa, 0xf3,
-	0x74, 0xe1, 0x07, 0x61, 0xba, 0x71, 0x04, 0x61, 0x34, 0x63, 0xe9, 0xda, 0xfa, 0xc7, 0x80, 0xea,
-	0x05, 0x9f, 0x77, 0x99, 0x3f, 0x63, 0x09, 0x7a, 0x00, 0x8d, 0xe9, 0x65, 0xc0, 0x42, 0xf1, 0x9c,
-	0x25, 0x3c, 0x88, 0xc2, 0xa6, 0xd1, 0x32, 0xda, 0x55, 0xba, 0x0d, 0xa2, 0xfb, 0x50, 0x15, 0xc1,
-	0x15, 0xe3, 0xc2, 0xbf, 0x8a, 0x9b, 0x85, 0x96, 0xd1, 0x2e, 0xd2, 0x0d, 0x80, 0xf6, 0xa0, 0x10,
-	0xcc, 0x9a, 0x45, 0x75, 0xb0, 0x10, 0xcc, 0xd0, 0x5d, 0x28, 0xcf, 0x23, 0xce, 0x83, 0xb8, 0x59,
-	0x6a, 0x19, 0xed, 0x0a, 0xd5, 0x96, 0xc4, 0x63, 0xc6, 0x12, 0xd7, 0x69, 0xee, 0xb4, 0x8c, 0x76,
-	0x9d, 0x6a, 0x0b, 0x1d, 0x83, 0x8a, 0xaf, 0xbf, 0x9c, 0x3c, 0x63, 0xd7, 0xcd, 0xb2, 0xda, 0xcb,
-	0x21, 0x08, 0x41, 0x89, 0x07, 0xf3, 0xb0, 0xb9, 0xab, 0x76, 0xd4, 0x1a, 0xb5, 0xa0, 0xc6, 0x97,
-	0x13, 0x95, 0xd1, 0x34, 0xba, 0x6c, 0x56, 0x5a, 0x46, 0xbb, 0x41, 0xf3, 0x90, 0xbc, 0xed, 0x92,
-	0x85, 0x73, 0xb1, 0x68, 0x56, 0xd5, 0xa6, 0xb6, 0xac, 0x6f, 0x01, 0xfa, 0xa7, 0xfd, 0x0b, 0xc6,
-	0xb9, 0x3f, 0x67, 0xa8, 0x0d, 0xe5, 0x85, 0xaa, 0x84, 0x4a, 0xbc, 0x76, 0x6a, 0x9e, 0xa8, 0x1a,
-	0x9e, 0xac, 0x2b, 0x44, 0xf5, 0xbe, 0x8c, 0x62, 0xe6, 0x0b, 0x5f, 0xa5, 0x5f, 0xa7, 0x6a, 0x6d,
-	0x79, 0x50, 0xea, 0x07, 0xe1, 0x1c, 0x7d, 0x01, 0xfb, 0x13, 0xc6, 0xc5, 0x58, 0x15, 0x7e, 0xbc,
-	0xf0, 0xf9, 0x42, 0xd1, 0xd5, 0x69, 0x43, 0xc2, 0x67, 0x12, 0xed, 0xfa, 0x7c, 0x81, 0x3e, 0x87,
-	0x9a, 0xf2, 0x5b, 0xb0, 0x60, 0xbe, 0x10, 0x8a, 0xaa, 0x44, 0x41, 0x42, 0x5d, 0x85, 0x58, 0x3d,
-	0x28, 0xf5, 0xa3, 0x70, 0x2e, 0xdb, 0xb2, 0x75, 0xf2, 0xfd, 0x74, 0xc7, 0x90, 0x3b, 0xfb, 0x1e,
-	0xb6, 0xbf, 0x0d, 0x28, 0x0f, 0x84, 0x2f, 0x96, 0x1c, 0x3d, 0x84, 0x32, 0x67, 0xe1, 0x26, 0x4f,
-	0xa4, 0xf3, 0xec, 0x33, 0x96, 0xd8, 0xb3, 0x59, 0xc2, 0x38, 0xa7, 0xda, 0xe3, 0xdd, 0xcb, 0x0b,
-	0x37, 0x5f, 0x5e, 0x7c, 0xfb, 0x72, 0xd4, 0x84, 0x5d, 0x25, 0x41, 0xd7, 0x51, 0x32, 0xa8, 0xd3,
-	0xcc, 0x44, 0x47, 0x50, 0x09, 0x23, 0xbc, 0x8a, 0x23, 0xce, 0x94, 0x12, 0x2a, 0x74, 0x6d, 0xcb,
-	0x53, 0xaf, 0xb4, 0x12, 0xcb, 0x4a, 0x50, 0x99, 0x69, 0xb5, 0xa1, 0xde, 0x89, 0xec, 0xd7, 0xfe,
-	0x35, 0x89, 0x44, 0x30, 0x55, 0x9e, 0x57, 0x69, 0x13, 0xb5, 0x66, 0x33, 0xd3, 0x7a, 0x01, 0xa6,
-	0x4e, 0x89, 0x71, 0xca, 0x7e, 0x59, 0x32, 0x2e, 0xfe, 0x57, 0xfe, 0x92, 0xd9, 0x5f, 0x0d, 0x82,
-	0x37, 0x4c, 0x65, 0xde, 0xa0, 0x99, 0x69, 0xfd, 0x04, 0x07, 0x39, 0x66, 0x1e, 0x47, 0x21, 0x67,
-	0xe8, 0x6b, 0x28, 0x73, 0x55, 0x64, 0x45, 0xbd, 0x77, 0x7a, 0xa8, 0xa9, 0x29, 0xe3, 0xcb, 0x4b,
-	0x91, 0xd6, 0x9f, 0x6a, 0x17, 0xd4, 0x86, 0x1d, 0xa9, 0x7a, 0xde, 0x2c, 0xb4, 0x8a, 0x1f, 0x08,
-	0x23, 0x75, 0xb0, 0xba, 0xb0, 0x47, 0xd8, 0x6b, 0x55, 0x6f, 0x9d, 0xf1, 0x7d, 0xa8, 0x4e, 0xde,
-	0x12, 0xc4, 0x06, 0x90, 0x51, 0x4f, 0x52, 0x67, 0xad, 0x84, 0xcc, 0xb4, 0x38, 0x1c, 0x2a, 0x9a,
-	0x7e, 0x12, 0xcd, 0x96, 0x53, 0x36, 0xd3, 0x74, 0xc7, 0x00, 0x71, 0x8a, 0xc8, 0x27, 0x99, 0xf2,
-	0xe5, 0x90, 0x0f, 0x13, 0x22, 0x0b, 0x76, 0xd4, 0x52, 0x75, 0xbd, 0x76, 0x5a, 0xd7, 0x49, 0xa8,
-	0x4b, 0x68, 0xba, 0x65, 0xfd, 0x66, 0xc0, 0xdd, 0x0e, 0xd3, 0x7a, 0x51, 0x2f, 0x68, 0xdd, 0x0b,
-	0x04, 0xa5, 0xdc, 0x13, 0x51, 0x6b, 0xf9, 0x5a, 0xb7, 0x1e, 0x85, 0xb6, 0x24, 0x1e, 0xbd, 0x7c,
-	0xc9, 0x59, 0xa6, 0x30, 0x6d, 0xa5, 0x33, 0xe1, 0x0d, 0x53, 0xd2, 0x6a, 0x50, 0xb5, 0x46, 0x26,
-	0x14, 0x7d, 0x3e, 0xd5, 0x92, 0x92, 0x4b, 0xeb, 0x4f, 0x03, 0xee, 0xbd, 0x13, 0xc4, 0x6d, 0xda,
-	0x26, 0xc3, 0xf3, 0xf9, 0x82, 0xa5, 0x7d, 0xab, 0x53, 0x6d, 0xa1, 0x47, 0xb0, 0x9b, 0x8e, 0x07,
-	0xde, 0x2c, 0x6e, 0x35, 0x34, 0x77, 0x25, 0xcd, 0x5c, 0x64, 0x45, 0x17, 0x3e, 0x27, 0x6c, 0x25,
-	0xf4, 0x64, 0xcc, 0x4c, 0xeb, 0x2b, 0xd8, 0xcf, 0xe2, 0xcc, 0xaa, 0xb4, 0xb9, 0xd2, 0xc8, 0x5f,
-	0x69, 0xfd, 0x0a, 0xe6, 0xc6, 0xf5, 0x36, 0xb9, 0x3c, 0x80, 0xb2, 0x6a, 0x51, 0xa6, 0xc1, 0xed,
-	0xf6, 0xe9, 0xbd, 0x7c, 0xac, 0xc5, 0xed, 0x58, 0x9f, 0xc0, 0x1d, 0xc2, 0x5e, 0x0f, 0x13, 0x3f,
-	0xe4, 0xfe, 0x54, 0x04, 0x51, 0xc8, 0xb5, 0xa0, 0x8e, 0xa0, 0x22, 0x56, 0xdd, 0x7c, 0xcc, 0x6b,
-	0xdb, 0xfa, 0x46, 0xa9, 0x21, 0x7f, 0xe8, 0xa6, 0x3c, 0xff, 0x48, 0x7b, 0xb7, 0x7d, 0xe4, 0x63,
-	0xf6, 0xee, 0x33, 0x28, 0x8a, 0x55, 0xd6, 0xb7, 0xaa, 0x66, 0x18, 0xae, 0xa8, 0x44, 0xff, 0xa3,
-	0x55, 0x1d, 0x38, 0xe8, 0x30, 0x71, 0x11, 0x70, 0x1e, 0x84, 0xf3, 0x1b, 0x92, 0x90, 0x25, 0xe1,
-	0x22, 0x8a, 0x17, 0x9b, 0x29, 0xba, 0xb6, 0xad, 0x47, 0x80, 0x3a, 0x4c, 0xd8, 0xe1, 0x94, 0x71,
-	0x11, 0x25, 0x37, 0x95, 0xe3, 0x77, 0x03, 0x0e, 0xb7, 0xdc, 0x6f, 0x53, 0x0a, 0x0b, 0xea, 0xbe,
-	0x26, 0xc8, 0x0d, 0xf6, 0x2d, 0x4c, 0x8e, 0x85, 0xcc, 0x26, 0x51, 0x36, 0xd7, 0x37, 0x88, 0xf5,
-	0x25, 0xd4, 0x3a, 0x4c, 0x48, 0xd7, 0xb3, 0x6b, 0x12, 0xe5, 0xa7, 0x84, 0xb1, 0x3d, 0x76, 0x7e,
-	0x54, 0x01, 0x67, 0x8e, 0xb7, 0x0b, 0x78, 0x6b, 0xe4, 0x15, 0xde, 0x1a, 0x79, 0xd6, 0x44, 0x3d,
-	0x85, 0x54, 0x61, 0x59, 0xfd, 0x8e, 0xa0, 0x12, 0x27, 0xec, 0x55, 0x6e, 0x46, 0xae, 0xed, 0x74,
-	0xe2, 0xb1, 0x57, 0x64, 0x79, 0x35, 0x61, 0x49, 0xf6, 0x7f, 0xb9, 0x41, 0xd6, 0x43, 0x25, 0x4d,
-	0x5a, 0xad, 0xad, 0x44, 0xb5, 0x3b, 0xbb, 0xe3, 0x63, 0xea, 0xef, 0x83, 0x2f, 0xec, 0xe1, 0x5f,
-	0x05, 0xa8, 0xe7, 0xa9, 0x50, 0x19, 0x0a, 0xde, 0x33, 0xf3, 0x13, 0x54, 0x87, 0xca, 0xb9, 0x4d,
-	0xce, 0x71, 0x0f, 0x3b, 0xa6, 0x81, 0x6a, 0xb0, 0x3b, 0x22, 0xcf, 0x88, 0xf7, 0x1d, 0x31, 0x0b,
-	0xe8, 0x53, 0x30, 0x5d, 0xf2, 0xdc, 0xee, 0xb9, 0xce, 0xd8, 0xa6, 0x9d, 0xd1, 0x05, 0x26, 0x43,
-	0xb3, 0x88, 0xee, 0xc0, 0x81, 0x83, 0x6d, 0xa7, 0xe7, 0x12, 0x3c, 0xc6, 0x2f, 0xce, 0x31, 0x76,
-	0xb0, 0x63, 0x96, 0x50, 0x03, 0xaa, 0xc4, 0x1b, 0x8e, 0x9f, 0x7a, 0x23, 0xe2, 0x98, 0x3b, 0x08,
-	0xc1, 0x9e, 0xdd, 0xa3, 0xd8, 0x76, 0xbe, 0x1f, 0xe3, 0x17, 0xee, 0x60, 0x38, 0x30, 0xcb, 0xf2,
-	0x64, 0x1f, 0xd3, 0x0b, 0x77, 0x30, 0x70, 0x3d, 0x32, 0x76, 0x30, 0x71, 0xb1, 0x63, 0xee, 0xa2,
-	0xbb, 0x80, 0x28, 0x1e, 0x78, 0x23, 0x7a, 0x2e, 0x09, 0xbb, 0xf6, 0x68, 0x30, 0xc4, 0x8e, 0x59,
-	0x41, 0xf7, 0xe0, 0xf0, 0xa9, 0xed, 0xf6, 0xb0, 0x33, 0xee, 0x53, 0x7c, 0xee, 0x11, 0xc7, 0x1d,
-	0xba, 0x1e, 0x31, 0xab, 0x32, 0x48, 0xfb, 0xcc, 0xa3, 0xd2, 0x0b, 0x90, 0x09, 0x75, 0x6f, 0x34,
-	0x1c, 0x7b, 0x4f, 0xc7, 0xd4, 0x26, 0x1d, 0x6c, 0xd6, 0xd0, 0x01, 0x34, 0x46, 0xc4, 0xbd, 0xe8,
-	0xf7, 0xb0, 0x8c, 0x18, 0x3b, 0x66, 0x5d, 0x26, 0xe9, 0x92, 0x21, 0xa6, 0xc4, 0xee, 0x99, 0x0d,
-	0xb4, 0x0f, 0xb5, 0x11, 0xb1, 0x9f, 0xdb, 0x6e, 0xcf, 0x3e, 0xeb, 0x61, 0x73, 0x4f, 0xc6, 0xee,
-	0xd8, 0x43, 0x7b, 0xdc, 0xf3, 0x06, 0x03, 0x73, 0x1f, 0x1d, 0xc2, 0xfe, 0x88, 0xd8, 0xa3, 0x61,
-	0x17, 0x93, 0xa1, 0x7b, 0x6e, 0x4b, 0x0a, 0xf3, 0xac, 0xf5, 0xc3, 0xf1, 0x3c, 0x10, 0x8b, 0xe5,
-	0xe4, 0x64, 0x1a, 0x5d, 0x3d, 0xf6, 0x59, 0x32, 0x8f, 0x82, 0x28, 0xfd, 0x7d, 0xac, 0x3a, 0x35,
-	0x29, 0xab, 0xef, 0xc4, 0x27, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x9f, 0x40, 0xe2, 0x3e,
-	0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("p2p.proto", fileDescriptor_p2p_2f394d9e3bd1b970) }
+
+var fileDescriptor_p2p_2f394d9e3bd1b970 = []byte{
+	// 1431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x73, 0xda, 0xc6,
+	0x16, 0xbf, 0x02, 0x8c, 0xe1, 0x00, 0xb6, 0xbc, 0x4e, 0x1c, 0xc6, 0x37, 0xe3, 0xcb, 0x68, 0x32,
+	0xf7, 0x72, 0xd3, 0x8c, 0x93, 0x71, 0x3e, 0x81, 0x6c, 0x29, 0x58, 0x0d, 0x16, 0x74, 0x81, 0x34,
+	0xcd, 0x0b, 0x15, 0x62, 0x83, 0xd4, 0x18, 0xad, 0xaa, 0x5d, 0x12, 0x3b, 0x2f, 0x9d, 0xe9, 0x74,
+	0xfa, 0xdc, 0x97, 0x3e, 0xf4, 0x0b, 0xe4, 0x63, 0xf4, 0x9b, 0x75, 0xa6, 0xb3, 0xab, 0x15, 0x08,
+	0x27, 0x8e, 0xa7, 0x9e, 0x3c, 0x71, 0xce, 0xd9, 0xb3, 0xe7, 0xef, 0xef, 0x1c, 0x2d, 0x50, 0x8d,
+	0x8f, 0xe2, 0xc3, 0x38, 0xa1, 0x9c, 0xa2, 0x0d, 0x7e, 0x19, 0x13, 0xb6, 0xaf, 0x4f, 0xce, 0xa9,
+	0xff, 0xc6, 0x0f, 0xbc, 0x30, 0x4a, 0x0f, 0xf6, 0x21, 0xa2, 0x53, 0x92, 0xd2, 0xc6, 0x5f, 0x1a,
+	0x54, 0xcf, 0xd8, 0xec, 0x94, 0x78, 0x53, 0x92, 0xa0, 0x07, 0xd0, 0xf0, 0xcf, 0x43, 0x12, 0xf1,
+	0x17, 0x24, 0x61, 0x21, 0x8d, 0x9a, 0x5a, 0x4b, 0x6b, 0x57, 0xf1, 0xba, 0x10, 0xdd, 0x87, 0x2a,
+	0x0f, 0xe7, 0x84, 0x71, 0x6f, 0x1e, 0x37, 0x0b, 0x2d, 0xad, 0x5d, 0xc4, 0x2b, 0x01, 0xda, 0x82,
+	0x42, 0x38, 0x6d, 0x16, 0xe5, 0xc5, 0x42, 0x38, 0x45, 0x7b, 0x50, 0x9e, 0x51, 0xc6, 0xc2, 0xb8,
+	0x59, 0x6a, 0x69, 0xed, 0x0a, 0x56, 0x9c, 0x90, 0xc7, 0x84, 0x24, 0x8e, 0xd5, 0xdc, 0x68, 0x69,
+	0xed, 0x3a, 0x56, 0x1c, 0x3a, 0x00, 0x19, 0x5f, 0x7f, 0x31, 0x79, 0x4e, 0x2e, 0x9b, 0x65, 0x79,
+	0x96, 0x93, 0x20, 0x04, 0x25, 0x16, 0xce, 0xa2, 0xe6, 0xa6, 0x3c, 0x91, 0x34, 0x6a, 0x41, 0x8d,
+	0x2d, 0x26, 0x32, 0x23, 0x9f, 0x9e, 0x37, 0x2b, 0x2d, 0xad, 0xdd, 0xc0, 0x79, 0x91, 0xf0, 0x76,
+	0x4e, 0xa2, 0x19, 0x0f, 0x9a, 0x55, 0x79, 0xa8, 0x38, 0xe3, 0x6b, 0x80, 0xfe, 0x51, 0xff, 0x8c,
+	0x30, 0xe6, 0xcd, 0x08, 0x6a, 0x43, 0x39, 0x90, 0x95, 0x90, 0x89, 0xd7, 0x8e, 0xf4, 0x43, 0x59,
+	0xc3, 0xc3, 0x65, 0x85, 0xb0, 0x3a, 0x17, 0x51, 0x4c, 0x3d, 0xee, 0xc9, 0xf4, 0xeb, 0x58, 0xd2,
+	0x46, 0x0f, 0x4a, 0xfd, 0x30, 0x9a, 0xa1, 0xff, 0xc2, 0xf6, 0x84, 0x30, 0x3e, 0x96, 0x85, 0x1f,
+	0x07, 0x1e, 0x0b, 0xa4, 0xb9, 0x3a, 0x6e, 0x08, 0xf1, 0xb1, 0x90, 0x9e, 0x7a, 0x2c, 0x40, 0xff,
+	0x81, 0x9a, 0xd4, 0x0b, 0x48, 0x38, 0x0b, 0xb8, 0x34, 0x55, 0xc2, 0x20, 0x44, 0xa7, 0x52, 0x62,
+	0x74, 0xa1, 0xd4, 0xa7, 0xd1, 0x4c, 0xb4, 0x65, 0xed, 0xe6, 0xa7, 0xcd, 0x1d, 0x40, 0xee, 0xee,
+	0x27, 0xac, 0x7d, 0x28, 0x40, 0x79, 0xc0, 0x3d, 0xbe, 0x60, 0xe8, 0x21, 0x94, 0x19, 0x89, 0x56,
+	0x79, 0x22, 0x95, 0x67, 0x9f, 0x90, 0xc4, 0x9c, 0x4e, 0x13, 0xc2, 0x18, 0x56, 0x1a, 0x1f, 0x3b,
+	0x2f, 0xdc, 0xec, 0xbc, 0x78, 0xd5, 0x39, 0x6a, 0xc2, 0xa6, 0x84, 0xa0, 0x63, 0x49, 0x18, 0xd4,
+	0x71, 0xc6, 0xa2, 0x7d, 0xa8, 0x44, 0xd4, 0xbe, 0x88, 0x29, 0x23, 0x12, 0x09, 0x15, 0xbc, 0xe4,
+	0xc5, 0xad, 0xb7, 0x0a, 0x89, 0x65, 0x09, 0xa8, 0x8c, 0x45, 0x06, 0xd4, 0x7d, 0x3a, 0x8f, 0x45,
+	0xa4, 0x21, 0x8d, 0x58, 0x73, 0xb3, 0x55, 0x6c, 0x57, 0xf1, 0x9a, 0x4c, 0x58, 0x7e, 0x4d, 0x93,
+	0x34, 0xe8, 0x14, 0x12, 0x4b, 0x3e, 0x3b, 0x73, 0xc9, 0x05, 0x97, 0x88, 0x28, 0xe1, 0x25, 0x6f,
+	0xb4, 0xa1, 0xde, 0xa1, 0xe6, 0x3b, 0xef, 0xd2, 0xa5, 0x3c, 0xf4, 0x65, 0x14, 0xf3, 0x14, 0x20,
+	0x6a, 0x1e, 0x32, 0xd6, 0x78, 0x09, 0xba, 0x2a, 0x17, 0x61, 0x98, 0xfc, 0xb8, 0x20, 0x8c, 0xff,
+	0xa3, 0xda, 0x0a, 0xcb, 0xde, 0xc5, 0x20, 0x7c, 0x4f, 0x64, 0x55, 0x1b, 0x38, 0x63, 0x8d, 0x1f,
+	0x60, 0x27, 0x67, 0x99, 0xc5, 0x34, 0x62, 0x04, 0x7d, 0x05, 0x65, 0x26, 0x1b, 0x28, 0x4d, 0x6f,
+	0x1d, 0xed, 0x2a, 0xd3, 0x98, 0xb0, 0xc5, 0x39, 0x4f, 0x7b, 0x8b, 0x95, 0x0a, 0x6a, 0xc3, 0x86,
+	0x98, 0x28, 0xd6, 0x2c, 0xb4, 0x8a, 0xd7, 0x84, 0x91, 0x2a, 0x18, 0xa7, 0xb0, 0xe5, 0x92, 0x77,
+	0xb2, 0x97, 0x2a, 0xe3, 0xfb, 0x50, 0x9d, 0x5c, 0x01, 0xdb, 0x4a, 0x20, 0xa2, 0x9e, 0xa4, 0xca,
+	0x0a, 0x65, 0x19, 0x6b, 0x30, 0xd8, 0x95, 0x66, 0xfa, 0x09, 0x9d, 0x2e, 0x7c, 0x32, 0x55, 0xe6,
+	0x0e, 0x00, 0xe2, 0x54, 0x22, 0xc6, 0x3d, 0xb5, 0x97, 0x93, 0x5c, 0x6f, 0x10, 0x19, 0xb0, 0x21,
+	0x49, 0x89, 0xa8, 0xda, 0x51, 0x5d, 0x25, 0x21, 0x9d, 0xe0, 0xf4, 0xc8, 0xf8, 0x59, 0x83, 0xbd,
+	0x0e, 0x51, 0x58, 0x94, 0xd3, 0xb9, 0xec, 0x05, 0x82, 0x52, 0x6e, 0xfc, 0x24, 0x2d, 0x36, 0xc1,
+	0xda, 0xc0, 0x29, 0x4e, 0xc8, 0xe9, 0xeb, 0xd7, 0x8c, 0x64, 0xe8, 0x55, 0x5c, 0xba, 0x6f, 0xde,
+	0x13, 0x09, 0xdb, 0x06, 0x96, 0x34, 0xd2, 0xa1, 0xe8, 0x31, 0x5f, 0xc1, 0x55, 0x90, 0xc6, 0x07,
+	0x0d, 0xee, 0x7d, 0x14, 0xc4, 0x6d, 0xda, 0x26, 0xc2, 0xf3, 0x58, 0x40, 0xd2, 0xbe, 0xd5, 0xb1,
+	0xe2, 0xd0, 0x23, 0xd8, 0x4c, 0x57, 0x0f, 0x6b, 0x16, 0xd7, 0x1a, 0x9a, 0x73, 0x89, 0x33, 0x15,
+	0x51, 0xd1, 0xc0, 0x63, 0x12, 0xdd, 0xe9, 0xd6, 0xcd, 0x58, 0xe3, 0xff, 0xb0, 0x9d, 0xc5, 0x99,
+	0x55, 0x69, 0xe5, 0x52, 0xcb, 0xbb, 0x34, 0x7e, 0x02, 0x7d, 0xa5, 0x7a, 0x9b, 0x5c, 0x1e, 0x40,
+	0x59, 0xb6, 0x28, 0xc3, 0xe0, 0x7a, 0xfb, 0xd4, 0x59, 0x3e, 0xd6, 0xe2, 0x7a, 0xac, 0x4f, 0xe1,
+	0xae, 0x4b, 0xde, 0x0d, 0x13, 0x2f, 0x62, 0x9e, 0xcf, 0xc5, 0x4c, 0x2b, 0x40, 0xed, 0x43, 0x85,
+	0x5f, 0x9c, 0xe6, 0x63, 0x5e, 0xf2, 0xc6, 0x13, 0x89, 0x86, 0xfc, 0xa5, 0x9b, 0xf2, 0xfc, 0x3d,
+	0xed, 0xdd, 0xfa, 0x95, 0x2f, 0xd9, 0xbb, 0x7f, 0x43, 0x91, 0x5f, 0x64, 0x7d, 0xab, 0x2a, 0x0b,
+	0xc3, 0x0b, 0x2c, 0xa4, 0x9f, 0x69, 0x55, 0x07, 0x76, 0x3a, 0x84, 0x9f, 0x85, 0x8c, 0x85, 0xd1,
+	0xec, 0x86, 0x24, 0x44, 0x49, 0x18, 0xa7, 0x71, 0xb0, 0xda, 0xd0, 0x4b, 0xde, 0x78, 0x04, 0xa8,
+	0x43, 0xb8, 0x19, 0xf9, 0x84, 0x71, 0x9a, 0xdc, 0x54, 0x8e, 0x5f, 0x35, 0xd8, 0x5d, 0x53, 0xbf,
+	0x4d, 0x29, 0x0c, 0xa8, 0x7b, 0xca, 0x40, 0xee, 0xa3, 0xb1, 0x26, 0x13, 0x6b, 0x21, 0xe3, 0x5d,
+	0x9a, 0x7d, 0x33, 0x56, 0x12, 0xe3, 0x7f, 0x50, 0xeb, 0x10, 0x2e, 0x54, 0x8f, 0x2f, 0x5d, 0x9a,
+	0xdf, 0x12, 0xda, 0xfa, 0xda, 0xf9, 0x1e, 0x76, 0x73, 0x8a, 0xb7, 0x0b, 0x78, 0x6d, 0xe5, 0x15,
+	0xae, 0xac, 0x3c, 0x63, 0x22, 0x47, 0x21, 0x45, 0x58, 0x56, 0xbf, 0x7d, 0xa8, 0xc4, 0x09, 0x79,
+	0x9b, 0xdb, 0x91, 0x4b, 0x3e, 0xdd, 0x78, 0xe4, 0xad, 0xbb, 0x98, 0x4f, 0x48, 0x92, 0x7d, 0x8b,
+	0x57, 0x92, 0xe5, 0x52, 0x49, 0x93, 0x96, 0xb4, 0x91, 0xc8, 0x76, 0x67, 0x3e, 0xbe, 0x24, 0xfe,
+	0xae, 0x9f, 0xb0, 0x08, 0xee, 0x74, 0x88, 0x34, 0x43, 0x4e, 0x82, 0x45, 0xf4, 0x26, 0xb7, 0x38,
+	0x13, 0x4a, 0x79, 0xb6, 0x38, 0x05, 0x8d, 0xee, 0xc0, 0x06, 0xe3, 0x5e, 0xc2, 0x55, 0x75, 0x52,
+	0x46, 0xac, 0x42, 0x12, 0xa5, 0xef, 0xbd, 0x3a, 0x16, 0xa4, 0xa8, 0x8b, 0xe7, 0xfb, 0x74, 0x11,
+	0x71, 0xa6, 0x10, 0xbd, 0xe4, 0x8d, 0x3f, 0x34, 0xd8, 0x5e, 0x79, 0xb3, 0x23, 0x9e, 0x5c, 0x0a,
+	0x0b, 0x6f, 0xc8, 0xa5, 0x72, 0x25, 0xc8, 0x4f, 0x3d, 0xae, 0x44, 0x6e, 0x93, 0x90, 0xcf, 0xbd,
+	0x58, 0xb9, 0x52, 0x9c, 0xe8, 0x9b, 0xb7, 0x98, 0x86, 0xbc, 0xef, 0xf1, 0xa0, 0x59, 0x92, 0x69,
+	0xaf, 0x04, 0xb9, 0x67, 0xdf, 0x46, 0xfe, 0xd9, 0x27, 0x3c, 0xf8, 0x74, 0x4a, 0xd4, 0xf3, 0x52,
+	0xd2, 0xc6, 0x6f, 0x1a, 0xdc, 0xbd, 0x52, 0x8c, 0xdb, 0x34, 0xe1, 0x09, 0x6c, 0x92, 0x88, 0x27,
+	0x21, 0xc9, 0xb6, 0xde, 0x9e, 0xd2, 0xbe, 0x92, 0x37, 0xce, 0xd4, 0x3e, 0xd3, 0x9e, 0x57, 0x72,
+	0x70, 0xe5, 0xc5, 0xc1, 0x37, 0xdd, 0x5c, 0x73, 0x22, 0x6f, 0x9e, 0x3d, 0x46, 0x24, 0x9d, 0x7f,
+	0x29, 0xa9, 0x4f, 0xa8, 0x62, 0xaf, 0xfb, 0xae, 0x19, 0xbf, 0xa4, 0x63, 0xbe, 0x32, 0x7e, 0x9b,
+	0x64, 0xaf, 0x77, 0x9b, 0xf5, 0xb0, 0x98, 0xeb, 0x61, 0xfe, 0x53, 0xaa, 0x50, 0xff, 0xf0, 0xcf,
+	0x02, 0xd4, 0xf3, 0xa6, 0x51, 0x19, 0x0a, 0xbd, 0xe7, 0xfa, 0xbf, 0x50, 0x1d, 0x2a, 0x27, 0xa6,
+	0x7b, 0x62, 0x77, 0x6d, 0x4b, 0xd7, 0x50, 0x0d, 0x36, 0x47, 0xee, 0x73, 0xb7, 0xf7, 0xad, 0xab,
+	0x17, 0xd0, 0x1d, 0xd0, 0x1d, 0xf7, 0x85, 0xd9, 0x75, 0xac, 0xb1, 0x89, 0x3b, 0xa3, 0x33, 0xdb,
+	0x1d, 0xea, 0x45, 0x74, 0x17, 0x76, 0x2c, 0xdb, 0xb4, 0xba, 0x8e, 0x6b, 0x8f, 0xed, 0x97, 0x27,
+	0xb6, 0x6d, 0xd9, 0x96, 0x5e, 0x42, 0x0d, 0xa8, 0xba, 0xbd, 0xe1, 0xf8, 0x59, 0x6f, 0xe4, 0x5a,
+	0xfa, 0x06, 0x42, 0xb0, 0x65, 0x76, 0xb1, 0x6d, 0x5a, 0xdf, 0x8d, 0xed, 0x97, 0xce, 0x60, 0x38,
+	0xd0, 0xcb, 0xe2, 0x66, 0xdf, 0xc6, 0x67, 0xce, 0x60, 0xe0, 0xf4, 0xdc, 0xb1, 0x65, 0xbb, 0x8e,
+	0x6d, 0xe9, 0x9b, 0x68, 0x0f, 0x10, 0xb6, 0x07, 0xbd, 0x11, 0x3e, 0x11, 0x06, 0x4f, 0xcd, 0xd1,
+	0x60, 0x68, 0x5b, 0x7a, 0x05, 0xdd, 0x83, 0xdd, 0x67, 0xa6, 0xd3, 0xb5, 0xad, 0x71, 0x1f, 0xdb,
+	0x27, 0x3d, 0xd7, 0x72, 0x86, 0x4e, 0xcf, 0xd5, 0xab, 0x22, 0x48, 0xf3, 0xb8, 0x87, 0x85, 0x16,
+	0x20, 0x1d, 0xea, 0xbd, 0xd1, 0x70, 0xdc, 0x7b, 0x36, 0xc6, 0xa6, 0xdb, 0xb1, 0xf5, 0x1a, 0xda,
+	0x81, 0xc6, 0xc8, 0x75, 0xce, 0xfa, 0x5d, 0x5b, 0x44, 0x6c, 0x5b, 0x7a, 0x5d, 0x24, 0xe9, 0xb8,
+	0x43, 0x1b, 0xbb, 0x66, 0x57, 0x6f, 0xa0, 0x6d, 0xa8, 0x8d, 0x5c, 0xf3, 0x85, 0xe9, 0x74, 0xcd,
+	0xe3, 0xae, 0xad, 0x6f, 0x89, 0xd8, 0x2d, 0x73, 0x68, 0x8e, 0xbb, 0xbd, 0xc1, 0x40, 0xdf, 0x46,
+	0xbb, 0xb0, 0x3d, 0x72, 0xcd, 0xd1, 0xf0, 0xd4, 0x76, 0x87, 0xce, 0x89, 0x29, 0x4c, 0xe8, 0xc7,
+	0xad, 0x57, 0x07, 0xb3, 0x90, 0x07, 0x8b, 0xc9, 0xa1, 0x4f, 0xe7, 0x8f, 0x3d, 0x92, 0xcc, 0x68,
+	0x48, 0xd3, 0xdf, 0xc7, 0xb2, 0x73, 0x93, 0xb2, 0xfc, 0x17, 0xf4, 0xf4, 0xef, 0x01, 0x00, 0x01,
+	0x01, 0xeb, 0x41, 0x1c, 0x0e, 0x00, 0x00,
 }
@@ -346,6 +346,28 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs == nil {
 		return merkle.CalculateMerkleRoot(nil)
 	}
+	return merkle.CalculateMerkleRoot(rs.merkleEntries())
+}
+
+// MerkleProof returns the audit path of the receipt at index, which can be verified with the
+// ReceiptsRootHash of block header.
+func (rs *Receipts) MerkleProof(index int) [][]byte {
+	if rs == nil || index >= len(rs.receipts) {
+		return nil
+	}
+	return merkle.CalculateMerkleProof(rs.merkleEntries(), index)
+}
+
+// MerkleLeafCount returns the number of leaves of the merkle tree of receipts, which is needed to verify the audit
+// path of a receipt.
+func (rs *Receipts) MerkleLeafCount() int {
+	if rs == nil {
+		return 0
+	}
+	return len(rs.merkleEntries())
+}
+
+func (rs *Receipts) merkleEntries() []merkle.MerkleEntry {
 	rsSize := len(rs.receipts)
 	if rs.bloom != nil {
 		rsSize++
@@ -357,7 +379,44 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs.bloom != nil {
 		mes[rsSize-1] = rs.bloom
 	}
-	return merkle.CalculateMerkleRoot(mes)
+	return mes
+}
+
+// Verify checks that the receipt of proof is included in the block of header.
+func (p *ReceiptProof) Verify() bool {
+	if p.GetReceipt() == nil || p.GetHeader() == nil || p.GetTxIdx() == nil {
+		return false
+	}
+	block := &Block{Header: p.Header}
+	if !bytes.Equal(block.BlockHash(), p.TxIdx.BlockHash) {
+		return false
+	}
+	return merkle.VerifyMerkleProof(p.Header.ReceiptsRootHash, p.Receipt.GetHash(), int(p.TxIdx.Idx), int(p.LeafCount), p.MerklePath)
+}
+
+// VerifyTx checks that proof is valid and proves the receipt of the tx of txHash.
+func (p *ReceiptProof) VerifyTx(txHash []byte) bool {
+	return bytes.Equal(p.GetReceipt().GetTxHash(), txHash) && p.Verify()
+}
+
+// Verify checks that the event of proof is emitted by the receipt included in the block.
+func (p *EventProof) Verify() bool {
+	if p.GetEvent() == nil || !p.GetReceiptProof().Verify() {
+		return false
+	}
+	events := p.ReceiptProof.Receipt.Events
+	idx := int(p.Event.EventIdx)
+	if idx < 0 || idx >= len(events) {
+		return false
+	}
+	ev, expected := p.Event, events[idx]
+	return bytes.Equal(ev.ContractAddress, expected.ContractAddress) && ev.EventName == expected.EventName &&
+		ev.JsonArgs == expected.JsonArgs && ev.EventIdx == expected.EventIdx
+}
+
+// VerifyEvent checks that proof is valid and proves the event at eventIdx of the tx of txHash.
+func (p *EventProof) VerifyEvent(txHash []byte, eventIdx int32) bool {
+	return p.GetEvent().GetEventIdx() == eventIdx && p.GetReceiptProof().VerifyTx(txHash) && p.Verify()
 }
 
 func (rs *Receipts) MarshalBinary() ([]byte, error) {
@@ -64,7 +64,7 @@ func (x CommitStatus) String() string {
 	return proto.EnumName(CommitStatus_name, int32(x))
 }
 func (CommitStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{0}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{0}
 }
 
 type VerifyStatus int32
@@ -90,7 +90,7 @@ func (x VerifyStatus) String() string {
 	return proto.EnumName(VerifyStatus_name, int32(x))
 }
 func (VerifyStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{1}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{1}
 }
 
 // BlockchainStatus is current status of blockchain
@@ -108,7 +108,7 @@ func (m *BlockchainStatus) Reset()         { *m = BlockchainStatus{} }
 func (m *BlockchainStatus) String() string { return proto.CompactTextString(m) }
 func (*BlockchainStatus) ProtoMessage()    {}
 func (*BlockchainStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{0}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{0}
 }
 func (m *BlockchainStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockchainStatus.Unmarshal(m, b)
@@ -170,7 +170,7 @@ func (m *ChainId) Reset()         { *m = ChainId{} }
 func (m *ChainId) String() string { return proto.CompactTextString(m) }
 func (*ChainId) ProtoMessage()    {}
 func (*ChainId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{1}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{1}
 }
 func (m *ChainId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainId.Unmarshal(m, b)
@@ -237,7 +237,7 @@ func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
 func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
 func (*ChainInfo) ProtoMessage()    {}
 func (*ChainInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{2}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{2}
 }
 func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainInfo.Unmarshal(m, b)
@@ -325,7 +325,7 @@ func (m *ChainStats) Reset()         { *m = ChainStats{} }
 func (m *ChainStats) String() string { return proto.CompactTextString(m) }
 func (*ChainStats) ProtoMessage()    {}
 func (*ChainStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{3}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{3}
 }
 func (m *ChainStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainStats.Unmarshal(m, b)
@@ -366,7 +366,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{4}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{4}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Input.Unmarshal(m, b)
@@ -428,7 +428,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{5}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{5}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Output.Unmarshal(m, b)
@@ -486,7 +486,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{6}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{6}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -517,7 +517,7 @@ func (m *SingleBytes) Reset()         { *m = SingleBytes{} }
 func (m *SingleBytes) String() string { return proto.CompactTextString(m) }
 func (*SingleBytes) ProtoMessage()    {}
 func (*SingleBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{7}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{7}
 }
 func (m *SingleBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleBytes.Unmarshal(m, b)
@@ -555,7 +555,7 @@ func (m *AccountAddress) Reset()         { *m = AccountAddress{} }
 func (m *AccountAddress) String() string { return proto.CompactTextString(m) }
 func (*AccountAddress) ProtoMessage()    {}
 func (*AccountAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{8}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{8}
 }
 func (m *AccountAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAddress.Unmarshal(m, b)
@@ -595,7 +595,7 @@ func (m *AccountAndRoot) Reset()         { *m = AccountAndRoot{} }
 func (m *AccountAndRoot) String() string { return proto.CompactTextString(m) }
 func (*AccountAndRoot) ProtoMessage()    {}
 func (*AccountAndRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{9}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{9}
 }
 func (m *AccountAndRoot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAndRoot.Unmarshal(m, b)
@@ -653,7 +653,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{10}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{10}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -733,7 +733,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{11}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{11}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -775,7 +775,7 @@ func (m *ListParams) Reset()         { *m = ListParams{} }
 func (m *ListParams) String() string { return proto.CompactTextString(m) }
 func (*ListParams) ProtoMessage()    {}
 func (*ListParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{12}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{12}
 }
 func (m *ListParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListParams.Unmarshal(m, b)
@@ -842,7 +842,7 @@ func (m *PageParams) Reset()         { *m = PageParams{} }
 func (m *PageParams) String() string { return proto.CompactTextString(m) }
 func (*PageParams) ProtoMessage()    {}
 func (*PageParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{13}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{13}
 }
 func (m *PageParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PageParams.Unmarshal(m, b)
@@ -890,7 +890,7 @@ func (m *BlockBodyPaged) Reset()         { *m = BlockBodyPaged{} }
 func (m *BlockBodyPaged) String() string { return proto.CompactTextString(m) }
 func (*BlockBodyPaged) ProtoMessage()    {}
 func (*BlockBodyPaged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{14}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{14}
 }
 func (m *BlockBodyPaged) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBodyPaged.Unmarshal(m, b)
@@ -950,7 +950,7 @@ func (m *BlockBodyParams) Reset()         { *m = BlockBodyParams{} }
 func (m *BlockBodyParams) String() string { return proto.CompactTextString(m) }
 func (*BlockBodyParams) ProtoMessage()    {}
 func (*BlockBodyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{15}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{15}
 }
 func (m *BlockBodyParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBodyParams.Unmarshal(m, b)
@@ -995,7 +995,7 @@ func (m *BlockHeaderList) Reset()         { *m = BlockHeaderList{} }
 func (m *BlockHeaderList) String() string { return proto.CompactTextString(m) }
 func (*BlockHeaderList) ProtoMessage()    {}
 func (*BlockHeaderList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{16}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{16}
 }
 func (m *BlockHeaderList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHeaderList.Unmarshal(m, b)
@@ -1035,7 +1035,7 @@ func (m *BlockMetadata) Reset()         { *m = BlockMetadata{} }
 func (m *BlockMetadata) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadata) ProtoMessage()    {}
 func (*BlockMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{17}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{17}
 }
 func (m *BlockMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadata.Unmarshal(m, b)
@@ -1087,7 +1087,7 @@ func (m *BlockMetadataList) Reset()         { *m = BlockMetadataList{} }
 func (m *BlockMetadataList) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadataList) ProtoMessage()    {}
 func (*BlockMetadataList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{18}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{18}
 }
 func (m *BlockMetadataList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadataList.Unmarshal(m, b)
@@ -1127,7 +1127,7 @@ func (m *CommitResult) Reset()         { *m = CommitResult{} }
 func (m *CommitResult) String() string { return proto.CompactTextString(m) }
 func (*CommitResult) ProtoMessage()    {}
 func (*CommitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{19}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{19}
 }
 func (m *CommitResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitResult.Unmarshal(m, b)
@@ -1179,7 +1179,7 @@ func (m *CommitResultList) Reset()         { *m = CommitResultList{} }
 func (m *CommitResultList) String() string { return proto.CompactTextString(m) }
 func (*CommitResultList) ProtoMessage()    {}
 func (*CommitResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{20}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{20}
 }
 func (m *CommitResultList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitResultList.Unmarshal(m, b)
@@ -1218,7 +1218,7 @@ func (m *VerifyResult) Reset()         { *m = VerifyResult{} }
 func (m *VerifyResult) String() string { return proto.CompactTextString(m) }
 func (*VerifyResult) ProtoMessage()    {}
 func (*VerifyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{21}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{21}
 }
 func (m *VerifyResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyResult.Unmarshal(m, b)
@@ -1264,7 +1264,7 @@ func (m *Personal) Reset()         { *m = Personal{} }
 func (m *Personal) String() string { return proto.CompactTextString(m) }
 func (*Personal) ProtoMessage()    {}
 func (*Personal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{22}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{22}
 }
 func (m *Personal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Personal.Unmarshal(m, b)
@@ -1311,7 +1311,7 @@ func (m *ImportFormat) Reset()         { *m = ImportFormat{} }
 func (m *ImportFormat) String() string { return proto.CompactTextString(m) }
 func (*ImportFormat) ProtoMessage()    {}
 func (*ImportFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{23}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{23}
 }
 func (m *ImportFormat) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportFormat.Unmarshal(m, b)
@@ -1355,6 +1355,8 @@ func (m *ImportFormat) GetNewpass() string {
 type Staking struct {
 	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	When                 uint64   `protobuf:"varint,2,opt,name=when,proto3" json:"when,omitempty"`
+	Unbonding            []byte   `protobuf:"bytes,3,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+	Release              uint64   `protobuf:"varint,4,opt,name=release,proto3" json:"release,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1364,7 +1366,7 @@ func (m *Staking) Reset()         { *m = Staking{} }
 func (m *Staking) String() string { return proto.CompactTextString(m) }
 func (*Staking) ProtoMessage()    {}
 func (*Staking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{24}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{24}
 }
 func (m *Staking) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Staking.Unmarshal(m, b)
@@ -1398,6 +1400,20 @@ func (m *Staking) GetWhen() uint64 {
 	return 0
 }
 
+func (m *Staking) GetUnbonding() []byte {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
+func (m *Staking) GetRelease() uint64 {
+	if m != nil {
+		return m.Release
+	}
+	return 0
+}
+
 type Vote struct {
 	Candidate            []byte   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
 	Amount               []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -1410,7 +1426,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{25}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{25}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Vote.Unmarshal(m, b)
@@ -1456,7 +1472,7 @@ func (m *VoteParams) Reset()         { *m = VoteParams{} }
 func (m *VoteParams) String() string { return proto.CompactTextString(m) }
 func (*VoteParams) ProtoMessage()    {}
 func (*VoteParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{26}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{26}
 }
 func (m *VoteParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteParams.Unmarshal(m, b)
@@ -1502,7 +1518,7 @@ func (m *AccountVoteInfo) Reset()         { *m = AccountVoteInfo{} }
 func (m *AccountVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*AccountVoteInfo) ProtoMessage()    {}
 func (*AccountVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{27}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{27}
 }
 func (m *AccountVoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountVoteInfo.Unmarshal(m, b)
@@ -1548,7 +1564,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{28}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{28}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
@@ -1594,7 +1610,7 @@ func (m *VoteList) Reset()         { *m = VoteList{} }
 func (m *VoteList) String() string { return proto.CompactTextString(m) }
 func (*VoteList) ProtoMessage()    {}
 func (*VoteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{29}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{29}
 }
 func (m *VoteList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteList.Unmarshal(m, b)
@@ -1640,7 +1656,7 @@ func (m *NodeReq) Reset()         { *m = NodeReq{} }
 func (m *NodeReq) String() string { return proto.CompactTextString(m) }
 func (*NodeReq) ProtoMessage()    {}
 func (*NodeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{30}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{30}
 }
 func (m *NodeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeReq.Unmarshal(m, b)
@@ -1685,7 +1701,7 @@ func (m *Name) Reset()         { *m = Name{} }
 func (m *Name) String() string { return proto.CompactTextString(m) }
 func (*Name) ProtoMessage()    {}
 func (*Name) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{31}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{31}
 }
 func (m *Name) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Name.Unmarshal(m, b)
@@ -1716,6 +1732,7 @@ type NameInfo struct {
 	Name                 *Name    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Owner                []byte   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Destination          []byte   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	ExpireBlock          uint64   `protobuf:"varint,4,opt,name=expireBlock,proto3" json:"expireBlock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1725,7 +1742,7 @@ func (m *NameInfo) Reset()         { *m = NameInfo{} }
 func (m *NameInfo) String() string { return proto.CompactTextString(m) }
 func (*NameInfo) ProtoMessage()    {}
 func (*NameInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{32}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{32}
 }
 func (m *NameInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NameInfo.Unmarshal(m, b)
@@ -1766,6 +1783,13 @@ func (m *NameInfo) GetDestination() []byte {
 	return nil
 }
 
+func (m *NameInfo) GetExpireBlock() uint64 {
+	if m != nil {
+		return m.ExpireBlock
+	}
+	return 0
+}
+
 type PeersParams struct {
 	NoHidden             bool     `protobuf:"varint,1,opt,name=noHidden,proto3" json:"noHidden,omitempty"`
 	ShowSelf             bool     `protobuf:"varint,2,opt,name=showSelf,proto3" json:"showSelf,omitempty"`
@@ -1778,7 +1802,7 @@ func (m *PeersParams) Reset()         { *m = PeersParams{} }
 func (m *PeersParams) String() string { return proto.CompactTextString(m) }
 func (*PeersParams) ProtoMessage()    {}
 func (*PeersParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{33}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{33}
 }
 func (m *PeersParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeersParams.Unmarshal(m, b)
@@ -1823,7 +1847,7 @@ func (m *KeyParams) Reset()         { *m = KeyParams{} }
 func (m *KeyParams) String() string { return proto.CompactTextString(m) }
 func (*KeyParams) ProtoMessage()    {}
 func (*KeyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{34}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{34}
 }
 func (m *KeyParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyParams.Unmarshal(m, b)
@@ -1862,7 +1886,7 @@ func (m *ServerInfo) Reset()         { *m = ServerInfo{} }
 func (m *ServerInfo) String() string { return proto.CompactTextString(m) }
 func (*ServerInfo) ProtoMessage()    {}
 func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{35}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{35}
 }
 func (m *ServerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerInfo.Unmarshal(m, b)
@@ -1907,7 +1931,7 @@ func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
 func (m *ConfigItem) String() string { return proto.CompactTextString(m) }
 func (*ConfigItem) ProtoMessage()    {}
 func (*ConfigItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{36}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{36}
 }
 func (m *ConfigItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigItem.Unmarshal(m, b)
@@ -1945,7 +1969,7 @@ func (m *EventList) Reset()         { *m = EventList{} }
 func (m *EventList) String() string { return proto.CompactTextString(m) }
 func (*EventList) ProtoMessage()    {}
 func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{37}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{37}
 }
 func (m *EventList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventList.Unmarshal(m, b)
@@ -1986,7 +2010,7 @@ func (m *ConsensusInfo) Reset()         { *m = ConsensusInfo{} }
 func (m *ConsensusInfo) String() string { return proto.CompactTextString(m) }
 func (*ConsensusInfo) ProtoMessage()    {}
 func (*ConsensusInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_96c44ee509d12060, []int{38}
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{38}
 }
 func (m *ConsensusInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusInfo.Unmarshal(m, b)
@@ -2013,16 +2037,702 @@ func (m *ConsensusInfo) GetType() string {
 	return ""
 }
 
-func (m *ConsensusInfo) GetInfo() string {
+func (m *ConsensusInfo) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+func (m *ConsensusInfo) GetBps() []string {
+	if m != nil {
+		return m.Bps
+	}
+	return nil
+}
+
+// TxProof is a merkle proof that tx is included in the tx root of block header
+type TxProof struct {
+	TxIdx                *TxIdx       `protobuf:"bytes,1,opt,name=txIdx,proto3" json:"txIdx,omitempty"`
+	Tx                   *Tx          `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Header               *BlockHeader `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	MerklePath           [][]byte     `protobuf:"bytes,4,rep,name=merklePath,proto3" json:"merklePath,omitempty"`
+	LeafCount            uint32       `protobuf:"varint,5,opt,name=leafCount,proto3" json:"leafCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxProof) Reset()         { *m = TxProof{} }
+func (m *TxProof) String() string { return proto.CompactTextString(m) }
+func (*TxProof) ProtoMessage()    {}
+func (*TxProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{39}
+}
+func (m *TxProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProof.Unmarshal(m, b)
+}
+func (m *TxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProof.Marshal(b, m, deterministic)
+}
+func (dst *TxProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProof.Merge(dst, src)
+}
+func (m *TxProof) XXX_Size() int {
+	return xxx_messageInfo_TxProof.Size(m)
+}
+func (m *TxProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProof proto.InternalMessageInfo
+
+func (m *TxProof) GetTxIdx() *TxIdx {
+	if m != nil {
+		return m.TxIdx
+	}
+	return nil
+}
+
+func (m *TxProof) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxProof) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+func (m *TxProof) GetLeafCount() uint32 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+// ReceiptProof is a merkle proof that receipt is included in the receipts root of block header
+type ReceiptProof struct {
+	TxIdx                *TxIdx       `protobuf:"bytes,1,opt,name=txIdx,proto3" json:"txIdx,omitempty"`
+	Receipt              *Receipt     `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Header               *BlockHeader `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	MerklePath           [][]byte     `protobuf:"bytes,4,rep,name=merklePath,proto3" json:"merklePath,omitempty"`
+	LeafCount            uint32       `protobuf:"varint,5,opt,name=leafCount,proto3" json:"leafCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptProof) Reset()         { *m = ReceiptProof{} }
+func (m *ReceiptProof) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProof) ProtoMessage()    {}
+func (*ReceiptProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{40}
+}
+func (m *ReceiptProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptProof.Unmarshal(m, b)
+}
+func (m *ReceiptProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptProof.Marshal(b, m, deterministic)
+}
+func (dst *ReceiptProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProof.Merge(dst, src)
+}
+func (m *ReceiptProof) XXX_Size() int {
+	return xxx_messageInfo_ReceiptProof.Size(m)
+}
+func (m *ReceiptProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProof proto.InternalMessageInfo
+
+func (m *ReceiptProof) GetTxIdx() *TxIdx {
+	if m != nil {
+		return m.TxIdx
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetLeafCount() uint32 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+type EventProofParams struct {
+	TxHash               []byte   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	EventIdx             int32    `protobuf:"varint,2,opt,name=eventIdx,proto3" json:"eventIdx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventProofParams) Reset()         { *m = EventProofParams{} }
+func (m *EventProofParams) String() string { return proto.CompactTextString(m) }
+func (*EventProofParams) ProtoMessage()    {}
+func (*EventProofParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{41}
+}
+func (m *EventProofParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventProofParams.Unmarshal(m, b)
+}
+func (m *EventProofParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventProofParams.Marshal(b, m, deterministic)
+}
+func (dst *EventProofParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProofParams.Merge(dst, src)
+}
+func (m *EventProofParams) XXX_Size() int {
+	return xxx_messageInfo_EventProofParams.Size(m)
+}
+func (m *EventProofParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProofParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProofParams proto.InternalMessageInfo
+
+func (m *EventProofParams) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *EventProofParams) GetEventIdx() int32 {
+	if m != nil {
+		return m.EventIdx
+	}
+	return 0
+}
+
+// EventProof is a merkle proof of the receipt which has the event
+type EventProof struct {
+	Event                *Event        `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ReceiptProof         *ReceiptProof `protobuf:"bytes,2,opt,name=receiptProof,proto3" json:"receiptProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EventProof) Reset()         { *m = EventProof{} }
+func (m *EventProof) String() string { return proto.CompactTextString(m) }
+func (*EventProof) ProtoMessage()    {}
+func (*EventProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{42}
+}
+func (m *EventProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventProof.Unmarshal(m, b)
+}
+func (m *EventProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventProof.Marshal(b, m, deterministic)
+}
+func (dst *EventProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProof.Merge(dst, src)
+}
+func (m *EventProof) XXX_Size() int {
+	return xxx_messageInfo_EventProof.Size(m)
+}
+func (m *EventProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProof proto.InternalMessageInfo
+
+func (m *EventProof) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *EventProof) GetReceiptProof() *ReceiptProof {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+// PersonalHD is the request of hd wallet accounts. Mnemonic is used only for recovery.
+type PersonalHD struct {
+	Passphrase           string   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Mnemonic             string   `protobuf:"bytes,2,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PersonalHD) Reset()         { *m = PersonalHD{} }
+func (m *PersonalHD) String() string { return proto.CompactTextString(m) }
+func (*PersonalHD) ProtoMessage()    {}
+func (*PersonalHD) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{43}
+}
+func (m *PersonalHD) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersonalHD.Unmarshal(m, b)
+}
+func (m *PersonalHD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersonalHD.Marshal(b, m, deterministic)
+}
+func (dst *PersonalHD) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonalHD.Merge(dst, src)
+}
+func (m *PersonalHD) XXX_Size() int {
+	return xxx_messageInfo_PersonalHD.Size(m)
+}
+func (m *PersonalHD) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonalHD.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonalHD proto.InternalMessageInfo
+
+func (m *PersonalHD) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *PersonalHD) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *PersonalHD) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// HDAccounts is the accounts derived from hd wallet. Mnemonic is set only when hd wallet is newly created.
+type HDAccounts struct {
+	Mnemonic             string     `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Accounts             []*Account `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *HDAccounts) Reset()         { *m = HDAccounts{} }
+func (m *HDAccounts) String() string { return proto.CompactTextString(m) }
+func (*HDAccounts) ProtoMessage()    {}
+func (*HDAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{44}
+}
+func (m *HDAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDAccounts.Unmarshal(m, b)
+}
+func (m *HDAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDAccounts.Marshal(b, m, deterministic)
+}
+func (dst *HDAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDAccounts.Merge(dst, src)
+}
+func (m *HDAccounts) XXX_Size() int {
+	return xxx_messageInfo_HDAccounts.Size(m)
+}
+func (m *HDAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDAccounts proto.InternalMessageInfo
+
+func (m *HDAccounts) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *HDAccounts) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// Delegation is the stake delegated to a bp and the reward not claimed yet
+type Delegation struct {
+	Bp                   []byte   `protobuf:"bytes,1,opt,name=bp,proto3" json:"bp,omitempty"`
+	Amount               []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reward               []byte   `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	When                 uint64   `protobuf:"varint,4,opt,name=when,proto3" json:"when,omitempty"`
+	Commission           uint32   `protobuf:"varint,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{45}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delegation.Unmarshal(m, b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+}
+func (dst *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(dst, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return xxx_messageInfo_Delegation.Size(m)
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetBp() []byte {
+	if m != nil {
+		return m.Bp
+	}
+	return nil
+}
+
+func (m *Delegation) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Delegation) GetReward() []byte {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
+func (m *Delegation) GetWhen() uint64 {
+	if m != nil {
+		return m.When
+	}
+	return 0
+}
+
+func (m *Delegation) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+type Proposal struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Param                string   `protobuf:"bytes,2,opt,name=param,proto3" json:"param,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Proposer             []byte   `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Start                uint64   `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	End                  uint64   `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
+	Apply                uint64   `protobuf:"varint,7,opt,name=apply,proto3" json:"apply,omitempty"`
+	Yes                  []byte   `protobuf:"bytes,8,opt,name=yes,proto3" json:"yes,omitempty"`
+	No                   []byte   `protobuf:"bytes,9,opt,name=no,proto3" json:"no,omitempty"`
+	Status               string   `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{46}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Proposal.Unmarshal(m, b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+}
+func (dst *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(dst, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return xxx_messageInfo_Proposal.Size(m)
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proposal) GetParam() string {
+	if m != nil {
+		return m.Param
+	}
+	return ""
+}
+
+func (m *Proposal) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Proposal) GetProposer() []byte {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *Proposal) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Proposal) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *Proposal) GetApply() uint64 {
+	if m != nil {
+		return m.Apply
+	}
+	return 0
+}
+
+func (m *Proposal) GetYes() []byte {
+	if m != nil {
+		return m.Yes
+	}
+	return nil
+}
+
+func (m *Proposal) GetNo() []byte {
+	if m != nil {
+		return m.No
+	}
+	return nil
+}
+
+func (m *Proposal) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type ProposalList struct {
+	Proposals            []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProposalList) Reset()         { *m = ProposalList{} }
+func (m *ProposalList) String() string { return proto.CompactTextString(m) }
+func (*ProposalList) ProtoMessage()    {}
+func (*ProposalList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{47}
+}
+func (m *ProposalList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalList.Unmarshal(m, b)
+}
+func (m *ProposalList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalList.Marshal(b, m, deterministic)
+}
+func (dst *ProposalList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalList.Merge(dst, src)
+}
+func (m *ProposalList) XXX_Size() int {
+	return xxx_messageInfo_ProposalList.Size(m)
+}
+func (m *ProposalList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalList proto.InternalMessageInfo
+
+func (m *ProposalList) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type AllowedPeers struct {
+	Ids                  [][]byte `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AllowedPeers) Reset()         { *m = AllowedPeers{} }
+func (m *AllowedPeers) String() string { return proto.CompactTextString(m) }
+func (*AllowedPeers) ProtoMessage()    {}
+func (*AllowedPeers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{48}
+}
+func (m *AllowedPeers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllowedPeers.Unmarshal(m, b)
+}
+func (m *AllowedPeers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllowedPeers.Marshal(b, m, deterministic)
+}
+func (dst *AllowedPeers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedPeers.Merge(dst, src)
+}
+func (m *AllowedPeers) XXX_Size() int {
+	return xxx_messageInfo_AllowedPeers.Size(m)
+}
+func (m *AllowedPeers) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedPeers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedPeers proto.InternalMessageInfo
+
+func (m *AllowedPeers) GetIds() [][]byte {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type DBStat struct {
+	Store                string   `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBStat) Reset()         { *m = DBStat{} }
+func (m *DBStat) String() string { return proto.CompactTextString(m) }
+func (*DBStat) ProtoMessage()    {}
+func (*DBStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{49}
+}
+func (m *DBStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBStat.Unmarshal(m, b)
+}
+func (m *DBStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBStat.Marshal(b, m, deterministic)
+}
+func (dst *DBStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBStat.Merge(dst, src)
+}
+func (m *DBStat) XXX_Size() int {
+	return xxx_messageInfo_DBStat.Size(m)
+}
+func (m *DBStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBStat proto.InternalMessageInfo
+
+func (m *DBStat) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *DBStat) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DBStat) GetValue() string {
 	if m != nil {
-		return m.Info
+		return m.Value
 	}
 	return ""
 }
 
-func (m *ConsensusInfo) GetBps() []string {
+type DBStats struct {
+	Stats                []*DBStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DBStats) Reset()         { *m = DBStats{} }
+func (m *DBStats) String() string { return proto.CompactTextString(m) }
+func (*DBStats) ProtoMessage()    {}
+func (*DBStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_bd5d8554e3810723, []int{50}
+}
+func (m *DBStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBStats.Unmarshal(m, b)
+}
+func (m *DBStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBStats.Marshal(b, m, deterministic)
+}
+func (dst *DBStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBStats.Merge(dst, src)
+}
+func (m *DBStats) XXX_Size() int {
+	return xxx_messageInfo_DBStats.Size(m)
+}
+func (m *DBStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBStats proto.InternalMessageInfo
+
+func (m *DBStats) GetStats() []*DBStat {
 	if m != nil {
-		return m.Bps
+		return m.Stats
 	}
 	return nil
 }
@@ -2070,6 +2780,18 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "types.ConfigItem.PropsEntry")
 	proto.RegisterType((*EventList)(nil), "types.EventList")
 	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
+	proto.RegisterType((*TxProof)(nil), "types.TxProof")
+	proto.RegisterType((*ReceiptProof)(nil), "types.ReceiptProof")
+	proto.RegisterType((*EventProofParams)(nil), "types.EventProofParams")
+	proto.RegisterType((*EventProof)(nil), "types.EventProof")
+	proto.RegisterType((*PersonalHD)(nil), "types.PersonalHD")
+	proto.RegisterType((*HDAccounts)(nil), "types.HDAccounts")
+	proto.RegisterType((*Delegation)(nil), "types.Delegation")
+	proto.RegisterType((*Proposal)(nil), "types.Proposal")
+	proto.RegisterType((*ProposalList)(nil), "types.ProposalList")
+	proto.RegisterType((*AllowedPeers)(nil), "types.AllowedPeers")
+	proto.RegisterType((*DBStat)(nil), "types.DBStat")
+	proto.RegisterType((*DBStats)(nil), "types.DBStats")
 	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
 	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
 }
@@ -2164,6 +2886,28 @@ type AergoRPCServiceClient interface {
 	GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error)
 	// Returns status of consensus and bps
 	GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error)
+	// Returns a merkle proof of transaction against tx root of the block
+	GetTxProof(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxProof, error)
+	// Returns a merkle proof of receipt against receipts root of the block
+	GetReceiptProof(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ReceiptProof, error)
+	// Returns a merkle proof of event, which is the proof of receipt having the event
+	GetEventProof(ctx context.Context, in *EventProofParams, opts ...grpc.CallOption) (*EventProof, error)
+	// Export account stored in this node as json keystore file
+	ExportAccountKeystore(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Create hd wallet with new mnemonic, or derive next accounts from existing one
+	CreateAccountHD(ctx context.Context, in *PersonalHD, opts ...grpc.CallOption) (*HDAccounts, error)
+	// Recover hd wallet from mnemonic and derive accounts from it
+	RecoverAccountHD(ctx context.Context, in *PersonalHD, opts ...grpc.CallOption) (*HDAccounts, error)
+	// Return the primary name of an address
+	GetNameByAddress(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*NameInfo, error)
+	// Return delegation info of account
+	GetDelegation(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Delegation, error)
+	// Returns governance proposals of the system contract
+	ListProposals(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*ProposalList, error)
+	// Return the peer ids allowed to join the permissioned network. It is empty if the network is permissionless
+	GetAllowedPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AllowedPeers, error)
+	// Return statistics of stores of node
+	GetDBStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DBStats, error)
 }
 
 type aergoRPCServiceClient struct {
@@ -2594,6 +3338,105 @@ func (c *aergoRPCServiceClient) GetConsensusInfo(ctx context.Context, in *Empty,
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) GetTxProof(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxProof, error) {
+	out := new(TxProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetReceiptProof(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ReceiptProof, error) {
+	out := new(ReceiptProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetReceiptProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetEventProof(ctx context.Context, in *EventProofParams, opts ...grpc.CallOption) (*EventProof, error) {
+	out := new(EventProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetEventProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ExportAccountKeystore(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ExportAccountKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) CreateAccountHD(ctx context.Context, in *PersonalHD, opts ...grpc.CallOption) (*HDAccounts, error) {
+	out := new(HDAccounts)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CreateAccountHD", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) RecoverAccountHD(ctx context.Context, in *PersonalHD, opts ...grpc.CallOption) (*HDAccounts, error) {
+	out := new(HDAccounts)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/RecoverAccountHD", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetNameByAddress(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*NameInfo, error) {
+	out := new(NameInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetNameByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetDelegation(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Delegation, error) {
+	out := new(Delegation)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListProposals(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*ProposalList, error) {
+	out := new(ProposalList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetAllowedPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AllowedPeers, error) {
+	out := new(AllowedPeers)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAllowedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetDBStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DBStats, error) {
+	out := new(DBStats)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetDBStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AergoRPCServiceServer is the server API for AergoRPCService service.
 type AergoRPCServiceServer interface {
 	// Returns the current state of this node
@@ -2674,6 +3517,28 @@ type AergoRPCServiceServer interface {
 	GetServerInfo(context.Context, *KeyParams) (*ServerInfo, error)
 	// Returns status of consensus and bps
 	GetConsensusInfo(context.Context, *Empty) (*ConsensusInfo, error)
+	// Returns a merkle proof of transaction against tx root of the block
+	GetTxProof(context.Context, *SingleBytes) (*TxProof, error)
+	// Returns a merkle proof of receipt against receipts root of the block
+	GetReceiptProof(context.Context, *SingleBytes) (*ReceiptProof, error)
+	// Returns a merkle proof of event, which is the proof of receipt having the event
+	GetEventProof(context.Context, *EventProofParams) (*EventProof, error)
+	// Export account stored in this node as json keystore file
+	ExportAccountKeystore(context.Context, *Personal) (*SingleBytes, error)
+	// Create hd wallet with new mnemonic, or derive next accounts from existing one
+	CreateAccountHD(context.Context, *PersonalHD) (*HDAccounts, error)
+	// Recover hd wallet from mnemonic and derive accounts from it
+	RecoverAccountHD(context.Context, *PersonalHD) (*HDAccounts, error)
+	// Return the primary name of an address
+	GetNameByAddress(context.Context, *AccountAddress) (*NameInfo, error)
+	// Return delegation info of account
+	GetDelegation(context.Context, *AccountAddress) (*Delegation, error)
+	// Returns governance proposals of the system contract
+	ListProposals(context.Context, *ListParams) (*ProposalList, error)
+	// Return the peer ids allowed to join the permissioned network. It is empty if the network is permissionless
+	GetAllowedPeers(context.Context, *Empty) (*AllowedPeers, error)
+	// Return statistics of stores of node
+	GetDBStats(context.Context, *Empty) (*DBStats, error)
 }
 
 func RegisterAergoRPCServiceServer(s *grpc.Server, srv AergoRPCServiceServer) {
@@ -3391,6 +4256,204 @@ func _AergoRPCService_GetConsensusInfo_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetTxProof(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetReceiptProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetReceiptProof(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetEventProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventProofParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetEventProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetEventProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetEventProof(ctx, req.(*EventProofParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ExportAccountKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Personal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ExportAccountKeystore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ExportAccountKeystore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ExportAccountKeystore(ctx, req.(*Personal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_CreateAccountHD_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonalHD)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).CreateAccountHD(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/CreateAccountHD",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).CreateAccountHD(ctx, req.(*PersonalHD))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_RecoverAccountHD_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonalHD)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).RecoverAccountHD(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/RecoverAccountHD",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).RecoverAccountHD(ctx, req.(*PersonalHD))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetNameByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetNameByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetNameByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetNameByAddress(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetDelegation(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListProposals(ctx, req.(*ListParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetAllowedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetAllowedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetAllowedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetAllowedPeers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetDBStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetDBStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetDBStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetDBStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.AergoRPCService",
 	HandlerType: (*AergoRPCServiceServer)(nil),
@@ -3539,6 +4602,50 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConsensusInfo",
 			Handler:    _AergoRPCService_GetConsensusInfo_Handler,
 		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _AergoRPCService_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetReceiptProof",
+			Handler:    _AergoRPCService_GetReceiptProof_Handler,
+		},
+		{
+			MethodName: "GetEventProof",
+			Handler:    _AergoRPCService_GetEventProof_Handler,
+		},
+		{
+			MethodName: "ExportAccountKeystore",
+			Handler:    _AergoRPCService_ExportAccountKeystore_Handler,
+		},
+		{
+			MethodName: "CreateAccountHD",
+			Handler:    _AergoRPCService_CreateAccountHD_Handler,
+		},
+		{
+			MethodName: "RecoverAccountHD",
+			Handler:    _AergoRPCService_RecoverAccountHD_Handler,
+		},
+		{
+			MethodName: "GetNameByAddress",
+			Handler:    _AergoRPCService_GetNameByAddress_Handler,
+		},
+		{
+			MethodName: "GetDelegation",
+			Handler:    _AergoRPCService_GetDelegation_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _AergoRPCService_ListProposals_Handler,
+		},
+		{
+			MethodName: "GetAllowedPeers",
+			Handler:    _AergoRPCService_GetAllowedPeers_Handler,
+		},
+		{
+			MethodName: "GetDBStats",
+			Handler:    _AergoRPCService_GetDBStats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3560,157 +4667,195 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_96c44ee509d12060) }
-
-var fileDescriptor_rpc_96c44ee509d12060 = []byte{
-	// 2370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xeb, 0x76, 0x1a, 0xc9,
-	0xf1, 0x07, 0x24, 0x10, 0x14, 0x20, 0x8d, 0x7a, 0xb5, 0xb6, 0xfe, 0xfc, 0xbd, 0x5e, 0xa5, 0xe3,
-	0x78, 0xb5, 0x8e, 0xad, 0xb5, 0xe5, 0x78, 0xe3, 0xec, 0x49, 0xb2, 0x41, 0x2c, 0xb2, 0x38, 0x2b,
-	0x23, 0xa5, 0xc1, 0x8e, 0x36, 0x1f, 0x42, 0x46, 0x4c, 0x23, 0xe6, 0x88, 0x99, 0x61, 0x67, 0x1a,
-	0x5d, 0xf6, 0x6b, 0x1e, 0x20, 0x8f, 0x92, 0x87, 0xc8, 0x1b, 0xe4, 0x35, 0xf2, 0x12, 0x39, 0xd5,
-	0x97, 0xb9, 0xa0, 0x71, 0xce, 0x71, 0x3e, 0x31, 0x55, 0x5d, 0xb7, 0xae, 0xaa, 0xae, 0xfe, 0x35,
-	0x50, 0x0b, 0xe7, 0xe3, 0xbd, 0x79, 0x18, 0x88, 0x80, 0x94, 0xc5, 0xed, 0x9c, 0x47, 0x2d, 0xeb,
-	0x7c, 0x16, 0x8c, 0x2f, 0xc7, 0x53, 0xdb, 0xf5, 0xd5, 0x42, 0xab, 0x69, 0x8f, 0xc7, 0xc1, 0xc2,
-	0x17, 0x9a, 0x04, 0x3f, 0x70, 0xb8, 0xfe, 0xae, 0xcd, 0xf7, 0xe7, 0xfa, 0xb3, 0xe1, 0x71, 0x11,
-	0xba, 0xda, 0x18, 0xfd, 0x47, 0x11, 0xac, 0x83, 0xd8, 0xd0, 0x40, 0xd8, 0x62, 0x11, 0x91, 0xc7,
-	0xb0, 0x71, 0xce, 0x23, 0x31, 0x92, 0x1e, 0x46, 0x53, 0x3b, 0x9a, 0x6e, 0x17, 0x77, 0x8a, 0xbb,
-	0x0d, 0xd6, 0x44, 0xb6, 0x14, 0x3f, 0xb2, 0xa3, 0x29, 0xf9, 0x1c, 0xea, 0x52, 0x6e, 0xca, 0xdd,
-	0x8b, 0xa9, 0xd8, 0x2e, 0xed, 0x14, 0x77, 0x57, 0x19, 0x20, 0xeb, 0x48, 0x72, 0xc8, 0x2f, 0x60,
-	0x7d, 0x1c, 0xf8, 0x11, 0xf7, 0xa3, 0x45, 0x34, 0x72, 0xfd, 0x49, 0xb0, 0xbd, 0xb2, 0x53, 0xdc,
-	0xad, 0xb1, 0x66, 0xcc, 0xed, 0xf9, 0x93, 0x80, 0xfc, 0x12, 0x88, 0xb4, 0x23, 0x63, 0x18, 0xb9,
-	0x8e, 0x72, 0xb9, 0x2a, 0x5d, 0xca, 0x48, 0x3a, 0xb8, 0xd0, 0x73, 0xd0, 0x29, 0x0d, 0x60, 0x4d,
-	0x93, 0x64, 0x0b, 0xca, 0x9e, 0x7d, 0xe1, 0x8e, 0x65, 0x74, 0x35, 0xa6, 0x08, 0x72, 0x0f, 0x2a,
-	0xf3, 0xc5, 0xf9, 0xcc, 0x1d, 0xcb, 0x80, 0xaa, 0x4c, 0x53, 0x64, 0x1b, 0xd6, 0x3c, 0xdb, 0xf5,
-	0x7d, 0x2e, 0x64, 0x14, 0x55, 0x66, 0x48, 0xf2, 0x00, 0x6a, 0x71, 0x40, 0xd2, 0x6d, 0x8d, 0x25,
-	0x0c, 0xfa, 0xf7, 0x12, 0xd4, 0x94, 0x47, 0x8c, 0xf5, 0x21, 0x94, 0x5c, 0x47, 0x3a, 0xac, 0xef,
-	0xaf, 0xef, 0xc9, 0x52, 0xec, 0xe9, 0x78, 0x58, 0xc9, 0x75, 0x48, 0x0b, 0xaa, 0xe7, 0xf3, 0xfe,
-	0xc2, 0x3b, 0xe7, 0xa1, 0xf4, 0xdf, 0x64, 0x31, 0x4d, 0x28, 0x34, 0x3c, 0xfb, 0x46, 0x66, 0x35,
-	0x72, 0x7f, 0xe2, 0x32, 0x8c, 0x55, 0x96, 0xe1, 0x61, 0x2c, 0x9e, 0x7d, 0x23, 0x82, 0x4b, 0xee,
-	0x47, 0x3a, 0x05, 0x09, 0x83, 0x3c, 0x86, 0xf5, 0x48, 0xd8, 0x97, 0xae, 0x7f, 0xe1, 0xb9, 0xbe,
-	0xeb, 0x2d, 0xbc, 0xed, 0xb2, 0x14, 0x59, 0xe2, 0xa2, 0x27, 0x11, 0x08, 0x7b, 0xa6, 0xd9, 0xdb,
-	0x15, 0x29, 0x95, 0xe1, 0x61, 0xa4, 0x17, 0x76, 0x34, 0x0f, 0xdd, 0x31, 0xdf, 0x5e, 0x93, 0xeb,
-	0x31, 0x8d, 0x51, 0xf8, 0xb6, 0xc7, 0xd5, 0x62, 0x55, 0x45, 0x11, 0x33, 0xe8, 0x23, 0x80, 0x8e,
-	0x69, 0x97, 0x08, 0xf3, 0x1d, 0xf2, 0x79, 0x10, 0x0a, 0x5d, 0x06, 0x4d, 0xd1, 0x31, 0x94, 0x7b,
-	0xfe, 0x7c, 0x21, 0x08, 0x81, 0xd5, 0x54, 0x0f, 0xc9, 0x6f, 0x2c, 0x86, 0xed, 0x38, 0x21, 0x8f,
-	0xa2, 0xed, 0xd2, 0xce, 0xca, 0x6e, 0x83, 0x19, 0x12, 0x8b, 0x7a, 0x65, 0xcf, 0x16, 0x2a, 0x3b,
-	0x0d, 0xa6, 0x08, 0x74, 0x12, 0x8d, 0x43, 0x77, 0x2e, 0x74, 0x4e, 0x34, 0x45, 0x27, 0x50, 0x39,
-	0x59, 0x08, 0xf4, 0xb2, 0x05, 0x65, 0xd7, 0x77, 0xf8, 0x8d, 0x74, 0xd3, 0x64, 0x8a, 0xc8, 0xfa,
-	0x29, 0xfe, 0xef, 0x7e, 0xd6, 0xa0, 0xdc, 0xf5, 0xe6, 0xe2, 0x96, 0xfe, 0x1c, 0xea, 0x03, 0xd7,
-	0xbf, 0x98, 0xf1, 0x83, 0x5b, 0xc1, 0x53, 0x56, 0x8a, 0x29, 0x2b, 0xf4, 0x31, 0xac, 0xb7, 0xd5,
-	0x59, 0x6c, 0x2f, 0x7b, 0xcb, 0xc8, 0xfd, 0x25, 0x91, 0xf3, 0x1d, 0x16, 0x04, 0x02, 0xe3, 0xd5,
-	0x1c, 0x2d, 0x69, 0x48, 0xcc, 0x22, 0x4a, 0xe8, 0x6d, 0xc8, 0x6f, 0xf2, 0x10, 0xa0, 0x13, 0x78,
-	0x73, 0xf4, 0xc0, 0x1d, 0xdd, 0xd5, 0x29, 0x0e, 0xfd, 0x77, 0x11, 0x56, 0x4f, 0x39, 0x0f, 0xc9,
-	0xd3, 0x24, 0x0d, 0xaa, 0x75, 0x89, 0x6e, 0x5d, 0x5c, 0xd5, 0x31, 0x26, 0xa9, 0x79, 0x09, 0x35,
-	0x3c, 0x75, 0xb2, 0x29, 0xa5, 0xbf, 0xfa, 0xfe, 0xa7, 0x5a, 0xbe, 0xcf, 0xaf, 0xe5, 0xf9, 0xef,
-	0x07, 0xc2, 0x1d, 0x73, 0x96, 0xc8, 0xe1, 0x0e, 0x23, 0x61, 0x0b, 0x95, 0xcf, 0x32, 0x53, 0x04,
-	0xe6, 0x73, 0xea, 0x3a, 0x0e, 0xf7, 0x65, 0x3e, 0xab, 0x4c, 0x53, 0xd8, 0x60, 0x33, 0x3b, 0x9a,
-	0x76, 0xa6, 0x7c, 0x7c, 0x29, 0x7b, 0x78, 0x85, 0x25, 0x0c, 0x6c, 0xcd, 0x88, 0xcf, 0x26, 0x73,
-	0xce, 0x43, 0xd9, 0xba, 0x55, 0x16, 0xd3, 0x98, 0xa1, 0x2b, 0x1e, 0x46, 0x6e, 0xe0, 0xcb, 0xae,
-	0xad, 0x31, 0x43, 0xd2, 0x67, 0x50, 0xc5, 0xed, 0x1c, 0xbb, 0x91, 0x20, 0x3f, 0x83, 0x32, 0x4a,
-	0xe3, 0x76, 0x57, 0x76, 0xeb, 0xfb, 0xf5, 0xd4, 0x76, 0x99, 0x5a, 0xa1, 0x57, 0x00, 0x28, 0x7a,
-	0x6a, 0x87, 0xb6, 0x17, 0xe5, 0x36, 0x29, 0x06, 0x9f, 0x1e, 0x6d, 0x9a, 0x42, 0xd9, 0xf8, 0xfc,
-	0x36, 0x99, 0xfc, 0x46, 0xd9, 0x60, 0x32, 0x89, 0xb8, 0x6a, 0x9c, 0x26, 0xd3, 0x14, 0xb1, 0x60,
-	0xc5, 0x8e, 0xc6, 0x72, 0x8b, 0x55, 0x86, 0x9f, 0xf4, 0x35, 0xc0, 0xa9, 0x7d, 0xc1, 0xb5, 0xdf,
-	0x44, 0xaf, 0x98, 0xd1, 0x33, 0x3e, 0x4a, 0x89, 0x0f, 0x7a, 0x03, 0xeb, 0x32, 0xf9, 0x07, 0x81,
-	0x73, 0x8b, 0x26, 0xe4, 0x04, 0x94, 0x67, 0xda, 0x34, 0xbd, 0x24, 0x52, 0x36, 0x4b, 0xb9, 0x36,
-	0xd3, 0x71, 0x3f, 0x82, 0xd5, 0xf3, 0xc0, 0xb9, 0x95, 0x51, 0xd7, 0xf7, 0x2d, 0x9d, 0xa7, 0xd8,
-	0x0d, 0x93, 0xab, 0xf4, 0xaf, 0xb0, 0x91, 0xf2, 0x2c, 0x03, 0xa7, 0xd0, 0xc0, 0x24, 0x05, 0xa1,
-	0xaf, 0x86, 0x9d, 0x4a, 0x5c, 0x86, 0x47, 0xbe, 0x84, 0xca, 0xdc, 0xbe, 0xc0, 0x01, 0xa4, 0xba,
-	0x68, 0xd3, 0x94, 0x21, 0xde, 0x3f, 0xd3, 0x02, 0xf4, 0xd7, 0xda, 0xc3, 0x11, 0xb7, 0x1d, 0x5d,
-	0xc3, 0x47, 0x50, 0x51, 0x73, 0x51, 0x17, 0xb1, 0x91, 0x0e, 0x8e, 0xe9, 0x35, 0xea, 0x42, 0x53,
-	0x32, 0xde, 0x72, 0x61, 0x3b, 0xb6, 0xb0, 0x73, 0x2b, 0xf9, 0x04, 0x2b, 0x89, 0x86, 0x75, 0x20,
-	0x24, 0x6d, 0x4a, 0xb9, 0x64, 0x5a, 0x02, 0x1b, 0x4c, 0xdc, 0xa8, 0x23, 0xa8, 0x5a, 0xd9, 0x90,
-	0xb4, 0x0d, 0x9b, 0x19, 0x57, 0x32, 0xca, 0xa7, 0x4b, 0x51, 0x6e, 0xa5, 0x4d, 0x1b, 0xc9, 0x38,
-	0x5a, 0x0e, 0x8d, 0x4e, 0xe0, 0x79, 0xae, 0x60, 0x3c, 0x5a, 0xcc, 0xf2, 0x67, 0xe3, 0x97, 0x50,
-	0xe6, 0x61, 0x18, 0xa8, 0x58, 0xd7, 0xf7, 0x3f, 0x31, 0xb7, 0x8c, 0xd4, 0x53, 0x57, 0x34, 0x53,
-	0x12, 0x58, 0x69, 0x87, 0x0b, 0xdb, 0x9d, 0xe9, 0x8b, 0x55, 0x53, 0xb4, 0x0d, 0x56, 0xda, 0x8d,
-	0x0c, 0xf4, 0x19, 0xac, 0x85, 0x92, 0x32, 0x91, 0x66, 0x0d, 0x2b, 0x49, 0x66, 0x64, 0xe8, 0x10,
-	0x1a, 0xef, 0x79, 0xe8, 0x4e, 0x6e, 0x75, 0xa4, 0xff, 0x07, 0x25, 0x71, 0xa3, 0xa7, 0x47, 0x4d,
-	0x6b, 0x0e, 0x6f, 0x58, 0x49, 0xdc, 0x7c, 0x28, 0x60, 0xa5, 0x9e, 0x09, 0x98, 0x0e, 0xf1, 0x8c,
-	0x86, 0x51, 0xe0, 0xdb, 0x33, 0x9c, 0x5e, 0x73, 0x3b, 0x8a, 0xe6, 0xd3, 0xd0, 0x8e, 0xb8, 0xbe,
-	0x3c, 0x52, 0x1c, 0xb2, 0x0b, 0x6b, 0x1a, 0xd1, 0xe8, 0xaa, 0x99, 0xfb, 0x56, 0x8f, 0x44, 0x66,
-	0x96, 0xe9, 0x14, 0x1a, 0x3d, 0x0f, 0x2f, 0x9d, 0xc3, 0x20, 0xf4, 0x6c, 0xec, 0x9c, 0x95, 0x6b,
-	0x77, 0xb2, 0x34, 0xea, 0x52, 0x63, 0x9b, 0xe1, 0x32, 0x16, 0x3a, 0x98, 0x39, 0xe8, 0x50, 0xda,
-	0xaf, 0x31, 0x43, 0xe2, 0x8a, 0xcf, 0xaf, 0xe5, 0x8a, 0xca, 0xab, 0x21, 0xe9, 0x2b, 0x58, 0x1b,
-	0xe8, 0xfb, 0xf3, 0x1e, 0x54, 0x6c, 0x2f, 0x35, 0xa9, 0x35, 0x85, 0x25, 0xbd, 0x9e, 0x72, 0x5f,
-	0xcf, 0x0c, 0xf9, 0x4d, 0x7f, 0x0b, 0xab, 0xef, 0x03, 0x21, 0xef, 0xd5, 0xb1, 0xed, 0x3b, 0xae,
-	0x83, 0x83, 0x52, 0xa9, 0x25, 0x8c, 0x94, 0xc5, 0x52, 0xda, 0x22, 0xdd, 0x07, 0x40, 0x6d, 0x7d,
-	0xf0, 0xd6, 0x63, 0x04, 0x52, 0x93, 0x88, 0x63, 0x0b, 0xca, 0x49, 0x92, 0x9a, 0x4c, 0x11, 0xd4,
-	0x81, 0x0d, 0x9d, 0x26, 0x54, 0x95, 0xd0, 0x65, 0x17, 0xd6, 0x0c, 0x1e, 0xc8, 0xe2, 0x17, 0xbd,
-	0x23, 0x66, 0x96, 0xc9, 0x17, 0x50, 0xb9, 0x0a, 0x84, 0x3a, 0xb7, 0xd8, 0x29, 0x1b, 0xa6, 0xa2,
-	0xda, 0x14, 0xd3, 0xcb, 0xf4, 0x1b, 0xa8, 0xc6, 0xe6, 0x55, 0x5c, 0xa5, 0x38, 0xae, 0x87, 0x00,
-	0xf1, 0xd6, 0x30, 0x8f, 0x2b, 0x58, 0xde, 0x84, 0x43, 0x7f, 0xa7, 0x74, 0xcd, 0xb8, 0xbe, 0x0a,
-	0x50, 0x2c, 0x3b, 0xae, 0x71, 0x9d, 0xa9, 0x95, 0x65, 0xf3, 0xb4, 0x0d, 0x6b, 0xfd, 0xc0, 0xe1,
-	0x8c, 0xff, 0x28, 0x4f, 0xac, 0xeb, 0xf1, 0x60, 0x11, 0x5f, 0x9a, 0x9a, 0x54, 0xc8, 0xce, 0x9b,
-	0x07, 0x3e, 0x8f, 0x93, 0x9a, 0x30, 0x68, 0x0b, 0x56, 0xfb, 0xb6, 0xc7, 0xb1, 0x62, 0x08, 0x6e,
-	0x74, 0x4e, 0xe5, 0x37, 0x1d, 0x43, 0x15, 0xd7, 0xe4, 0xce, 0x3e, 0x4f, 0xad, 0x27, 0xc1, 0xe1,
-	0xb2, 0x12, 0xc6, 0x12, 0x04, 0xd7, 0xbe, 0x9e, 0x2e, 0x0d, 0xa6, 0x08, 0xb2, 0x03, 0x75, 0x87,
-	0x47, 0xc2, 0xf5, 0x6d, 0x81, 0xb7, 0x95, 0xc2, 0x19, 0x69, 0x16, 0xed, 0x42, 0x1d, 0x6f, 0xa4,
-	0x48, 0x57, 0xb6, 0x05, 0x55, 0x3f, 0x38, 0x52, 0xd7, 0x65, 0x51, 0x5d, 0x7b, 0x86, 0x96, 0x57,
-	0xe2, 0x34, 0xb8, 0x1e, 0xf0, 0xd9, 0x44, 0xe3, 0xda, 0x98, 0xa6, 0x9f, 0x41, 0xed, 0x7b, 0x6e,
-	0xe6, 0xb2, 0x05, 0x2b, 0x97, 0xfc, 0x56, 0x26, 0xb2, 0xc6, 0xf0, 0x93, 0xfe, 0xad, 0x04, 0x30,
-	0xe0, 0xe1, 0x15, 0x0f, 0xe5, 0x6e, 0x5e, 0x41, 0x25, 0x92, 0x67, 0x52, 0x27, 0xfb, 0x33, 0xd3,
-	0x05, 0xb1, 0xc8, 0x9e, 0x3a, 0xb3, 0x5d, 0x5f, 0x84, 0xb7, 0x4c, 0x0b, 0xa3, 0xda, 0x38, 0xf0,
-	0x27, 0xae, 0xe9, 0x89, 0x1c, 0xb5, 0x8e, 0x5c, 0xd7, 0x6a, 0x4a, 0xb8, 0xf5, 0x1b, 0xa8, 0xa7,
-	0xac, 0x25, 0xd1, 0x15, 0x75, 0x74, 0x09, 0x32, 0x52, 0xa5, 0x55, 0xc4, 0x37, 0xa5, 0xd7, 0xc5,
-	0xd6, 0x31, 0xd4, 0x53, 0x16, 0x73, 0x54, 0xbf, 0x48, 0xab, 0x26, 0xb7, 0x8b, 0x52, 0xea, 0x09,
-	0xee, 0xa5, 0xac, 0xd1, 0x9f, 0x10, 0x2b, 0x99, 0x05, 0xb2, 0x0f, 0xe5, 0x79, 0x18, 0xcc, 0x23,
-	0xbd, 0x99, 0x07, 0x77, 0x54, 0xf7, 0x4e, 0x71, 0x59, 0xed, 0x45, 0x89, 0xb6, 0xf0, 0xe2, 0x8e,
-	0x99, 0x1f, 0xb3, 0x13, 0xfa, 0x02, 0x6a, 0xdd, 0x2b, 0xee, 0x0b, 0x73, 0xad, 0x71, 0x24, 0x96,
-	0xaf, 0x35, 0x29, 0xc1, 0xf4, 0x1a, 0xed, 0x41, 0xb3, 0x93, 0x79, 0x24, 0x11, 0x58, 0x45, 0x39,
-	0xd3, 0xa4, 0xf8, 0x8d, 0x3c, 0xf9, 0xaa, 0x52, 0x0e, 0xe5, 0x37, 0xc6, 0x75, 0x3e, 0x37, 0xe7,
-	0x0d, 0x3f, 0x9f, 0xfc, 0xab, 0x68, 0x2e, 0x1d, 0xfd, 0xbe, 0xab, 0x41, 0x79, 0x78, 0x36, 0x3a,
-	0xf9, 0xde, 0x2a, 0x90, 0x2d, 0xb0, 0x86, 0x67, 0xa3, 0xfe, 0x49, 0xbf, 0xd3, 0x1d, 0x0d, 0x4f,
-	0x4e, 0x46, 0xc7, 0x27, 0x7f, 0xb2, 0x8a, 0xe4, 0x53, 0xd8, 0x1c, 0x9e, 0x8d, 0xda, 0xc7, 0xac,
-	0xdb, 0xfe, 0xee, 0x87, 0x51, 0xf7, 0xac, 0x37, 0x18, 0x0e, 0xac, 0x12, 0xf9, 0x04, 0x36, 0x86,
-	0x67, 0xa3, 0x5e, 0xff, 0x7d, 0xfb, 0xb8, 0xf7, 0xdd, 0xe8, 0xa8, 0x3d, 0x38, 0xb2, 0x56, 0x96,
-	0x98, 0x83, 0xde, 0x9b, 0xbe, 0xb5, 0xaa, 0x0d, 0x18, 0xe6, 0xe1, 0x09, 0x7b, 0xdb, 0x1e, 0x5a,
-	0x65, 0xf2, 0xff, 0x70, 0x5f, 0xb2, 0x07, 0xef, 0x0e, 0x0f, 0x7b, 0x9d, 0x5e, 0xb7, 0x3f, 0x1c,
-	0x1d, 0xb4, 0x8f, 0xdb, 0xfd, 0x4e, 0xd7, 0xaa, 0x68, 0x9d, 0xa3, 0xf6, 0x60, 0x34, 0x68, 0xbf,
-	0xed, 0xaa, 0x98, 0xac, 0xb5, 0xd8, 0xd4, 0xb0, 0xcb, 0xfa, 0xed, 0xe3, 0x51, 0x97, 0xb1, 0x13,
-	0x66, 0xd5, 0x9e, 0x4c, 0xcc, 0xf5, 0xa4, 0xf7, 0xb4, 0x05, 0xd6, 0xfb, 0x2e, 0xeb, 0x1d, 0xfe,
-	0x30, 0x1a, 0x0c, 0xdb, 0xc3, 0x77, 0x03, 0xb5, 0xbd, 0x1d, 0x78, 0x90, 0xe5, 0x62, 0x7c, 0xa3,
-	0xfe, 0xc9, 0x70, 0xf4, 0xb6, 0x3d, 0xec, 0x1c, 0x59, 0x45, 0xf2, 0x10, 0x5a, 0x59, 0x89, 0xcc,
-	0xf6, 0x4a, 0xfb, 0xff, 0xb4, 0x60, 0xa3, 0xcd, 0xc3, 0x8b, 0x80, 0x9d, 0x76, 0xb0, 0xd5, 0xf1,
-	0x75, 0xf4, 0x02, 0x6a, 0x38, 0x7a, 0x06, 0x12, 0xe1, 0x9a, 0x21, 0xaa, 0x87, 0x51, 0x2b, 0xe7,
-	0xba, 0xa1, 0x05, 0xf2, 0x02, 0x2a, 0x6f, 0xe5, 0xbb, 0x9b, 0x18, 0x24, 0xad, 0xc8, 0x88, 0xf1,
-	0x1f, 0x17, 0x3c, 0x12, 0xad, 0xf5, 0x2c, 0x9b, 0x16, 0xc8, 0x2b, 0x80, 0xe4, 0x65, 0x4e, 0xe2,
-	0x2e, 0xc1, 0x57, 0x48, 0xeb, 0x7e, 0x1a, 0x64, 0xa4, 0x9e, 0xee, 0xb4, 0x40, 0x9e, 0x43, 0xe3,
-	0x0d, 0x17, 0xc9, 0x83, 0x35, 0xab, 0x68, 0x65, 0x9e, 0xac, 0xfe, 0x24, 0xa0, 0x05, 0xb2, 0xa7,
-	0xdf, 0xb7, 0x68, 0x62, 0x49, 0x7c, 0x33, 0x2d, 0x2e, 0x9f, 0x7b, 0xb4, 0x40, 0xbe, 0x05, 0x0b,
-	0x1b, 0x39, 0x85, 0x9d, 0x22, 0x62, 0x04, 0x13, 0x44, 0xdd, 0xba, 0x77, 0x17, 0x63, 0xe1, 0x2a,
-	0x2d, 0x90, 0x03, 0xd8, 0x8c, 0x0d, 0xc4, 0xb0, 0x2d, 0xc7, 0xc2, 0x76, 0x1e, 0x94, 0xd2, 0x36,
-	0x5e, 0xc0, 0x46, 0x6c, 0x63, 0x20, 0x42, 0x6e, 0x7b, 0x4b, 0xa1, 0x67, 0xd0, 0x22, 0x2d, 0x3c,
-	0x2f, 0x92, 0x36, 0xdc, 0xbf, 0xe3, 0x36, 0x57, 0x35, 0x17, 0xc2, 0x49, 0x13, 0x7b, 0x50, 0x7d,
-	0xc3, 0x95, 0x05, 0x92, 0x53, 0xe8, 0x65, 0xa7, 0xe4, 0xf7, 0x60, 0x19, 0xf9, 0x04, 0x9f, 0xe6,
-	0xe8, 0x7d, 0xc0, 0x23, 0xf9, 0x56, 0x16, 0x33, 0x86, 0xde, 0xe4, 0xde, 0x32, 0x3e, 0xd7, 0x99,
-	0xfa, 0xf4, 0x2e, 0xff, 0x82, 0x3b, 0xb4, 0x40, 0x76, 0xa1, 0xfc, 0x86, 0x8b, 0xe1, 0x59, 0xae,
-	0xd7, 0x04, 0xc6, 0xd1, 0x02, 0xf9, 0x15, 0x80, 0x71, 0xf5, 0x01, 0x71, 0x2b, 0x16, 0xef, 0xf9,
-	0x66, 0x83, 0xfb, 0x52, 0x8b, 0xf1, 0x31, 0x77, 0xe7, 0x22, 0x57, 0xcb, 0x34, 0xb6, 0x96, 0xa1,
-	0x05, 0x04, 0xe3, 0x6f, 0xb8, 0x68, 0x1f, 0xf4, 0x72, 0xe5, 0xc1, 0x80, 0xbc, 0x83, 0x9e, 0x92,
-	0x1d, 0x70, 0xdf, 0x19, 0x9e, 0x91, 0x24, 0xd8, 0x56, 0x1e, 0x70, 0xa5, 0x78, 0xd8, 0x2b, 0x03,
-	0xf7, 0xc2, 0xcf, 0xca, 0x66, 0xf6, 0xf8, 0x14, 0xaa, 0x6a, 0x68, 0xe4, 0xdb, 0x4b, 0xe3, 0x5d,
-	0x99, 0x91, 0xaa, 0xf2, 0x30, 0x3c, 0x23, 0xcd, 0x58, 0x1a, 0x5b, 0x28, 0x3e, 0x7f, 0xcb, 0x20,
-	0x5b, 0x9e, 0x26, 0x6c, 0x11, 0x35, 0x1b, 0xfe, 0x5b, 0x8b, 0x48, 0x09, 0x5a, 0x20, 0x7f, 0x90,
-	0x2d, 0x22, 0xa9, 0xb6, 0xef, 0x9c, 0x86, 0x41, 0x30, 0x89, 0x67, 0x44, 0xf6, 0xcf, 0x81, 0x38,
-	0x4e, 0xcd, 0x96, 0xb2, 0xb2, 0x06, 0xcd, 0x4e, 0xc8, 0x51, 0x5f, 0xff, 0x55, 0xb0, 0x11, 0xbf,
-	0x76, 0x15, 0xd2, 0x6e, 0x2d, 0x01, 0x67, 0x79, 0x7c, 0xea, 0x58, 0x03, 0x45, 0x47, 0x4b, 0xfd,
-	0x4f, 0xb2, 0xe2, 0x7a, 0x63, 0xcf, 0xa1, 0x7e, 0x1c, 0x8c, 0x2f, 0x3f, 0xc2, 0xc9, 0x3e, 0x34,
-	0xdf, 0xf9, 0xb3, 0x8f, 0xd3, 0xf9, 0x1a, 0x9a, 0x0a, 0xca, 0x1b, 0x1d, 0xb3, 0xe9, 0x34, 0xc0,
-	0xcf, 0xd7, 0xeb, 0xde, 0xa4, 0xf5, 0xee, 0xf8, 0xca, 0x1f, 0xcc, 0x2f, 0xa1, 0xf9, 0xc7, 0x05,
-	0x0f, 0x6f, 0x3b, 0x81, 0x2f, 0x42, 0x7b, 0x9c, 0x0c, 0x40, 0xc9, 0xfd, 0x80, 0x52, 0x1b, 0x48,
-	0x46, 0x49, 0x55, 0x7b, 0x33, 0x5d, 0x59, 0xa5, 0x7e, 0xef, 0x0e, 0xcb, 0x14, 0xed, 0x85, 0x6c,
-	0x13, 0x89, 0xfe, 0x48, 0xfa, 0xcf, 0x18, 0x8d, 0x05, 0x5b, 0x1b, 0x29, 0x5e, 0x5c, 0x00, 0x54,
-	0x79, 0x2f, 0xd1, 0xf0, 0x66, 0x0a, 0x21, 0x2f, 0x69, 0x18, 0x50, 0x2d, 0x07, 0xed, 0x46, 0x52,
-	0x65, 0xa5, 0xb8, 0xdc, 0x5a, 0xea, 0x2f, 0x9f, 0x38, 0xd0, 0xa5, 0x37, 0x83, 0xba, 0x86, 0x54,
-	0x7f, 0xca, 0x97, 0xc1, 0x07, 0xd4, 0x97, 0x5e, 0x12, 0xb4, 0x40, 0x9e, 0xc9, 0x06, 0x8b, 0x21,
-	0x74, 0x1a, 0x34, 0xc7, 0x91, 0x9a, 0x55, 0x59, 0x3e, 0x39, 0xce, 0x25, 0x06, 0xd2, 0x33, 0xd9,
-	0x6c, 0xf1, 0xd0, 0x9d, 0x09, 0x05, 0x30, 0x5b, 0x19, 0xa8, 0x24, 0x07, 0xf2, 0x4b, 0xf5, 0x27,
-	0x8e, 0x64, 0x44, 0x79, 0x2a, 0x56, 0x5a, 0x45, 0xa7, 0xe5, 0x6b, 0x68, 0xe2, 0x96, 0x12, 0x48,
-	0x6c, 0x84, 0x62, 0x14, 0x1d, 0x5f, 0x7c, 0x89, 0x10, 0x2d, 0x90, 0xd7, 0xf2, 0xa8, 0x66, 0x61,
-	0x59, 0xfe, 0xcd, 0x91, 0x91, 0xa1, 0x85, 0x83, 0x9d, 0x3f, 0x3f, 0xbc, 0x70, 0xc5, 0x74, 0x71,
-	0xbe, 0x37, 0x0e, 0xbc, 0xaf, 0x6c, 0xc4, 0x13, 0x6e, 0xa0, 0x7e, 0xbf, 0x92, 0x1a, 0xe7, 0x15,
-	0xf9, 0x7f, 0xfc, 0xcb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd0, 0x1e, 0x5b, 0xe9, 0x17,
-	0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_bd5d8554e3810723) }
+
+var fileDescriptor_rpc_bd5d8554e3810723 = []byte{
+	// 2988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x39, 0x5d, 0x57, 0x23, 0xc7,
+	0xb1, 0x92, 0x90, 0x40, 0x2a, 0x24, 0x10, 0xbd, 0x5f, 0x5c, 0x5d, 0x7b, 0xcd, 0x6d, 0xfb, 0xda,
+	0x78, 0xaf, 0x8d, 0xbd, 0xec, 0xf5, 0x67, 0xec, 0x38, 0x42, 0xc0, 0xa2, 0x63, 0x16, 0x36, 0x2d,
+	0x79, 0x83, 0xf3, 0x60, 0x65, 0xd0, 0x34, 0x30, 0x67, 0x35, 0x1f, 0x9e, 0x19, 0x81, 0xf0, 0x53,
+	0xce, 0xc9, 0xc9, 0x73, 0x7e, 0x4a, 0xce, 0xc9, 0x8f, 0x48, 0xde, 0xf3, 0x98, 0xbf, 0x90, 0x3f,
+	0x91, 0x53, 0xd5, 0xdd, 0xf3, 0x21, 0x06, 0xdb, 0x9b, 0x87, 0x3c, 0x69, 0xaa, 0xba, 0xaa, 0xab,
+	0xba, 0xba, 0xba, 0xbe, 0x04, 0x8d, 0x30, 0x18, 0x6f, 0x05, 0xa1, 0x1f, 0xfb, 0xac, 0x16, 0x5f,
+	0x07, 0x32, 0xea, 0xb4, 0x4f, 0x27, 0xfe, 0xf8, 0xe5, 0xf8, 0xc2, 0x72, 0x3c, 0xb5, 0xd0, 0x69,
+	0x59, 0xe3, 0xb1, 0x3f, 0xf5, 0x62, 0x0d, 0x82, 0xe7, 0xdb, 0x52, 0x7f, 0x37, 0x82, 0xed, 0x40,
+	0x7f, 0x36, 0x5d, 0x19, 0x87, 0x8e, 0xde, 0x8c, 0xff, 0xb9, 0x0c, 0xed, 0x9d, 0x64, 0xa3, 0x41,
+	0x6c, 0xc5, 0xd3, 0x88, 0xbd, 0x0d, 0xab, 0xa7, 0x32, 0x8a, 0x47, 0x24, 0x61, 0x74, 0x61, 0x45,
+	0x17, 0xeb, 0xe5, 0x8d, 0xf2, 0x66, 0x53, 0xb4, 0x10, 0x4d, 0xe4, 0x07, 0x56, 0x74, 0xc1, 0xde,
+	0x80, 0x65, 0xa2, 0xbb, 0x90, 0xce, 0xf9, 0x45, 0xbc, 0x5e, 0xd9, 0x28, 0x6f, 0x56, 0x05, 0x20,
+	0xea, 0x80, 0x30, 0xec, 0x7f, 0x61, 0x65, 0xec, 0x7b, 0x91, 0xf4, 0xa2, 0x69, 0x34, 0x72, 0xbc,
+	0x33, 0x7f, 0x7d, 0x61, 0xa3, 0xbc, 0xd9, 0x10, 0xad, 0x04, 0xdb, 0xf7, 0xce, 0x7c, 0xf6, 0x7f,
+	0xc0, 0x68, 0x1f, 0xd2, 0x61, 0xe4, 0xd8, 0x4a, 0x64, 0x95, 0x44, 0x92, 0x26, 0x3d, 0x5c, 0xe8,
+	0xdb, 0x28, 0x94, 0xfb, 0xb0, 0xa4, 0x41, 0x76, 0x17, 0x6a, 0xae, 0x75, 0xee, 0x8c, 0x49, 0xbb,
+	0x86, 0x50, 0x00, 0xbb, 0x0f, 0x8b, 0xc1, 0xf4, 0x74, 0xe2, 0x8c, 0x49, 0xa1, 0xba, 0xd0, 0x10,
+	0x5b, 0x87, 0x25, 0xd7, 0x72, 0x3c, 0x4f, 0xc6, 0xa4, 0x45, 0x5d, 0x18, 0x90, 0xbd, 0x06, 0x8d,
+	0x44, 0x21, 0x12, 0xdb, 0x10, 0x29, 0x82, 0xff, 0xa9, 0x02, 0x0d, 0x25, 0x11, 0x75, 0x7d, 0x08,
+	0x15, 0xc7, 0x26, 0x81, 0xcb, 0xdb, 0x2b, 0x5b, 0x74, 0x15, 0x5b, 0x5a, 0x1f, 0x51, 0x71, 0x6c,
+	0xd6, 0x81, 0xfa, 0x69, 0x70, 0x34, 0x75, 0x4f, 0x65, 0x48, 0xf2, 0x5b, 0x22, 0x81, 0x19, 0x87,
+	0xa6, 0x6b, 0xcd, 0xc8, 0xaa, 0x91, 0xf3, 0x83, 0x24, 0x35, 0xaa, 0x22, 0x87, 0x43, 0x5d, 0x5c,
+	0x6b, 0x16, 0xfb, 0x2f, 0xa5, 0x17, 0x69, 0x13, 0xa4, 0x08, 0xf6, 0x36, 0xac, 0x44, 0xb1, 0xf5,
+	0xd2, 0xf1, 0xce, 0x5d, 0xc7, 0x73, 0xdc, 0xa9, 0xbb, 0x5e, 0x23, 0x92, 0x39, 0x2c, 0x4a, 0x8a,
+	0xfd, 0xd8, 0x9a, 0x68, 0xf4, 0xfa, 0x22, 0x51, 0xe5, 0x70, 0xa8, 0xe9, 0xb9, 0x15, 0x05, 0xa1,
+	0x33, 0x96, 0xeb, 0x4b, 0xb4, 0x9e, 0xc0, 0xa8, 0x85, 0x67, 0xb9, 0x52, 0x2d, 0xd6, 0x95, 0x16,
+	0x09, 0x82, 0xbf, 0x05, 0xd0, 0x33, 0xee, 0x12, 0xa1, 0xbd, 0x43, 0x19, 0xf8, 0x61, 0xac, 0xaf,
+	0x41, 0x43, 0x7c, 0x0c, 0xb5, 0xbe, 0x17, 0x4c, 0x63, 0xc6, 0xa0, 0x9a, 0xf1, 0x21, 0xfa, 0xc6,
+	0xcb, 0xb0, 0x6c, 0x3b, 0x94, 0x51, 0xb4, 0x5e, 0xd9, 0x58, 0xd8, 0x6c, 0x0a, 0x03, 0xe2, 0xa5,
+	0x5e, 0x5a, 0x93, 0xa9, 0xb2, 0x4e, 0x53, 0x28, 0x00, 0x85, 0x44, 0xe3, 0xd0, 0x09, 0x62, 0x6d,
+	0x13, 0x0d, 0xf1, 0x33, 0x58, 0x3c, 0x9e, 0xc6, 0x28, 0xe5, 0x2e, 0xd4, 0x1c, 0xcf, 0x96, 0x33,
+	0x12, 0xd3, 0x12, 0x0a, 0xc8, 0xcb, 0x29, 0xff, 0xfb, 0x72, 0x96, 0xa0, 0xb6, 0xe7, 0x06, 0xf1,
+	0x35, 0x7f, 0x13, 0x96, 0x07, 0x8e, 0x77, 0x3e, 0x91, 0x3b, 0xd7, 0xb1, 0xcc, 0xec, 0x52, 0xce,
+	0xec, 0xc2, 0xdf, 0x86, 0x95, 0xae, 0x7a, 0x8b, 0xdd, 0x79, 0x69, 0x39, 0xba, 0xef, 0x52, 0x3a,
+	0xcf, 0x16, 0xbe, 0x1f, 0xa3, 0xbe, 0x1a, 0xa3, 0x29, 0x0d, 0x88, 0x56, 0x44, 0x0a, 0x7d, 0x0c,
+	0xfa, 0x66, 0x0f, 0x01, 0x7a, 0xbe, 0x1b, 0xa0, 0x04, 0x69, 0x6b, 0xaf, 0xce, 0x60, 0xf8, 0x3f,
+	0xcb, 0x50, 0x7d, 0x2e, 0x65, 0xc8, 0xde, 0x4b, 0xcd, 0xa0, 0x5c, 0x97, 0x69, 0xd7, 0xc5, 0x55,
+	0xad, 0x63, 0x6a, 0x9a, 0x27, 0xd0, 0xc0, 0x57, 0x47, 0x4e, 0x49, 0xf2, 0x96, 0xb7, 0xef, 0x69,
+	0xfa, 0x23, 0x79, 0x45, 0xef, 0xff, 0xc8, 0x8f, 0x9d, 0xb1, 0x14, 0x29, 0x1d, 0x9e, 0x30, 0x8a,
+	0xad, 0x58, 0xd9, 0xb3, 0x26, 0x14, 0x80, 0xf6, 0xbc, 0x70, 0x6c, 0x5b, 0x7a, 0x64, 0xcf, 0xba,
+	0xd0, 0x10, 0x3a, 0xd8, 0xc4, 0x8a, 0x2e, 0x7a, 0x17, 0x72, 0xfc, 0x92, 0x7c, 0x78, 0x41, 0xa4,
+	0x08, 0x74, 0xcd, 0x48, 0x4e, 0xce, 0x02, 0x29, 0x43, 0x72, 0xdd, 0xba, 0x48, 0x60, 0xb4, 0xd0,
+	0xa5, 0x0c, 0x23, 0xc7, 0xf7, 0xc8, 0x6b, 0x1b, 0xc2, 0x80, 0xfc, 0x7d, 0xa8, 0xe3, 0x71, 0x0e,
+	0x9d, 0x28, 0x66, 0xff, 0x03, 0x35, 0xa4, 0xc6, 0xe3, 0x2e, 0x6c, 0x2e, 0x6f, 0x2f, 0x67, 0x8e,
+	0x2b, 0xd4, 0x0a, 0xbf, 0x04, 0x40, 0xd2, 0xe7, 0x56, 0x68, 0xb9, 0x51, 0xa1, 0x93, 0xa2, 0xf2,
+	0xd9, 0xd0, 0xa6, 0x21, 0xa4, 0x4d, 0xde, 0x6f, 0x4b, 0xd0, 0x37, 0xd2, 0xfa, 0x67, 0x67, 0x91,
+	0x54, 0x8e, 0xd3, 0x12, 0x1a, 0x62, 0x6d, 0x58, 0xb0, 0xa2, 0x31, 0x1d, 0xb1, 0x2e, 0xf0, 0x93,
+	0x7f, 0x0a, 0xf0, 0xdc, 0x3a, 0x97, 0x5a, 0x6e, 0xca, 0x57, 0xce, 0xf1, 0x19, 0x19, 0x95, 0x54,
+	0x06, 0x9f, 0xc1, 0x0a, 0x19, 0x7f, 0xc7, 0xb7, 0xaf, 0x71, 0x0b, 0x8a, 0x80, 0xf4, 0xa6, 0x8d,
+	0xd3, 0x13, 0x90, 0xd9, 0xb3, 0x52, 0xb8, 0x67, 0x56, 0xef, 0xb7, 0xa0, 0x7a, 0xea, 0xdb, 0xd7,
+	0xa4, 0xf5, 0xf2, 0x76, 0x5b, 0xdb, 0x29, 0x11, 0x23, 0x68, 0x95, 0xff, 0x0e, 0x56, 0x33, 0x92,
+	0x49, 0x71, 0x0e, 0x4d, 0x34, 0x92, 0x1f, 0x7a, 0x2a, 0xd8, 0x29, 0xc3, 0xe5, 0x70, 0xec, 0x5d,
+	0x58, 0x0c, 0xac, 0x73, 0x0c, 0x40, 0xca, 0x8b, 0xd6, 0xcc, 0x35, 0x24, 0xe7, 0x17, 0x9a, 0x80,
+	0x7f, 0xa2, 0x25, 0x1c, 0x48, 0xcb, 0xd6, 0x77, 0xf8, 0x16, 0x2c, 0xaa, 0xb8, 0xa8, 0x2f, 0xb1,
+	0x99, 0x55, 0x4e, 0xe8, 0x35, 0xee, 0x40, 0x8b, 0x10, 0xcf, 0x64, 0x6c, 0xd9, 0x56, 0x6c, 0x15,
+	0xde, 0xe4, 0x23, 0xbc, 0x49, 0xdc, 0x78, 0xbd, 0x92, 0x73, 0xff, 0x8c, 0x48, 0xa1, 0x29, 0xd0,
+	0xc1, 0xe2, 0x99, 0x7a, 0x82, 0xca, 0x95, 0x0d, 0xc8, 0xbb, 0xb0, 0x96, 0x13, 0x45, 0x5a, 0xbe,
+	0x37, 0xa7, 0xe5, 0xdd, 0xec, 0xd6, 0x86, 0x32, 0xd1, 0x56, 0x42, 0xb3, 0xe7, 0xbb, 0xae, 0x13,
+	0x0b, 0x19, 0x4d, 0x27, 0xc5, 0xb1, 0xf1, 0x5d, 0xa8, 0xc9, 0x30, 0xf4, 0x95, 0xae, 0x2b, 0xdb,
+	0x77, 0x4c, 0x96, 0x21, 0x3e, 0x95, 0xa2, 0x85, 0xa2, 0xc0, 0x9b, 0xb6, 0x65, 0x6c, 0x39, 0x13,
+	0x9d, 0x58, 0x35, 0xc4, 0xbb, 0xd0, 0xce, 0x8a, 0x21, 0x45, 0xdf, 0x87, 0xa5, 0x90, 0x20, 0xa3,
+	0x69, 0x7e, 0x63, 0x45, 0x29, 0x0c, 0x0d, 0x1f, 0x42, 0xf3, 0x85, 0x0c, 0x9d, 0xb3, 0x6b, 0xad,
+	0xe9, 0x7f, 0x41, 0x25, 0x9e, 0xe9, 0xe8, 0xd1, 0xd0, 0x9c, 0xc3, 0x99, 0xa8, 0xc4, 0xb3, 0xdb,
+	0x14, 0x56, 0xec, 0x39, 0x85, 0xf9, 0x10, 0xdf, 0x68, 0x18, 0xf9, 0x9e, 0x35, 0xc1, 0xe8, 0x15,
+	0x58, 0x51, 0x14, 0x5c, 0x84, 0x56, 0x24, 0x75, 0xf2, 0xc8, 0x60, 0xd8, 0x26, 0x2c, 0xe9, 0x8a,
+	0x66, 0xbd, 0x92, 0xcb, 0xb7, 0x3a, 0x24, 0x0a, 0xb3, 0xcc, 0x2f, 0xa0, 0xd9, 0x77, 0x31, 0xe9,
+	0xec, 0xfb, 0xa1, 0x6b, 0xa1, 0xe7, 0x2c, 0x5c, 0x39, 0x67, 0x73, 0xa1, 0x2e, 0x13, 0xb6, 0x05,
+	0x2e, 0xe3, 0x45, 0xfb, 0x13, 0x1b, 0x05, 0xd2, 0xfe, 0x0d, 0x61, 0x40, 0x5c, 0xf1, 0xe4, 0x15,
+	0xad, 0x28, 0xbb, 0x1a, 0x90, 0xbb, 0xb0, 0x34, 0xd0, 0xf9, 0xf3, 0x3e, 0x2c, 0x5a, 0x6e, 0x26,
+	0x52, 0x6b, 0x08, 0xaf, 0xf4, 0xea, 0x42, 0x7a, 0x3a, 0x66, 0xd0, 0x37, 0x86, 0xbb, 0xa9, 0x77,
+	0xea, 0x7b, 0x36, 0xbe, 0x05, 0x95, 0x70, 0x52, 0x04, 0x8a, 0x0b, 0xe5, 0x44, 0xa2, 0x15, 0xaa,
+	0xc4, 0x64, 0x40, 0xfe, 0x05, 0x54, 0x5f, 0xf8, 0x31, 0xe5, 0xe3, 0xb1, 0xe5, 0xd9, 0x8e, 0x8d,
+	0x01, 0x56, 0x89, 0x4b, 0x11, 0x19, 0x4d, 0x2a, 0x59, 0x4d, 0xf8, 0x36, 0x00, 0x72, 0xeb, 0x07,
+	0xbb, 0x92, 0x54, 0x2e, 0x0d, 0xaa, 0x54, 0xee, 0x42, 0x2d, 0x35, 0x6e, 0x4b, 0x28, 0x80, 0xdb,
+	0xb0, 0xaa, 0xcd, 0x8b, 0xac, 0x54, 0xf2, 0x6c, 0xc2, 0x92, 0xa9, 0x23, 0xf2, 0x75, 0x8f, 0xb6,
+	0x84, 0x30, 0xcb, 0xec, 0x1d, 0x58, 0xbc, 0xf4, 0x63, 0xf5, 0xde, 0xd1, 0xc3, 0x56, 0x8d, 0x27,
+	0xe8, 0xad, 0x84, 0x5e, 0xe6, 0x9f, 0x43, 0x3d, 0xd9, 0x5e, 0xe9, 0x55, 0x49, 0xf4, 0x7a, 0x08,
+	0x90, 0x1c, 0x0d, 0xed, 0xbf, 0x80, 0x6e, 0x91, 0x62, 0xf8, 0x97, 0x8a, 0xd7, 0x84, 0xf9, 0x4b,
+	0x3f, 0x96, 0xc6, 0xa3, 0x97, 0x33, 0xf2, 0x84, 0x5a, 0x99, 0xdf, 0x9e, 0x77, 0x61, 0xe9, 0xc8,
+	0xb7, 0xa5, 0x90, 0xdf, 0xd3, 0x4b, 0x77, 0x5c, 0xe9, 0x4f, 0x93, 0x64, 0xab, 0x41, 0x55, 0x11,
+	0xba, 0x81, 0xef, 0xc9, 0xc4, 0xa8, 0x29, 0x82, 0x77, 0xa0, 0x7a, 0x64, 0xb9, 0x12, 0x6f, 0x1a,
+	0x8b, 0x22, 0x6d, 0x53, 0xfa, 0xe6, 0x7f, 0x2c, 0x43, 0x1d, 0x17, 0xe9, 0x68, 0x6f, 0x64, 0x08,
+	0x52, 0xed, 0x70, 0x59, 0x51, 0xe3, 0x1d, 0xf8, 0x57, 0x9e, 0x0e, 0x4b, 0x4d, 0xa1, 0x00, 0xb6,
+	0x01, 0xcb, 0xb6, 0x8c, 0x62, 0xc7, 0xb3, 0x62, 0x4c, 0x73, 0xca, 0x5f, 0xb2, 0x28, 0xa4, 0x90,
+	0xb3, 0xc0, 0x09, 0x25, 0x45, 0x19, 0xed, 0x35, 0x59, 0x14, 0xdf, 0x83, 0x65, 0x4c, 0x76, 0x91,
+	0xbe, 0xfc, 0x0e, 0xd4, 0x3d, 0xff, 0x40, 0x65, 0xe2, 0xb2, 0xca, 0xa8, 0x06, 0xc6, 0xb5, 0xe8,
+	0xc2, 0xbf, 0x1a, 0xc8, 0xc9, 0x99, 0x2e, 0x99, 0x13, 0x98, 0xbf, 0x0e, 0x8d, 0xaf, 0xa5, 0x09,
+	0xf9, 0x6d, 0x58, 0x78, 0x29, 0xaf, 0xc9, 0xd6, 0x0d, 0x81, 0x9f, 0xfc, 0x0f, 0x15, 0x80, 0x81,
+	0x0c, 0x2f, 0x65, 0x48, 0xe7, 0xfd, 0x08, 0x16, 0x23, 0x7a, 0xee, 0xfa, 0x3e, 0x5e, 0x37, 0x8e,
+	0x92, 0x90, 0x6c, 0xa9, 0x70, 0xb0, 0xe7, 0xc5, 0xe1, 0xb5, 0xd0, 0xc4, 0xc8, 0x36, 0xf6, 0xbd,
+	0x33, 0xc7, 0xb8, 0x4d, 0x01, 0x5b, 0x8f, 0xd6, 0x35, 0x9b, 0x22, 0xee, 0x7c, 0x06, 0xcb, 0x99,
+	0xdd, 0x52, 0xed, 0xca, 0x5a, 0xbb, 0xb4, 0xe8, 0x52, 0xb7, 0xaf, 0x80, 0xcf, 0x2b, 0x9f, 0x96,
+	0x3b, 0x87, 0xb0, 0x9c, 0xd9, 0xb1, 0x80, 0xf5, 0x9d, 0x2c, 0x6b, 0x9a, 0xb8, 0x14, 0x53, 0x3f,
+	0x96, 0x6e, 0x66, 0x37, 0xfe, 0x03, 0x40, 0xba, 0xc0, 0xb6, 0xa1, 0x16, 0x84, 0x7e, 0x10, 0xe9,
+	0xc3, 0xbc, 0x76, 0x83, 0x75, 0xeb, 0x39, 0x2e, 0xab, 0xb3, 0x28, 0xd2, 0x0e, 0xd6, 0x04, 0x09,
+	0xf2, 0x55, 0x4e, 0xc2, 0x1f, 0x43, 0x63, 0xef, 0x52, 0x7a, 0xb1, 0xc9, 0x98, 0x12, 0x81, 0xf9,
+	0x8c, 0x49, 0x14, 0x42, 0xaf, 0xf1, 0x3e, 0xb4, 0x7a, 0xb9, 0xfe, 0x8b, 0x41, 0x15, 0xe9, 0x8c,
+	0x1f, 0xe3, 0x37, 0xe2, 0xa8, 0x61, 0x53, 0x02, 0xe9, 0x1b, 0xf5, 0x3a, 0x0d, 0xcc, 0x93, 0xc4,
+	0x4f, 0xfe, 0x97, 0x32, 0x2c, 0x0d, 0x67, 0xcf, 0x43, 0xdf, 0x3f, 0x63, 0x1c, 0x6a, 0xf1, 0xac,
+	0x6f, 0x9b, 0x1c, 0xd1, 0x4c, 0x72, 0x44, 0xdf, 0x9e, 0x09, 0xb5, 0xa4, 0x93, 0x48, 0xa5, 0x28,
+	0x89, 0xa4, 0x29, 0x7a, 0xe1, 0x27, 0x53, 0xf4, 0x43, 0x00, 0x57, 0x86, 0x2f, 0x27, 0xf2, 0xb9,
+	0x15, 0x63, 0xa3, 0x88, 0x0d, 0x44, 0x06, 0x43, 0xd5, 0xa5, 0xb4, 0xce, 0x7a, 0x14, 0xde, 0x6a,
+	0x14, 0xde, 0x52, 0x04, 0xff, 0x6b, 0x19, 0x9a, 0x42, 0x8e, 0xa5, 0x13, 0xc4, 0x3f, 0x5f, 0xf3,
+	0x4d, 0x8c, 0xd1, 0xc4, 0x33, 0x97, 0x8c, 0xf4, 0x4e, 0xc2, 0x2c, 0xff, 0x07, 0x0f, 0xb2, 0x0f,
+	0x6d, 0xba, 0x59, 0x3a, 0x45, 0x5a, 0x4f, 0xc6, 0xb3, 0x83, 0xb4, 0xa4, 0xd0, 0x10, 0x3e, 0x72,
+	0xba, 0x7e, 0x3c, 0x66, 0x85, 0xca, 0x9a, 0x04, 0xe6, 0x0e, 0x40, 0xba, 0x0f, 0x5a, 0x83, 0x56,
+	0xe6, 0xac, 0xa1, 0x7c, 0x48, 0x2d, 0xb1, 0x4f, 0xa0, 0x19, 0x66, 0x2c, 0xa8, 0x4d, 0x72, 0x27,
+	0x6f, 0x12, 0x5a, 0x12, 0x39, 0x42, 0xfe, 0x1d, 0x80, 0xc9, 0xff, 0x07, 0xbb, 0x3f, 0x59, 0x01,
+	0x74, 0xa0, 0xee, 0x7a, 0xd2, 0xf5, 0x3d, 0xdd, 0xcc, 0x37, 0x44, 0x02, 0xa7, 0xe9, 0x6b, 0x21,
+	0x9b, 0xbe, 0x86, 0x00, 0x07, 0xbb, 0x3a, 0x81, 0x45, 0x39, 0xfe, 0xf2, 0x1c, 0xff, 0x23, 0xa8,
+	0xeb, 0xf2, 0xc1, 0xbc, 0xd4, 0xf9, 0xf2, 0x22, 0x59, 0xe7, 0xbf, 0x2f, 0x03, 0xec, 0xca, 0x89,
+	0x3c, 0x57, 0xd1, 0x77, 0x05, 0x2a, 0xa7, 0x81, 0xb6, 0x6f, 0xe5, 0x34, 0xb8, 0x2d, 0xff, 0xaa,
+	0xce, 0xf8, 0xca, 0x0a, 0x6d, 0x1d, 0xc2, 0x35, 0x94, 0x54, 0x08, 0xd5, 0x4c, 0x85, 0x80, 0x59,
+	0x0f, 0xeb, 0xb0, 0x88, 0x3a, 0x1b, 0x75, 0xd5, 0x19, 0x0c, 0xff, 0x47, 0x19, 0xea, 0x18, 0x22,
+	0xfc, 0xc8, 0x9a, 0x64, 0x52, 0x79, 0xd5, 0xa4, 0xf2, 0x00, 0xaf, 0xdf, 0x84, 0x07, 0x02, 0x6e,
+	0xe9, 0x70, 0x3b, 0x50, 0x0f, 0x68, 0x1f, 0x19, 0xea, 0x1e, 0x37, 0x81, 0x75, 0x0f, 0x17, 0x2a,
+	0x57, 0xab, 0x0a, 0x05, 0xe0, 0xb3, 0x97, 0x9e, 0x4d, 0x8d, 0x58, 0x55, 0xe0, 0x27, 0xd2, 0x59,
+	0x41, 0x30, 0xb9, 0xa6, 0x0e, 0xac, 0x2a, 0x14, 0x80, 0x74, 0xd7, 0x32, 0xd2, 0xe3, 0x02, 0xfc,
+	0x44, 0x3d, 0x3d, 0x7f, 0xbd, 0xa1, 0x0c, 0xe5, 0xf9, 0xd4, 0x5d, 0xab, 0xfc, 0x00, 0xaa, 0x5c,
+	0x55, 0x10, 0xff, 0x12, 0x9a, 0xe6, 0x6c, 0xba, 0x54, 0x6d, 0x04, 0x1a, 0x36, 0xa1, 0xcc, 0x94,
+	0x12, 0x86, 0x4e, 0xa4, 0x14, 0x7c, 0x03, 0x9a, 0xdd, 0xc9, 0xc4, 0xbf, 0x92, 0x36, 0xa5, 0x3c,
+	0x54, 0xc4, 0xb1, 0x15, 0x63, 0x53, 0xe0, 0x27, 0xdf, 0x87, 0xc5, 0xdd, 0x1d, 0x4c, 0x16, 0xea,
+	0x88, 0x7e, 0x68, 0xbc, 0x4d, 0x01, 0x26, 0xe2, 0x56, 0x0a, 0x22, 0xee, 0x42, 0x26, 0xe2, 0xf2,
+	0x2d, 0x58, 0x52, 0xfb, 0x44, 0xec, 0x4d, 0xd5, 0xef, 0x1a, 0xfd, 0x5a, 0x5a, 0x3f, 0xb5, 0xac,
+	0xda, 0xdf, 0xe8, 0xd1, 0xdf, 0xcb, 0xa6, 0xde, 0xd7, 0xa3, 0xb5, 0x06, 0xd4, 0x86, 0x27, 0xa3,
+	0xe3, 0xaf, 0xdb, 0x25, 0x76, 0x17, 0xda, 0xc3, 0x93, 0xd1, 0xd1, 0xf1, 0x51, 0x6f, 0x6f, 0x34,
+	0x3c, 0x3e, 0x1e, 0x1d, 0x1e, 0xff, 0xa6, 0x5d, 0x66, 0xf7, 0x60, 0x6d, 0x78, 0x32, 0xea, 0x1e,
+	0x8a, 0xbd, 0xee, 0xee, 0xb7, 0xa3, 0xbd, 0x93, 0xfe, 0x60, 0x38, 0x68, 0x57, 0xd8, 0x1d, 0x58,
+	0x1d, 0x9e, 0x8c, 0xfa, 0x47, 0x2f, 0xba, 0x87, 0xfd, 0xdd, 0xd1, 0x41, 0x77, 0x70, 0xd0, 0x5e,
+	0x98, 0x43, 0x0e, 0xfa, 0x4f, 0x8f, 0xda, 0x55, 0xbd, 0x81, 0x41, 0xee, 0x1f, 0x8b, 0x67, 0xdd,
+	0x61, 0xbb, 0xc6, 0xfe, 0x1b, 0x1e, 0x10, 0x7a, 0xf0, 0xcd, 0xfe, 0x7e, 0xbf, 0xd7, 0xdf, 0x3b,
+	0x1a, 0x8e, 0x76, 0xba, 0x87, 0xdd, 0xa3, 0xde, 0x5e, 0x7b, 0x51, 0xf3, 0x1c, 0x74, 0x07, 0xa3,
+	0x41, 0xf7, 0xd9, 0x9e, 0xd2, 0xa9, 0xbd, 0x94, 0x6c, 0x35, 0xdc, 0x13, 0x47, 0xdd, 0xc3, 0xd1,
+	0x9e, 0x10, 0xc7, 0xa2, 0xdd, 0x78, 0x74, 0x66, 0x3a, 0x03, 0x7d, 0xa6, 0xbb, 0xd0, 0x7e, 0xb1,
+	0x27, 0xfa, 0xfb, 0xdf, 0x8e, 0x06, 0xc3, 0xee, 0xf0, 0x9b, 0x81, 0x3a, 0xde, 0x06, 0xbc, 0x96,
+	0xc7, 0xa2, 0x7e, 0xa3, 0xa3, 0xe3, 0xe1, 0xe8, 0x59, 0x77, 0xd8, 0x3b, 0x68, 0x97, 0xd9, 0x43,
+	0xe8, 0xe4, 0x29, 0x72, 0xc7, 0xab, 0x6c, 0xff, 0xed, 0x1e, 0xac, 0x76, 0x65, 0x78, 0xee, 0x8b,
+	0xe7, 0x3d, 0x2c, 0x05, 0x70, 0x30, 0xf5, 0x18, 0x1a, 0x58, 0xbd, 0x0d, 0x68, 0xb8, 0x60, 0x1e,
+	0xac, 0xae, 0xe7, 0x3a, 0x05, 0x95, 0x3e, 0x2f, 0xb1, 0xc7, 0xb0, 0xf8, 0x8c, 0x46, 0x9e, 0xcc,
+	0x0c, 0x31, 0x14, 0x18, 0x09, 0xf9, 0xfd, 0x54, 0x46, 0x71, 0x67, 0x25, 0x8f, 0xe6, 0x25, 0xf6,
+	0x11, 0x40, 0x3a, 0x14, 0x65, 0x49, 0x04, 0xc4, 0x01, 0x50, 0xe7, 0x41, 0x36, 0x9c, 0x67, 0xa6,
+	0xa6, 0xbc, 0xc4, 0x3e, 0x84, 0xe6, 0x53, 0x19, 0xa7, 0xb3, 0xc2, 0x3c, 0x63, 0x3b, 0x37, 0x2d,
+	0xf4, 0xce, 0x7c, 0x5e, 0x62, 0x5b, 0x7a, 0xb4, 0x48, 0xae, 0x99, 0x27, 0x5f, 0xcb, 0x92, 0xe3,
+	0x3a, 0x4a, 0xf8, 0x0a, 0xda, 0xf8, 0x40, 0x32, 0xa9, 0x24, 0x62, 0x86, 0x30, 0x1d, 0x66, 0x74,
+	0xee, 0xdf, 0x4c, 0x39, 0xb8, 0xca, 0x4b, 0x6c, 0x07, 0xd6, 0x92, 0x0d, 0x92, 0x8e, 0xb9, 0x60,
+	0x87, 0xf5, 0xa2, 0x2e, 0x56, 0xef, 0xf1, 0x18, 0x56, 0x93, 0x3d, 0x06, 0x71, 0x28, 0x2d, 0x77,
+	0x4e, 0xf5, 0x5c, 0xa3, 0xce, 0x4b, 0x1f, 0x96, 0x59, 0x17, 0x1e, 0xdc, 0x10, 0x5b, 0xc8, 0x5a,
+	0xd8, 0x3d, 0xd3, 0x16, 0x5b, 0x50, 0x7f, 0x2a, 0xd5, 0x0e, 0xac, 0xe0, 0xa2, 0xe7, 0x85, 0xb2,
+	0x5f, 0x42, 0xdb, 0xd0, 0x27, 0x07, 0x2d, 0xe2, 0xbb, 0x45, 0x22, 0xfb, 0x8a, 0x2e, 0x33, 0x99,
+	0x7a, 0xb0, 0xfb, 0xf3, 0xa3, 0x11, 0x6d, 0xa9, 0x7b, 0x37, 0xf1, 0xe7, 0xd2, 0xe6, 0x25, 0xb6,
+	0x09, 0xb5, 0xa7, 0x32, 0x1e, 0x9e, 0x14, 0x4a, 0x4d, 0x8b, 0x1f, 0x5e, 0x62, 0xff, 0x0f, 0x60,
+	0x44, 0xdd, 0x42, 0xde, 0x4e, 0x4b, 0x12, 0xcf, 0x1c, 0x70, 0x9b, 0xb8, 0x74, 0xae, 0x2d, 0xe4,
+	0x9a, 0x2b, 0x51, 0x78, 0x09, 0x6b, 0x93, 0xa7, 0x32, 0xee, 0xee, 0xf4, 0x0b, 0xe9, 0xc1, 0x24,
+	0xc0, 0x9d, 0xbe, 0xa2, 0x1d, 0x48, 0xcf, 0x1e, 0x9e, 0xb0, 0x54, 0xd9, 0x4e, 0xd1, 0xcc, 0x80,
+	0xe3, 0x63, 0x5f, 0x1c, 0x38, 0xe7, 0x5e, 0x9e, 0x36, 0x77, 0xc6, 0xf7, 0xa0, 0xae, 0x82, 0x46,
+	0xf1, 0x7e, 0xd9, 0x51, 0x03, 0x59, 0xa4, 0xae, 0x24, 0x0c, 0x4f, 0x58, 0x2b, 0xa1, 0x46, 0x17,
+	0x4a, 0xde, 0xdf, 0xfc, 0x7c, 0x83, 0x97, 0xb4, 0x8b, 0xa8, 0xd8, 0xf0, 0x63, 0x2e, 0x42, 0x14,
+	0xbc, 0xc4, 0x7e, 0x45, 0x2e, 0x42, 0x50, 0xd7, 0xb3, 0x55, 0xf5, 0x73, 0x2f, 0x5f, 0x04, 0xe8,
+	0xb9, 0x6c, 0xe7, 0x4e, 0x1e, 0x4d, 0xb4, 0x74, 0x07, 0xad, 0x5e, 0x28, 0x91, 0x5f, 0xe1, 0x59,
+	0x92, 0xa6, 0x74, 0x91, 0xd3, 0x99, 0x2b, 0x2a, 0xe8, 0xf9, 0x2c, 0xe3, 0x1d, 0x98, 0x1a, 0x25,
+	0xef, 0xff, 0x2c, 0x4f, 0xae, 0x0f, 0xf6, 0x21, 0x2c, 0x1f, 0xfa, 0xe3, 0x97, 0xaf, 0x20, 0x64,
+	0x1b, 0x5a, 0xdf, 0x78, 0x93, 0x57, 0xe3, 0xf9, 0x18, 0x5a, 0x6a, 0x8a, 0x62, 0x78, 0xcc, 0xa1,
+	0xb3, 0xb3, 0x95, 0x62, 0xbe, 0xbd, 0x59, 0x96, 0xef, 0x86, 0xac, 0xe2, 0xc0, 0xfc, 0x04, 0x5a,
+	0xbf, 0x9e, 0xca, 0xf0, 0xba, 0xe7, 0x7b, 0x71, 0x68, 0x8d, 0xd3, 0x00, 0x48, 0xd8, 0x5b, 0x98,
+	0xba, 0xc0, 0x72, 0x4c, 0xea, 0xb6, 0xd7, 0xb2, 0x37, 0xab, 0xd8, 0xef, 0xdf, 0x40, 0x99, 0x4b,
+	0x7b, 0x4c, 0x6e, 0xa2, 0x4a, 0x85, 0xec, 0x1c, 0x5c, 0xf7, 0xca, 0x9d, 0xd5, 0x0c, 0x2e, 0xb9,
+	0x00, 0x64, 0x79, 0x41, 0x03, 0x85, 0xb5, 0xcc, 0x90, 0x61, 0x8e, 0xc3, 0xcc, 0x25, 0x28, 0xd0,
+	0xae, 0xa6, 0xb7, 0xac, 0x18, 0xe7, 0x5d, 0x4b, 0x4d, 0xdb, 0x3b, 0xf7, 0xf3, 0x68, 0x33, 0x17,
+	0x51, 0x69, 0x48, 0xf9, 0x27, 0x0d, 0x57, 0x6e, 0x61, 0x9f, 0x1b, 0xc6, 0xf0, 0x12, 0x7b, 0x9f,
+	0x1c, 0x2c, 0x19, 0x42, 0x64, 0xc7, 0x0e, 0x9d, 0xd5, 0x0c, 0xa0, 0xa5, 0x7c, 0xac, 0xc2, 0x39,
+	0xd5, 0xf7, 0x3a, 0x26, 0x9b, 0x23, 0xee, 0x3b, 0x93, 0x58, 0x35, 0xe0, 0x9d, 0x5c, 0x1b, 0x40,
+	0x01, 0xf9, 0x89, 0x9a, 0x9f, 0x13, 0x22, 0x2a, 0x62, 0x69, 0x67, 0x59, 0xb4, 0x59, 0x3e, 0x86,
+	0x16, 0x1e, 0x29, 0x1d, 0x19, 0x18, 0xa2, 0x64, 0xca, 0x90, 0x24, 0xbe, 0x94, 0x88, 0x97, 0xd8,
+	0xa7, 0xf4, 0x54, 0xf3, 0x6d, 0x6b, 0x71, 0xe6, 0xc8, 0xd1, 0x24, 0x61, 0xd2, 0x34, 0xa9, 0x3f,
+	0x16, 0x26, 0x35, 0x0d, 0x2f, 0xb1, 0x2f, 0xe8, 0xf2, 0x72, 0x3d, 0x62, 0x11, 0x63, 0x51, 0xbf,
+	0xc3, 0x4b, 0xec, 0x4b, 0x3a, 0x63, 0xa6, 0xa3, 0x7a, 0x90, 0x35, 0x44, 0xa6, 0x59, 0xeb, 0xac,
+	0xdd, 0x58, 0xa0, 0xc4, 0x75, 0x2f, 0xf7, 0x9c, 0xbe, 0x96, 0xd7, 0xaa, 0x48, 0xfd, 0x99, 0xcf,
+	0xea, 0x33, 0x58, 0xcd, 0xc5, 0xa4, 0x83, 0x5d, 0xb6, 0x36, 0xc7, 0x79, 0xb0, 0x9b, 0x88, 0x4e,
+	0xbb, 0x25, 0x5e, 0x62, 0x9f, 0x43, 0x5b, 0xc8, 0xb1, 0x7f, 0x29, 0xc3, 0x57, 0xe7, 0xfd, 0x82,
+	0x6e, 0x08, 0xfd, 0x6a, 0xe7, 0xda, 0xfc, 0xeb, 0x75, 0x8b, 0xcb, 0x16, 0x38, 0xe1, 0x2f, 0xc8,
+	0x66, 0x99, 0x1e, 0xeb, 0x16, 0x56, 0x23, 0x3a, 0xa5, 0xa4, 0x13, 0xb7, 0xa8, 0x74, 0x31, 0x0d,
+	0x41, 0x51, 0x41, 0x73, 0x67, 0xae, 0x7f, 0x48, 0xfc, 0x91, 0x9e, 0x69, 0xb6, 0x7b, 0xc8, 0xbb,
+	0x55, 0x12, 0xf8, 0x33, 0x24, 0x94, 0xce, 0xd0, 0xab, 0x4c, 0x2f, 0x90, 0x67, 0x59, 0xc9, 0xb5,
+	0x02, 0x11, 0x2f, 0xed, 0x6c, 0xfc, 0xf6, 0xe1, 0xb9, 0x13, 0x5f, 0x4c, 0x4f, 0xb7, 0xc6, 0xbe,
+	0xfb, 0x81, 0x85, 0x35, 0xad, 0xe3, 0xab, 0xdf, 0x0f, 0x88, 0xf6, 0x74, 0x91, 0xfe, 0x8e, 0x7f,
+	0xf2, 0xaf, 0x01, 0x00, 0xf9, 0x15, 0x69, 0xf8, 0xe8, 0x1f, 0x00, 0x00,
 }
@@ -5,3 +5,15 @@ import "math/big"
 func (s *Staking) GetAmountBigInt() *big.Int {
 	return new(big.Int).SetBytes(s.GetAmount())
 }
+
+func (s *Staking) GetUnbondingBigInt() *big.Int {
+	return new(big.Int).SetBytes(s.GetUnbonding())
+}
+
+func (d *Delegation) GetAmountBigInt() *big.Int {
+	return new(big.Int).SetBytes(d.GetAmount())
+}
+
+func (d *Delegation) GetRewardBigInt() *big.Int {
+	return new(big.Int).SetBytes(d.GetReward())
+}
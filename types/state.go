@@ -98,6 +98,15 @@ func MustParseBlockID(blockHash []byte) BlockID {
 	return hash
 }
 
+// DecodeBlockID parses the block hash encoded in base58. It returns error if the decoded length is not fit.
+func DecodeBlockID(encoded string) (BlockID, error) {
+	blockHash, err := enc.ToBytes(encoded)
+	if err != nil {
+		return BlockID{}, err
+	}
+	return ParseToBlockID(blockHash)
+}
+
 // ToBlockID make a BlockID from bytes
 func ToBlockID(blockHash []byte) BlockID {
 	return BlockID(ToHashID(blockHash))
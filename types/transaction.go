@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/aergoio/aergo/fee"
@@ -17,9 +18,24 @@ import (
 
 const Stake = "v1stake"
 const Unstake = "v1unstake"
+const Delegate = "v1delegate"
+const Undelegate = "v1undelegate"
+const Claim = "v1claim"
+const SetCommission = "v1setCommission"
+const Withdraw = "v1withdraw"
+const Slash = "v1slash"
+const Propose = "v1propose"
+const VoteProposal = "v1voteProposal"
+const AddAllowedPeer = "v1addAllowedPeer"
+const RemoveAllowedPeer = "v1removeAllowedPeer"
+
+// MaxCommission is the commission rate of 100% in basis points
+const MaxCommission = 10000
 const SetContractOwner = "v1setOwner"
 const NameCreate = "v1createName"
 const NameUpdate = "v1updateName"
+const NameRenew = "v1renewName"
+const NameRevoke = "v1revokeName"
 
 const TxMaxSize = 200 * 1024
 
@@ -136,7 +152,86 @@ func ValidateSystemTx(tx *TxBody) error {
 	}
 	switch ci.Name {
 	case Stake,
-		Unstake:
+		Unstake,
+		Undelegate,
+		Claim,
+		Withdraw:
+	case Delegate:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		bp, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if addr, err := DecodeAddress(bp); err != nil || len(addr) != AddressLength {
+			return ErrTxInvalidPayload
+		}
+	case SetCommission:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		rate, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if n, err := strconv.ParseUint(rate, 10, 32); err != nil || n > MaxCommission {
+			return ErrTxInvalidPayload
+		}
+	case Propose:
+		// a proposal is a pair of a parameter name and its new value
+		if len(ci.Args) != 2 {
+			return ErrTxInvalidPayload
+		}
+		if _, ok := ci.Args[0].(string); !ok {
+			return ErrTxInvalidPayload
+		}
+		value, ok := ci.Args[1].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if _, ok := new(big.Int).SetString(value, 10); !ok {
+			return ErrTxInvalidPayload
+		}
+	case VoteProposal:
+		if len(ci.Args) != 2 {
+			return ErrTxInvalidPayload
+		}
+		id, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+			return ErrTxInvalidPayload
+		}
+		if choice, ok := ci.Args[1].(string); !ok || (choice != ProposalYes && choice != ProposalNo) {
+			return ErrTxInvalidPayload
+		}
+	case Slash:
+		// evidence of double signing is a pair of encoded block headers
+		if len(ci.Args) != 2 {
+			return ErrTxInvalidPayload
+		}
+		for _, v := range ci.Args {
+			encoded, ok := v.(string)
+			if !ok {
+				return ErrTxInvalidPayload
+			}
+			if _, err := DecodeBlockHeader(encoded); err != nil {
+				return ErrTxInvalidPayload
+			}
+		}
+	case AddAllowedPeer, RemoveAllowedPeer:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		encoded, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if _, err := peer.IDB58Decode(encoded); err != nil {
+			return ErrTxInvalidPayload
+		}
 	case VoteBP:
 		unique := map[string]int{}
 		for i, v := range ci.Args {
@@ -197,6 +292,20 @@ func validateNameTx(tx *TxBody) error {
 		if len(ci.Args) != 1 {
 			return fmt.Errorf("invalid arguments in %s", ci)
 		}
+	case NameRenew:
+		if err := _validateNameTx(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+	case NameRevoke:
+		if err := _validateNameTx(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 || !strings.Contains(ci.Args[0].(string), ".") {
+			return fmt.Errorf("only subname can be revoked in %s", ci)
+		}
 	case NameUpdate:
 		if err := _validateNameTx(tx, &ci); err != nil {
 			return err
@@ -226,6 +335,58 @@ func validateNameTx(tx *TxBody) error {
 	return nil
 }
 
+// ValidateForkTx checks that the governance tx to be executed at blockNo doesn't use the features of the forks which
+// are not active yet. Such tx is rejected as the nodes before the forks reject it.
+func ValidateForkTx(tx *TxBody, blockNo BlockNo) error {
+	if tx.GetType() != TxType_GOVERNANCE {
+		return nil
+	}
+	var ci CallInfo
+	if err := json.Unmarshal(tx.GetPayload(), &ci); err != nil {
+		return ErrTxInvalidPayload
+	}
+	switch string(tx.GetRecipient()) {
+	case AergoSystem:
+		switch ci.Name {
+		case Withdraw, Slash:
+			if !IsForkActive(ForkUnbonding, blockNo) {
+				return ErrTxInvalidPayload
+			}
+		case SetCommission, Delegate, Undelegate, Claim:
+			if !IsForkActive(ForkDelegation, blockNo) {
+				return ErrTxInvalidPayload
+			}
+		case Propose, VoteProposal:
+			if !IsForkActive(ForkGovernance, blockNo) {
+				return ErrTxInvalidPayload
+			}
+		case AddAllowedPeer, RemoveAllowedPeer:
+			if !IsForkActive(ForkAllowlist, blockNo) {
+				return ErrTxInvalidPayload
+			}
+		}
+	case AergoName:
+		switch ci.Name {
+		case NameRenew:
+			if !IsForkActive(ForkNameExpiry, blockNo) {
+				return ErrTxInvalidPayload
+			}
+		case NameRevoke:
+			if !IsForkActive(ForkSubName, blockNo) {
+				return ErrTxInvalidPayload
+			}
+		}
+		if !IsForkActive(ForkSubName, blockNo) {
+			for _, arg := range ci.Args {
+				if s, ok := arg.(string); ok && strings.Contains(s, ".") && s != AergoSystem && s != AergoName {
+					return fmt.Errorf("too long name %s", string(tx.GetPayload()))
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func _validateNameTx(tx *TxBody, ci *CallInfo) error {
 	if len(ci.Args) < 1 {
 		return fmt.Errorf("invalid arguments in %s", ci)
@@ -235,6 +396,10 @@ func _validateNameTx(tx *TxBody, ci *CallInfo) error {
 		return fmt.Errorf("invalid arguments in %s", nameParam)
 	}
 
+	// subname is given by the owner of parent without price
+	if strings.Contains(nameParam, ".") {
+		return validateSubName(nameParam)
+	}
 	if len(nameParam) > NameLength {
 		return fmt.Errorf("too long name %s", string(tx.GetPayload()))
 	}
@@ -270,7 +435,7 @@ func (tx *transaction) ValidateWithSenderState(senderState *State) error {
 			if err := json.Unmarshal(tx.GetBody().GetPayload(), &ci); err != nil {
 				return ErrTxInvalidPayload
 			}
-			if ci.Name == Stake &&
+			if (ci.Name == Stake || ci.Name == Delegate) &&
 				amount.Cmp(balance) > 0 {
 				return ErrInsufficientBalance
 			}
@@ -340,6 +505,25 @@ func (tx *transaction) GetMaxFee() *big.Int {
 
 const allowedNameChar = "abcdefghijklmnopqrstuvwxyz1234567890"
 
+func validateSubName(name string) error {
+	if len(name) > MaxNameLength {
+		return fmt.Errorf("too long name %s", name)
+	}
+	labels := strings.Split(name, ".")
+	for _, label := range labels {
+		if label == "" {
+			return fmt.Errorf("empty label in %s", name)
+		}
+		if err := validateAllowedChar([]byte(label)); err != nil {
+			return err
+		}
+	}
+	if len(labels[len(labels)-1]) != NameLength {
+		return fmt.Errorf("invalid top level name in %s", name)
+	}
+	return nil
+}
+
 func validateAllowedChar(param []byte) error {
 	if param == nil {
 		return fmt.Errorf("invalid parameter in NameTx")
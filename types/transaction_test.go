@@ -149,3 +149,26 @@ func buildVoteNumBPPayloadEx(count int, err int) []byte {
 	payload, _ := json.Marshal(ci)
 	return payload
 }
+
+func TestValidateSubName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"pay.ab1234567890", false},
+		{"a.pay.AB1234567890", false},
+		{"pay.short", true},
+		{".ab1234567890", true},
+		{"pay..ab1234567890", true},
+		{"p_y.ab1234567890", true},
+		{"longestsubnamelabel.ab1234567890", false},
+		{"toolongsubnamelabels.ab1234567890", true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.wantErr, validateSubName(tt.name) != nil, tt.name)
+	}
+	assert.True(t, IsNameAddress([]byte("pay.ab1234567890")))
+	addr, err := DecodeAddress("pay.ab1234567890")
+	assert.NoError(t, err)
+	assert.Equal(t, "pay.ab1234567890", EncodeAddress(addr))
+}
@@ -14,6 +14,14 @@ const (
 	VoteNumBP      = "v1voteNumBP"
 	VoteNamePrice  = "v1voteNamePrice"
 	VoteMinStaking = "v1voteMinStaking"
+
+	ProposalYes = "yes"
+	ProposalNo  = "no"
+
+	ProposalVoting   = "voting"
+	ProposalPassed   = "passed"
+	ProposalRejected = "rejected"
+	ProposalApplied  = "applied"
 )
 
 //var AllVotes = [...]string{VoteBP, VoteGasPrice, VoteNumBP, VoteNamePrice, VoteMinStaking}
@@ -37,3 +45,15 @@ func (vl VoteList) Swap(i, j int) { vl.Votes[i], vl.Votes[j] = vl.Votes[j], vl.V
 func (v Vote) GetAmountBigInt() *big.Int {
 	return new(big.Int).SetBytes(v.Amount)
 }
+
+func (p *Proposal) GetValueBigInt() *big.Int {
+	return new(big.Int).SetBytes(p.GetValue())
+}
+
+func (p *Proposal) GetYesBigInt() *big.Int {
+	return new(big.Int).SetBytes(p.GetYes())
+}
+
+func (p *Proposal) GetNoBigInt() *big.Int {
+	return new(big.Int).SetBytes(p.GetNo())
+}